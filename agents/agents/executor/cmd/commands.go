@@ -8,7 +8,9 @@
 	"github.com/phayes/freeport"
 	"github.com/synapsecns/sanguine/agents/agents/executor"
 	"github.com/synapsecns/sanguine/agents/agents/executor/api"
+	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/base"
 	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/mysql"
+	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/postgres"
 	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/executor/metadata"
 	execConfig "github.com/synapsecns/sanguine/agents/config/executor"
@@ -23,6 +25,7 @@
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 
 	// used to embed markdown.
@@ -72,7 +75,7 @@ func createExecutorParameters(ctx context.Context, c *cli.Context, metrics metri
 		return executorConfig, nil, fmt.Errorf("failed to decode config: %w", err)
 	}
 
-	if executorConfig.DBPrefix == "" && executorConfig.DBConfig.Type == dbcommon.Mysql.String() {
+	if executorConfig.DBPrefix == "" && (executorConfig.DBConfig.Type == dbcommon.Mysql.String() || executorConfig.DBConfig.Type == dbcommon.Postgres.String()) {
 		executorConfig.DBPrefix = "executor"
 	}
 
@@ -272,7 +275,53 @@ func InitExecutorDB(parentCtx context.Context, database string, path string, tab
 
 		return mysqlStore, nil
 
+	case database == dbcommon.Postgres.String():
+		if tablePrefix != "" {
+			postgres.NamingStrategy = schema.NamingStrategy{
+				TablePrefix: fmt.Sprintf("%s_", tablePrefix),
+			}
+		}
+
+		postgresStore, err := postgres.NewPostgresStore(ctx, path, handler, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres store: %w", err)
+		}
+
+		return postgresStore, nil
+
 	default:
 		return nil, fmt.Errorf("invalid database type: %s", database)
 	}
 }
+
+// ExecutorMigrateCommand runs versioned migrations on the executor database.
+var ExecutorMigrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Name:       "executor-migrate",
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{configFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		executorConfig, err := execConfig.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode config: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(executorConfig.DBConfig.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		// tables are prefixed the same way as in executor-run
+		namingStrategy := schema.NamingStrategy{}
+		if dbType != dbcommon.Sqlite {
+			prefix := executorConfig.DBPrefix
+			if prefix == "" {
+				prefix = "executor"
+			}
+			namingStrategy.TablePrefix = fmt.Sprintf("%s_", prefix)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, executorConfig.DBConfig.Source, dbcommon.SqliteFileName, namingStrategy)
+	},
+})
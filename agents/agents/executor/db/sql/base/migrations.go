@@ -0,0 +1,17 @@
+package base
+
+import (
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
+)
+
+// MigrationService is the name the executor db's migrations are stored under.
+const MigrationService = "executor"
+
+// GetMigrations gets the versioned schema migrations for the executor db. New migrations are appended here
+// and released migrations must never be changed, so their models are listed out rather than taken from GetAllModels.
+func GetMigrations() []dbcommon.Migration {
+	return []dbcommon.Migration{
+		dbcommon.AutoMigration(1, "initial_schema", &Message{}, &Attestation{}, &State{}, &txdb.ETHTX{}),
+	}
+}
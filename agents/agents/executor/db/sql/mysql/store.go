@@ -59,7 +59,7 @@ func NewMysqlStore(parentCtx context.Context, dbURL string, handler metrics.Hand
 		// migrate in a transaction since we skip this by default
 		err = gdb.Transaction(func(tx *gorm.DB) error {
 			//nolint: wrapcheck
-			return gdb.WithContext(ctx).AutoMigrate(base.GetAllModels()...)
+			return common_base.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
 		})
 	}
 	if err != nil {
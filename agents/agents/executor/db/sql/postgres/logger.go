@@ -0,0 +1,8 @@
+package postgres
+
+import (
+	"github.com/ipfs/go-log"
+)
+
+// Logger is the postgres logger.
+var logger = log.Logger("synapse-postgres")
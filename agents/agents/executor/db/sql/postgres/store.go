@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/base"
+	common_base "github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"gorm.io/gorm/schema"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Store is the postgres store. It extends the base store for postgres specific queries.
+type Store struct {
+	*base.Store
+}
+
+// MaxIdleConns is exported here for testing. Tests execute too slowly with a reconnect each time.
+var MaxIdleConns = 10
+
+// NamingStrategy is for table prefixes.
+var NamingStrategy = schema.NamingStrategy{}
+
+// NewPostgresStore creates a new postgres store for a given data store.
+func NewPostgresStore(parentCtx context.Context, dbURL string, handler metrics.Handler, skipMigrations bool) (_ *Store, err error) {
+	logger.Debug("creating postgres store")
+
+	ctx, span := handler.Tracer().Start(parentCtx, "start-postgres")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:               common_base.GetGormLogger(logger),
+		FullSaveAssociations: true,
+		NamingStrategy:       NamingStrategy,
+		NowFunc:              time.Now,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sql db: %w", err)
+	}
+
+	sqlDB.SetMaxIdleConns(MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(time.Hour)
+
+	handler.AddGormCallbacks(gdb)
+
+	if !skipMigrations {
+		// migrate in a transaction since we skip this by default
+		err = gdb.Transaction(func(tx *gorm.DB) error {
+			//nolint: wrapcheck
+			return common_base.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+	}
+	return &Store{base.NewStore(gdb, handler)}, nil
+}
+
+// var _ db.Service = &Store{}
@@ -14,10 +14,12 @@
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/agents/agents/executor/db"
 	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/mysql"
+	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/postgres"
 	"github.com/synapsecns/sanguine/agents/agents/executor/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/executor/metadata"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dbcommon/postgrestest"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
@@ -29,6 +31,8 @@ type DBSuite struct {
 	dbs      []db.ExecutorDB
 	logIndex atomic.Int64
 	metrics  metrics.Handler
+	// postgresConnString is the postgres database used when postgres tests are enabled
+	postgresConnString string
 }
 
 // NewEventDBSuite creates a new EventDBSuite.
@@ -56,6 +60,10 @@ func (t *DBSuite) SetupSuite() {
 	var err error
 	t.metrics, err = metrics.NewByType(t.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
 	Nil(t.T(), err)
+
+	if os.Getenv(dbcommon.EnablePostgresTestVar) == "true" {
+		t.postgresConnString = postgrestest.GetConnString(t.T())
+	}
 }
 
 func (t *DBSuite) SetupTest() {
@@ -68,6 +76,7 @@ func (t *DBSuite) SetupTest() {
 
 	t.dbs = []db.ExecutorDB{sqliteStore}
 	t.setupMysqlDB()
+	t.setupPostgresDB()
 }
 
 func (t *DBSuite) setupMysqlDB() {
@@ -102,6 +111,21 @@ func (t *DBSuite) setupMysqlDB() {
 	t.dbs = append(t.dbs, mysqlStore)
 }
 
+func (t *DBSuite) setupPostgresDB() {
+	if t.postgresConnString == "" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("test%d_", t.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(t.GetTestContext(), t.postgresConnString, t.metrics, false)
+	t.Require().NoError(err)
+
+	t.dbs = append(t.dbs, postgresStore)
+}
+
 func (t *DBSuite) RunOnAllDBs(testFunc func(testDB db.ExecutorDB)) {
 	t.T().Helper()
 
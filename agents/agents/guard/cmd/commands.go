@@ -13,7 +13,9 @@
 	"github.com/synapsecns/sanguine/agents/agents/guard"
 	"github.com/synapsecns/sanguine/agents/agents/guard/api"
 	"github.com/synapsecns/sanguine/agents/agents/guard/db"
+	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/base"
 	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/mysql"
+	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/postgres"
 	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/guard/metadata"
 	"github.com/synapsecns/sanguine/core/dbcommon"
@@ -27,6 +29,7 @@
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 
 	// used to embed markdown.
@@ -75,7 +78,7 @@ func createGuardParameters(ctx context.Context, c *cli.Context, metrics metrics.
 		return guardConfig, nil, fmt.Errorf("failed to decode config: %w", err)
 	}
 
-	if guardConfig.DBPrefix == "" && guardConfig.DBConfig.Type == dbcommon.Mysql.String() {
+	if guardConfig.DBPrefix == "" && (guardConfig.DBConfig.Type == dbcommon.Mysql.String() || guardConfig.DBConfig.Type == dbcommon.Postgres.String()) {
 		guardConfig.DBPrefix = "guard"
 	}
 
@@ -282,7 +285,53 @@ func InitGuardDB(parentCtx context.Context, database string, path string, tableP
 
 		return mysqlStore, nil
 
+	case database == dbcommon.Postgres.String():
+		if tablePrefix != "" {
+			postgres.NamingStrategy = schema.NamingStrategy{
+				TablePrefix: fmt.Sprintf("%s_", tablePrefix),
+			}
+		}
+
+		postgresStore, err := postgres.NewPostgresStore(ctx, path, handler)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres store: %w", err)
+		}
+
+		return postgresStore, nil
+
 	default:
 		return nil, fmt.Errorf("invalid database type: %s", database)
 	}
 }
+
+// GuardMigrateCommand runs versioned migrations on the guard database.
+var GuardMigrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Name:       "guard-migrate",
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{configFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		guardConfig, err := config.DecodeAgentConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode config: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(guardConfig.DBConfig.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		// tables are prefixed the same way as in guard-run
+		namingStrategy := schema.NamingStrategy{}
+		if dbType != dbcommon.Sqlite {
+			prefix := guardConfig.DBPrefix
+			if prefix == "" {
+				prefix = "guard"
+			}
+			namingStrategy.TablePrefix = fmt.Sprintf("%s_", prefix)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, guardConfig.DBConfig.Source, dbcommon.SqliteFileName, namingStrategy)
+	},
+})
@@ -42,7 +42,7 @@ func NewMysqlStore(ctx context.Context, dbURL string, handler metrics.Handler) (
 
 	handler.AddGormCallbacks(gdb)
 
-	err = gdb.WithContext(ctx).AutoMigrate(base.GetAllModels()...)
+	err = common_base.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
 	if err != nil {
 		return nil, fmt.Errorf("could not migrate on mysql: %w", err)
 	}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/base"
+	common_base "github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"time"
+)
+
+// Logger is the postgres logger.
+var logger = log.Logger("guard-postgres")
+
+// NewPostgresStore creates a new postgres store for a given data store.
+func NewPostgresStore(ctx context.Context, dbURL string, handler metrics.Handler) (*Store, error) {
+	logger.Debug("create postgres store")
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:               common_base.GetGormLogger(logger),
+		FullSaveAssociations: true,
+		NamingStrategy:       NamingStrategy,
+		NowFunc:              time.Now,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sql db: %w", err)
+	}
+
+	sqlDB.SetMaxIdleConns(MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(time.Hour)
+
+	handler.AddGormCallbacks(gdb)
+
+	err = common_base.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+	}
+
+	return &Store{base.NewStore(gdb, handler)}, nil
+}
+
+// Store is the postgres store. It extends the base store for postgres queries.
+type Store struct {
+	*base.Store
+}
+
+// MaxIdleConns is exported here for testing. Tests execute too slowly with a reconnect each time.
+var MaxIdleConns = 10
+
+// NamingStrategy is for table prefixes.
+var NamingStrategy = schema.NamingStrategy{}
@@ -15,10 +15,12 @@
 	"github.com/Flaque/filet"
 	"github.com/synapsecns/sanguine/agents/agents/guard/db"
 	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/mysql"
+	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/postgres"
 	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/guard/metadata"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dbcommon/postgrestest"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
@@ -28,6 +30,8 @@ type DBSuite struct {
 	*testsuite.TestSuite
 	dbs     []db.GuardDB
 	metrics metrics.Handler
+	// postgresConnString is the postgres database used when postgres tests are enabled
+	postgresConnString string
 }
 
 // NewEventDBSuite creates a new EventDBSuite.
@@ -55,6 +59,10 @@ func (t *DBSuite) SetupSuite() {
 	var err error
 	t.metrics, err = metrics.NewByType(t.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
 	Nil(t.T(), err)
+
+	if os.Getenv(dbcommon.EnablePostgresTestVar) == "true" {
+		t.postgresConnString = postgrestest.GetConnString(t.T())
+	}
 }
 
 func (t *DBSuite) SetupTest() {
@@ -65,6 +73,7 @@ func (t *DBSuite) SetupTest() {
 
 	t.dbs = []db.GuardDB{sqliteStore}
 	t.setupMysqlDB()
+	t.setupPostgresDB()
 }
 
 func (t *DBSuite) setupMysqlDB() {
@@ -99,6 +108,21 @@ func (t *DBSuite) setupMysqlDB() {
 	t.dbs = append(t.dbs, mysqlStore)
 }
 
+func (t *DBSuite) setupPostgresDB() {
+	if t.postgresConnString == "" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("test%d_", t.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(t.GetTestContext(), t.postgresConnString, t.metrics)
+	t.Require().NoError(err)
+
+	t.dbs = append(t.dbs, postgresStore)
+}
+
 func (t *DBSuite) RunOnAllDBs(testFunc func(testDB db.GuardDB)) {
 	t.T().Helper()
 
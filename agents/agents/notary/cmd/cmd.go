@@ -19,7 +19,7 @@ func Start(args []string, buildInfo config.BuildInfo) {
 	app.EnableBashCompletion = true
 
 	// commands
-	app.Commands = cli.Commands{NotaryInfoCommand, NotaryRunCommand}
+	app.Commands = cli.Commands{NotaryInfoCommand, NotaryRunCommand, NotaryMigrateCommand}
 	shellCommand := commandline.GenerateShellCommand(app.Commands)
 	app.Commands = append(app.Commands, shellCommand)
 	app.Action = shellCommand.Action
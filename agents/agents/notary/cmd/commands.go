@@ -3,7 +3,9 @@
 import (
 	"context"
 	"github.com/synapsecns/sanguine/agents/agents/notary/db"
+	"github.com/synapsecns/sanguine/agents/agents/notary/db/sql/base"
 	"github.com/synapsecns/sanguine/agents/agents/notary/db/sql/mysql"
+	"github.com/synapsecns/sanguine/agents/agents/notary/db/sql/postgres"
 	"github.com/synapsecns/sanguine/agents/agents/notary/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/notary/metadata"
 	"github.com/synapsecns/sanguine/core/dbcommon"
@@ -11,6 +13,7 @@
 	omnirpcClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 	"os"
 	"sync/atomic"
@@ -70,7 +73,7 @@ func createNotaryParameters(ctx context.Context, c *cli.Context, metrics metrics
 		return notaryConfig, nil, fmt.Errorf("failed to decode config: %w", err)
 	}
 
-	if notaryConfig.DBPrefix == "" && notaryConfig.DBConfig.Type == dbcommon.Mysql.String() {
+	if notaryConfig.DBPrefix == "" && (notaryConfig.DBConfig.Type == dbcommon.Mysql.String() || notaryConfig.DBConfig.Type == dbcommon.Postgres.String()) {
 		notaryConfig.DBPrefix = "notary"
 	}
 
@@ -212,7 +215,53 @@ func InitNotaryDB(parentCtx context.Context, database string, path string, table
 
 		return mysqlStore, nil
 
+	case database == dbcommon.Postgres.String():
+		if tablePrefix != "" {
+			postgres.NamingStrategy = schema.NamingStrategy{
+				TablePrefix: fmt.Sprintf("%s_", tablePrefix),
+			}
+		}
+
+		postgresStore, err := postgres.NewPostgresStore(ctx, path, handler)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres store: %w", err)
+		}
+
+		return postgresStore, nil
+
 	default:
 		return nil, fmt.Errorf("invalid database type: %s", database)
 	}
 }
+
+// NotaryMigrateCommand runs versioned migrations on the notary database.
+var NotaryMigrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Name:       "notary-migrate",
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{configFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		notaryConfig, err := config.DecodeAgentConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode config: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(notaryConfig.DBConfig.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		// tables are prefixed the same way as in notary-run
+		namingStrategy := schema.NamingStrategy{}
+		if dbType != dbcommon.Sqlite {
+			prefix := notaryConfig.DBPrefix
+			if prefix == "" {
+				prefix = "notary"
+			}
+			namingStrategy.TablePrefix = fmt.Sprintf("%s_", prefix)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, notaryConfig.DBConfig.Source, dbcommon.SqliteFileName, namingStrategy)
+	},
+})
@@ -0,0 +1,17 @@
+package base
+
+import (
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
+)
+
+// MigrationService is the name the notary db's migrations are stored under.
+const MigrationService = "notary"
+
+// GetMigrations gets the versioned schema migrations for the notary db. New migrations are appended here
+// and released migrations must never be changed, so their models are listed out rather than taken from GetAllModels.
+func GetMigrations() []dbcommon.Migration {
+	return []dbcommon.Migration{
+		dbcommon.AutoMigration(1, "initial_schema", &txdb.ETHTX{}),
+	}
+}
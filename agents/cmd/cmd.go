@@ -27,11 +27,11 @@ func Start(args []string, buildInfo config.BuildInfo) {
 	// commands
 	app.Commands = cli.Commands{
 		// Executor Commands
-		executorCmd.ExecutorInfoCommand, executorCmd.ExecutorRunCommand,
+		executorCmd.ExecutorInfoCommand, executorCmd.ExecutorRunCommand, executorCmd.ExecutorMigrateCommand,
 		// Notary Commands
-		notaryCmd.NotaryInfoCommand, notaryCmd.NotaryRunCommand,
+		notaryCmd.NotaryInfoCommand, notaryCmd.NotaryRunCommand, notaryCmd.NotaryMigrateCommand,
 		// Guard Commands
-		guardCmd.GuardInfoCommand, guardCmd.GuardRunCommand,
+		guardCmd.GuardInfoCommand, guardCmd.GuardRunCommand, guardCmd.GuardMigrateCommand,
 	}
 	shellCommand := commandline.GenerateShellCommand(app.Commands)
 	app.Commands = append(app.Commands, shellCommand)
@@ -8,15 +8,15 @@
 
 // DBConfig is used to configure a database.
 type DBConfig struct {
-	// Type is the type of database. This can be either "sqlite" or "mysql".
+	// Type is the type of database. This can be "sqlite", "mysql" or "postgres".
 	Type string `yaml:"type"`
-	// Source is the source of the database. This can be either a path to a sqlite database or a mysql database url.
+	// Source is the source of the database. This can be either a path to a sqlite database or a mysql/postgres database url.
 	Source string `yaml:"source"`
 }
 
 // IsValid asserts the database connection is valid.
 func (d *DBConfig) IsValid(_ context.Context) (ok bool, err error) {
-	if d.Type != dbcommon.Sqlite.String() && d.Type != dbcommon.Mysql.String() {
+	if d.Type != dbcommon.Sqlite.String() && d.Type != dbcommon.Mysql.String() && d.Type != dbcommon.Postgres.String() {
 		return false, fmt.Errorf("invalid database type: %s", d.Type)
 	}
 
@@ -3,10 +3,14 @@
 import (
 	"fmt"
 	"github.com/synapsecns/sanguine/contrib/screener-api/config"
+	"github.com/synapsecns/sanguine/contrib/screener-api/db/sql/base"
 	"github.com/synapsecns/sanguine/contrib/screener-api/screener"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/urfave/cli/v2"
 	"gopkg.in/yaml.v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 	"os"
 )
 
@@ -72,3 +76,30 @@
 		return nil
 	},
 }
+
+// migrateCommand runs versioned migrations on the screener database.
+var migrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{fileFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		configFile, err := os.ReadFile(c.String(fileFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open config file: %w", err)
+		}
+
+		var cfg config.Config
+		err = yaml.Unmarshal(configFile, &cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config file: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(cfg.Database.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, cfg.Database.DSN, "api.db", schema.NamingStrategy{})
+	},
+})
@@ -0,0 +1,17 @@
+package base
+
+import (
+	"github.com/synapsecns/sanguine/contrib/screener-api/db"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+)
+
+// MigrationService is the name the screener db's migrations are stored under.
+const MigrationService = "screener-api"
+
+// GetMigrations gets the versioned schema migrations for the screener db. New migrations are appended here
+// and released migrations must never be changed, so their models are listed out rather than taken from GetAllModels.
+func GetMigrations() []dbcommon.Migration {
+	return []dbcommon.Migration{
+		dbcommon.AutoMigration(1, "initial_schema", &db.AddressIndicators{}),
+	}
+}
@@ -0,0 +1,2 @@
+// Package postgres provides a postgres store for the screener-api.
+package postgres
@@ -7,6 +7,7 @@
 	"fmt"
 	"github.com/synapsecns/sanguine/contrib/screener-api/db"
 	"github.com/synapsecns/sanguine/contrib/screener-api/db/sql/mysql"
+	"github.com/synapsecns/sanguine/contrib/screener-api/db/sql/postgres"
 	"github.com/synapsecns/sanguine/contrib/screener-api/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
@@ -28,6 +29,13 @@ func Connect(ctx context.Context, dbType dbcommon.DBType, path string, metrics m
 			return nil, fmt.Errorf("could not create sqlite store: %w", err)
 		}
 
+		return store, nil
+	case dbcommon.Postgres:
+		store, err := postgres.NewPostgresStore(ctx, path, metrics)
+		if err != nil {
+			return nil, fmt.Errorf("could not create postgres store: %w", err)
+		}
+
 		return store, nil
 	case dbcommon.Clickhouse:
 		return nil, errors.New("driver not supported")
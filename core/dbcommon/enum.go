@@ -23,7 +23,7 @@ type Enum interface {
 	driver.Valuer
 }
 
-// EnumDataType is exported here to be passed as GormDataType.
+// EnumDataType is exported here to be passed as GormDataType. integer is used since it maps to the same type on mysql, postgres and sqlite.
 // TODO: support string types.
 const EnumDataType = "integer"
 
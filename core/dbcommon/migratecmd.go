@@ -0,0 +1,164 @@
+package dbcommon
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+)
+
+// MigrateCommandConfig configures a migrate command.
+type MigrateCommandConfig struct {
+	// Name is the name of the command, migrate if empty. This is used when multiple services share a cli.
+	Name string
+	// Service is the name migrations are stored under.
+	Service string
+	// Migrations are the service's migrations.
+	Migrations []Migration
+	// Flags are the flags needed to open the database, e.g. config or db/path flags.
+	Flags []cli.Flag
+	// Open opens the service's database from the flags w/o migrating it.
+	Open func(c *cli.Context) (*gorm.DB, error)
+}
+
+var dryRunFlag = &cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "print the migrations that would run without running them",
+}
+
+var toVersionFlag = &cli.Uint64Flag{
+	Name:     "to",
+	Usage:    "--to <version>, every migration newer than this version is rolled back",
+	Required: true,
+}
+
+// NewMigrateCommand creates a migrate command w/ up, down and status subcommands for a service.
+func NewMigrateCommand(cfg MigrateCommandConfig) *cli.Command {
+	migrator := func(c *cli.Context) (*Migrator, error) {
+		gdb, err := cfg.Open(c)
+		if err != nil {
+			return nil, fmt.Errorf("could not open database: %w", err)
+		}
+
+		return NewMigrator(gdb, cfg.Service, cfg.Migrations)
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = "migrate"
+	}
+
+	return &cli.Command{
+		Name:        name,
+		Description: fmt.Sprintf("runs versioned schema migrations for the %s database", cfg.Service),
+		Subcommands: []*cli.Command{
+			{
+				Name:        "up",
+				Description: "applies all pending migrations",
+				Flags:       append([]cli.Flag{dryRunFlag}, cfg.Flags...),
+				Action: func(c *cli.Context) error {
+					m, err := migrator(c)
+					if err != nil {
+						return err
+					}
+
+					if c.Bool(dryRunFlag.Name) {
+						pending, err := m.Pending(c.Context)
+						if err != nil {
+							return err
+						}
+						printMigrations(c.App.Writer, "would apply", pending, upSQL)
+						return nil
+					}
+
+					applied, err := m.Migrate(c.Context)
+					printMigrations(c.App.Writer, "applied", applied, nil)
+					return err
+				},
+			},
+			{
+				Name:        "down",
+				Description: "rolls back migrations newer than a version",
+				Flags:       append([]cli.Flag{dryRunFlag, toVersionFlag}, cfg.Flags...),
+				Action: func(c *cli.Context) error {
+					m, err := migrator(c)
+					if err != nil {
+						return err
+					}
+
+					if c.Bool(dryRunFlag.Name) {
+						rollbacks, err := m.Rollbacks(c.Context, c.Uint64(toVersionFlag.Name))
+						if err != nil {
+							return err
+						}
+						printMigrations(c.App.Writer, "would roll back", rollbacks, downSQL)
+						return nil
+					}
+
+					rolledBack, err := m.Rollback(c.Context, c.Uint64(toVersionFlag.Name))
+					printMigrations(c.App.Writer, "rolled back", rolledBack, nil)
+					return err
+				},
+			},
+			{
+				Name:        "status",
+				Description: "prints the status of every migration",
+				Flags:       cfg.Flags,
+				Action: func(c *cli.Context) error {
+					m, err := migrator(c)
+					if err != nil {
+						return err
+					}
+
+					statuses, err := m.Status(c.Context)
+					if err != nil {
+						return err
+					}
+
+					for _, status := range statuses {
+						state := "pending"
+						if status.AppliedAt != nil {
+							state = fmt.Sprintf("applied %s", status.AppliedAt.Format(time.RFC3339))
+						}
+						if status.Unknown {
+							state += " (unknown to this build)"
+						}
+						_, _ = fmt.Fprintf(c.App.Writer, "%d\t%s\t%s\n", status.Version, status.Name, state)
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// upSQL gets the sql run when applying a migration.
+func upSQL(migration Migration) string {
+	return migration.UpSQL
+}
+
+// downSQL gets the sql run when rolling back a migration.
+func downSQL(migration Migration) string {
+	return migration.DownSQL
+}
+
+// printMigrations prints a list of migrations. If getSQL is set, the sql of sql migrations is printed as well.
+func printMigrations(w io.Writer, action string, migrations []Migration, getSQL func(Migration) string) {
+	if len(migrations) == 0 {
+		_, _ = fmt.Fprintf(w, "no migrations %s\n", action)
+		return
+	}
+
+	for _, migration := range migrations {
+		_, _ = fmt.Fprintf(w, "%s %d\t%s\n", action, migration.Version, migration.Name)
+		if getSQL == nil {
+			continue
+		}
+
+		if sql := getSQL(migration); sql != "" {
+			_, _ = fmt.Fprintf(w, "%s\n", sql)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package dbcommon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MigrateFunc applies (or rolls back) a migration inside of a transaction.
+type MigrateFunc func(tx *gorm.DB) error
+
+// Migration is a versioned schema migration. Migrations are applied in version order and each version is only
+// ever applied once per service.
+type Migration struct {
+	// Version orders the migration. It must be unique for a service and must never be changed once released.
+	Version uint64
+	// Name is a short description of the migration.
+	Name string
+	// Up applies the migration.
+	Up MigrateFunc
+	// Down rolls back the migration. Migrations w/o a down can't be rolled back.
+	Down MigrateFunc
+	// UpSQL is the sql run by up for sql migrations. This is shown in dry runs.
+	UpSQL string
+	// DownSQL is the sql run by down for sql migrations. This is shown in dry runs.
+	DownSQL string
+}
+
+// SQLMigration creates a migration that executes sql. down can be empty if the migration can't be rolled back.
+func SQLMigration(version uint64, name, up, down string) Migration {
+	migration := Migration{
+		Version: version,
+		Name:    name,
+		Up:      execSQL(up),
+		UpSQL:   up,
+		DownSQL: down,
+	}
+
+	if down != "" {
+		migration.Down = execSQL(down)
+	}
+
+	return migration
+}
+
+// AutoMigration creates a migration that auto migrates models. Since auto migrations are idempotent, this is used for
+// the initial migration of each service so databases created before versioned migrations are adopted as is.
+// Auto migrations can't be rolled back since they may have adopted existing tables.
+func AutoMigration(version uint64, name string, models ...interface{}) Migration {
+	return Migration{
+		Version: version,
+		Name:    name,
+		Up: func(tx *gorm.DB) error {
+			//nolint: wrapcheck
+			return tx.AutoMigrate(models...)
+		},
+	}
+}
+
+// execSQL creates a migrate func that executes sql.
+func execSQL(sql string) MigrateFunc {
+	return func(tx *gorm.DB) error {
+		//nolint: wrapcheck
+		return tx.Exec(sql).Error
+	}
+}
+
+// SchemaMigration is a migration that's been applied to the database.
+type SchemaMigration struct {
+	// Service is the service the migration belongs to.
+	Service string `gorm:"column:service;primaryKey"`
+	// Version is the version of the migration.
+	Version uint64 `gorm:"column:version;primaryKey;autoIncrement:false"`
+	// Name is the name of the migration.
+	Name string `gorm:"column:name"`
+	// AppliedAt is when the migration was applied.
+	AppliedAt time.Time `gorm:"column:applied_at"`
+}
+
+// MigrationStatus is the status of a single migration.
+type MigrationStatus struct {
+	// Version is the version of the migration.
+	Version uint64
+	// Name is the name of the migration.
+	Name string
+	// AppliedAt is when the migration was applied, nil if it's pending.
+	AppliedAt *time.Time
+	// Unknown is true for migrations that were applied to the database but aren't known to this build.
+	// This happens when an older release is run against a newer schema.
+	Unknown bool
+}
+
+// ErrUnknownMigration is returned when the database has migrations applied that aren't known to this build.
+var ErrUnknownMigration = errors.New("database has unknown migrations applied")
+
+// Migrator runs versioned migrations for a service.
+type Migrator struct {
+	db         *gorm.DB
+	service    string
+	migrations []Migration
+}
+
+// NewMigrator creates a new migrator. Migrations are sorted by version and must have unique versions.
+func NewMigrator(db *gorm.DB, service string, migrations []Migration) (*Migrator, error) {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	for i, migration := range sorted {
+		if migration.Version == 0 {
+			return nil, fmt.Errorf("migration %s must have a version greater than 0", migration.Name)
+		}
+		if migration.Up == nil {
+			return nil, fmt.Errorf("migration %d (%s) has no up", migration.Version, migration.Name)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("duplicate migration version %d for %s", migration.Version, service)
+		}
+	}
+
+	return &Migrator{
+		db:         db,
+		service:    service,
+		migrations: sorted,
+	}, nil
+}
+
+// RunMigrations applies all pending migrations for a service. This should be used in place of AutoMigrate.
+// Migrations aren't locked, so only one replica of a service may run them at a time.
+func RunMigrations(ctx context.Context, db *gorm.DB, service string, migrations []Migration) error {
+	migrator, err := NewMigrator(db, service, migrations)
+	if err != nil {
+		return err
+	}
+
+	_, err = migrator.Migrate(ctx)
+	return err
+}
+
+// Status gets the status of every known and unknown migration, ordered by version.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[uint64]bool)
+	var statuses []MigrationStatus
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		status := MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+		}
+		if appliedMigration, ok := applied[migration.Version]; ok {
+			appliedAt := appliedMigration.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	for version, appliedMigration := range applied {
+		if known[version] {
+			continue
+		}
+		appliedAt := appliedMigration.AppliedAt
+		statuses = append(statuses, MigrationStatus{
+			Version:   version,
+			Name:      appliedMigration.Name,
+			AppliedAt: &appliedAt,
+			Unknown:   true,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// Pending gets the migrations that haven't been applied yet. This is used for dry runs.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkUnknown(applied); err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate applies all pending migrations in order. Each migration is applied in its own transaction, so a failed
+// migration leaves the ones before it applied. MySQL commits DDL statements implicitly though, so on MySQL a failed
+// migration can leave part of its schema changes applied without being recorded.
+//
+// Migrations aren't locked, so only one replica of a service may run them at a time. Other replicas should skip
+// migrations, or migrations should be applied before they're started.
+func (m *Migrator) Migrate(ctx context.Context) (applied []Migration, err error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range pending {
+		migration := migration
+		err = m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+
+			//nolint: wrapcheck
+			return tx.Create(&SchemaMigration{
+				Service:   m.service,
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("could not apply migration %d (%s): %w", migration.Version, migration.Name, err)
+		}
+
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Rollbacks gets the applied migrations newer than version in the order they'd be rolled back. This is used for dry runs.
+func (m *Migrator) Rollbacks(ctx context.Context, version uint64) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkUnknown(applied); err != nil {
+		return nil, err
+	}
+
+	var rollbacks []Migration
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.Version <= version {
+			break
+		}
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.Down == nil {
+			return nil, fmt.Errorf("migration %d (%s) can't be rolled back", migration.Version, migration.Name)
+		}
+		rollbacks = append(rollbacks, migration)
+	}
+
+	return rollbacks, nil
+}
+
+// Rollback rolls back every applied migration newer than version, newest first.
+func (m *Migrator) Rollback(ctx context.Context, version uint64) (rolledBack []Migration, err error) {
+	rollbacks, err := m.Rollbacks(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range rollbacks {
+		migration := migration
+		err = m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+
+			//nolint: wrapcheck
+			return tx.Where(&SchemaMigration{Service: m.service, Version: migration.Version}).Delete(&SchemaMigration{}).Error
+		})
+		if err != nil {
+			return rolledBack, fmt.Errorf("could not roll back migration %d (%s): %w", migration.Version, migration.Name, err)
+		}
+
+		rolledBack = append(rolledBack, migration)
+	}
+
+	return rolledBack, nil
+}
+
+// applied gets the migrations applied to the database by version, creating the migrations table if needed.
+func (m *Migrator) applied(ctx context.Context) (map[uint64]SchemaMigration, error) {
+	err := m.db.WithContext(ctx).AutoMigrate(&SchemaMigration{})
+	if err != nil {
+		return nil, fmt.Errorf("could not create migrations table: %w", err)
+	}
+
+	var schemaMigrations []SchemaMigration
+	err = m.db.WithContext(ctx).Where(&SchemaMigration{Service: m.service}).Find(&schemaMigrations).Error
+	if err != nil {
+		return nil, fmt.Errorf("could not get applied migrations: %w", err)
+	}
+
+	applied := make(map[uint64]SchemaMigration, len(schemaMigrations))
+	for _, schemaMigration := range schemaMigrations {
+		applied[schemaMigration.Version] = schemaMigration
+	}
+	return applied, nil
+}
+
+// checkUnknown makes sure every applied migration is known to this build.
+func (m *Migrator) checkUnknown(applied map[uint64]SchemaMigration) error {
+	known := make(map[uint64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+	}
+
+	for version, schemaMigration := range applied {
+		if !known[version] {
+			return fmt.Errorf("%w: %s migration %d (%s)", ErrUnknownMigration, m.service, version, schemaMigration.Name)
+		}
+	}
+	return nil
+}
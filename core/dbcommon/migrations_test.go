@@ -0,0 +1,125 @@
+package dbcommon_test
+
+import (
+	"bytes"
+	"testing/fstest"
+
+	"github.com/Flaque/filet"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+type migrationTestModel struct {
+	ID   uint64 `gorm:"column:id;primaryKey"`
+	Name string `gorm:"column:name"`
+}
+
+func (s *DbSuite) TestMigrations() {
+	gdb, err := dbcommon.Open(dbcommon.Sqlite, filet.TmpDir(s.T(), ""), dbcommon.SqliteFileName, schema.NamingStrategy{TablePrefix: "test_"})
+	s.Require().NoError(err)
+
+	sqlMigrations, err := dbcommon.LoadSQLMigrations(fstest.MapFS{
+		"migrations/0002_add_index.up.sql":   {Data: []byte("CREATE INDEX idx_name ON test_migration_test_models (name);")},
+		"migrations/0002_add_index.down.sql": {Data: []byte("DROP INDEX idx_name;")},
+		"migrations/0003_add_row.up.sql":     {Data: []byte("INSERT INTO test_migration_test_models (id, name) VALUES (1, 'a');")},
+		"migrations/README.md":               {Data: []byte("ignored")},
+	}, "migrations")
+	s.Require().NoError(err)
+	s.Require().Len(sqlMigrations, 2)
+	s.Nil(sqlMigrations[1].Down)
+
+	migrations := append([]dbcommon.Migration{dbcommon.AutoMigration(1, "initial", &migrationTestModel{})}, sqlMigrations...)
+	migrations = append(migrations, dbcommon.Migration{
+		Version: 4,
+		Name:    "rename",
+		Up: func(tx *gorm.DB) error {
+			return tx.Model(&migrationTestModel{}).Where("id = ?", 1).Update("name", "b").Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Model(&migrationTestModel{}).Where("id = ?", 1).Update("name", "a").Error
+		},
+	})
+
+	migrator, err := dbcommon.NewMigrator(gdb, "test", migrations)
+	s.Require().NoError(err)
+
+	pending, err := migrator.Pending(s.GetTestContext())
+	s.Require().NoError(err)
+	s.Len(pending, 4)
+
+	applied, err := migrator.Migrate(s.GetTestContext())
+	s.Require().NoError(err)
+	s.Len(applied, 4)
+
+	var model migrationTestModel
+	s.Require().NoError(gdb.First(&model, 1).Error)
+	s.Equal("b", model.Name)
+
+	// migrating again is a no-op
+	applied, err = migrator.Migrate(s.GetTestContext())
+	s.Require().NoError(err)
+	s.Empty(applied)
+
+	// 0003 has no down so it can't be rolled back past
+	_, err = migrator.Rollbacks(s.GetTestContext(), 2)
+	s.Require().Error(err)
+
+	rolledBack, err := migrator.Rollback(s.GetTestContext(), 3)
+	s.Require().NoError(err)
+	s.Len(rolledBack, 1)
+
+	s.Require().NoError(gdb.First(&model, 1).Error)
+	s.Equal("a", model.Name)
+
+	statuses, err := migrator.Status(s.GetTestContext())
+	s.Require().NoError(err)
+	s.Require().Len(statuses, 4)
+	s.NotNil(statuses[2].AppliedAt)
+	s.Nil(statuses[3].AppliedAt)
+
+	// an older build w/o migrations 0003 and 0004 shouldn't run against this schema
+	oldMigrator, err := dbcommon.NewMigrator(gdb, "test", migrations[:2])
+	s.Require().NoError(err)
+	_, err = oldMigrator.Migrate(s.GetTestContext())
+	s.Require().ErrorIs(err, dbcommon.ErrUnknownMigration)
+
+	// other services have their own versions
+	otherMigrator, err := dbcommon.NewMigrator(gdb, "other", migrations[:1])
+	s.Require().NoError(err)
+	applied, err = otherMigrator.Migrate(s.GetTestContext())
+	s.Require().NoError(err)
+	s.Len(applied, 1)
+
+	_, err = dbcommon.NewMigrator(gdb, "test", append(migrations, migrations[0]))
+	s.Require().Error(err)
+}
+
+func (s *DbSuite) TestMigrateCommand() {
+	dbPath := filet.TmpDir(s.T(), "")
+	var output bytes.Buffer
+	app := cli.NewApp()
+	app.Writer = &output
+	app.Commands = cli.Commands{dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+		Service: "test",
+		Migrations: []dbcommon.Migration{
+			dbcommon.AutoMigration(1, "initial", &migrationTestModel{}),
+			dbcommon.SQLMigration(2, "add_row", "INSERT INTO migration_test_models (id, name) VALUES (1, 'a');", "DELETE FROM migration_test_models WHERE id = 1;"),
+		},
+		Open: func(c *cli.Context) (*gorm.DB, error) {
+			return dbcommon.Open(dbcommon.Sqlite, dbPath, dbcommon.SqliteFileName, schema.NamingStrategy{})
+		},
+	})}
+
+	s.Require().NoError(app.RunContext(s.GetTestContext(), []string{"app", "migrate", "up", "--dry-run"}))
+	s.Contains(output.String(), "would apply 2\tadd_row\nINSERT INTO")
+
+	s.Require().NoError(app.RunContext(s.GetTestContext(), []string{"app", "migrate", "up"}))
+	s.Require().NoError(app.RunContext(s.GetTestContext(), []string{"app", "migrate", "down", "--to", "1"}))
+	s.Contains(output.String(), "rolled back 2\tadd_row")
+
+	output.Reset()
+	s.Require().NoError(app.RunContext(s.GetTestContext(), []string{"app", "migrate", "status"}))
+	s.Contains(output.String(), "2\tadd_row\tpending")
+}
@@ -0,0 +1,48 @@
+package dbcommon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var logger = log.Logger("dbcommon")
+
+// SqliteFileName is the name of the database file most stores create in a sqlite path.
+const SqliteFileName = "synapse.db"
+
+// Open opens a database w/o migrating it. This is used by tooling (e.g. the migrate command) that needs to
+// access a service's database directly. Sqlite paths are the directory containing sqliteFile, the same as
+// the service stores. namingStrategy should match the one used by the service's store.
+func Open(dbType DBType, path, sqliteFile string, namingStrategy schema.Namer) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch dbType {
+	case Mysql:
+		dialector = mysql.Open(path)
+	case Postgres:
+		dialector = postgres.Open(path)
+	case Sqlite:
+		dialector = sqlite.Open(fmt.Sprintf("%s/%s", path, sqliteFile))
+	case Clickhouse:
+		return nil, fmt.Errorf("driver not supported: %s", dbType)
+	default:
+		return nil, fmt.Errorf("unsupported driver: %s", dbType)
+	}
+
+	gdb, err := gorm.Open(dialector, &gorm.Config{
+		Logger:         GetGormLogger(logger),
+		NamingStrategy: namingStrategy,
+		NowFunc:        time.Now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s database: %w", dbType, err)
+	}
+
+	return gdb, nil
+}
@@ -0,0 +1,2 @@
+// Package postgrestest provides postgres databases for tests.
+package postgrestest
@@ -0,0 +1,101 @@
+package postgrestest
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dockerutil"
+)
+
+const (
+	// user is the user created in the container.
+	user = "postgres"
+	// password is the password of the user created in the container.
+	password = "postgres"
+	// database is the database created in the container.
+	database = "sanguine_test"
+)
+
+// GetConnString gets the connection string for a postgres test database. If dbcommon.PostgresHostVar is set, the
+// database configured in the environment is used. Otherwise, a postgres container is started w/ ory/dockertest
+// and purged once the test is done.
+func GetConnString(tb testing.TB) string {
+	tb.Helper()
+
+	if os.Getenv(dbcommon.PostgresHostVar) != "" {
+		return dbcommon.GetTestPostgresConnString()
+	}
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		tb.Fatalf("could not create docker pool: %v", err)
+	}
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "postgres",
+		Tag:        "16-alpine",
+		Env: []string{
+			"POSTGRES_USER=" + user,
+			"POSTGRES_PASSWORD=" + password,
+			"POSTGRES_DB=" + database,
+		},
+		Labels:       map[string]string{"postgres_test_" + tb.Name(): "1"},
+		ExposedPorts: []string{"5432/tcp"},
+	}, func(config *docker.HostConfig) {
+		// set AutoRemove to true so that stopped container goes away by itself
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		tb.Fatalf("could not start postgres: %v", err)
+	}
+
+	tb.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			tb.Logf("could not purge postgres: %v", err)
+		}
+	})
+
+	// Docker will hard kill the container in 360 seconds (this is a test env).
+	// In a continuous integration environment, this is increased to allow for the lower cpu count
+	resourceLifetime := uint(360)
+	pool.MaxWait = time.Minute * 2
+
+	if os.Getenv("CI") != "" {
+		resourceLifetime = 900
+		pool.MaxWait = time.Minute * 5
+	}
+
+	if err := resource.Expire(resourceLifetime); err != nil {
+		tb.Fatalf("could not set postgres expiry: %v", err)
+	}
+
+	// the entrypoint starts a temporary server w/o tcp while initializing the database, so checking tcp here
+	// makes sure we connect to the final server.
+	err = pool.Retry(func() error {
+		exitCode, err := resource.Exec([]string{"pg_isready", "-h", "127.0.0.1", "-U", user, "-d", database}, dockertest.ExecOptions{})
+		if err != nil {
+			return fmt.Errorf("could not check postgres: %w", err)
+		}
+		if exitCode != 0 {
+			return fmt.Errorf("postgres is not ready, pg_isready exited with %d", exitCode)
+		}
+		return nil
+	})
+	if err != nil {
+		tb.Fatalf("could not connect to postgres: %v", err)
+	}
+
+	port, err := strconv.Atoi(dockerutil.GetPort(resource, "5432/tcp"))
+	if err != nil {
+		tb.Fatalf("could not parse postgres port: %v", err)
+	}
+
+	return dbcommon.PostgresConnString(user, password, "localhost", port, database)
+}
@@ -0,0 +1,75 @@
+package dbcommon
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sqlMigrationRegex matches sql migration file names, e.g. 0002_add_index.up.sql.
+var sqlMigrationRegex = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// LoadSQLMigrations loads sql migrations from a directory in a filesystem (usually an embed.FS). Files are named
+// <version>_<name>.up.sql and <version>_<name>.down.sql, down files are optional. Other files are ignored.
+func LoadSQLMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read migrations dir %s: %w", dir, err)
+	}
+
+	type sqlFiles struct {
+		name     string
+		up, down string
+	}
+	byVersion := make(map[uint64]*sqlFiles)
+
+	for _, entry := range entries {
+		matches := sqlMigrationRegex.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse version of %s: %w", entry.Name(), err)
+		}
+
+		contents, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", entry.Name(), err)
+		}
+
+		files, ok := byVersion[version]
+		if !ok {
+			files = &sqlFiles{name: matches[2]}
+			byVersion[version] = files
+		}
+		if files.name != matches[2] {
+			return nil, fmt.Errorf("migration %d has files w/ different names: %s and %s", version, files.name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			files.up = strings.TrimSpace(string(contents))
+		} else {
+			files.down = strings.TrimSpace(string(contents))
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for version, files := range byVersion {
+		if files.up == "" {
+			return nil, fmt.Errorf("migration %d (%s) has no up file", version, files.name)
+		}
+		migrations = append(migrations, SQLMigration(version, files.name, files.up, files.down))
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -27,3 +27,30 @@
 func GetTestConnString() string {
 	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", core.GetEnv(MysqlUserVar, "root"), os.Getenv(MysqlPasswordVar), core.GetEnv(MysqlHostVar, "127.0.0.1"), core.GetEnvInt(MysqlPortVar, 3306), os.Getenv(MysqlDatabaseVar))
 }
+
+const (
+	// EnablePostgresTestVar is the environment variable to enable postgres tests.
+	EnablePostgresTestVar = "ENABLE_POSTGRES_TEST"
+	// PostgresDatabaseVar is the environment variable for the postgres database name.
+	PostgresDatabaseVar = "POSTGRES_DATABASE"
+	// PostgresUserVar is the environment variable for the postgres user.
+	PostgresUserVar = "POSTGRES_USER"
+	// PostgresPasswordVar is the environment variable for the postgres password.
+	PostgresPasswordVar = "POSTGRES_PASSWORD"
+	// PostgresHostVar is the environment variable for the postgres host. If this is not set, tests
+	// start a postgres container instead.
+	PostgresHostVar = "POSTGRES_HOST"
+	// PostgresPortVar is the environment variable for the postgres port.
+	PostgresPortVar = "POSTGRES_PORT"
+)
+
+// GetTestPostgresConnString returns the connection string for the postgres test database.
+// this is derived from environment variables.
+func GetTestPostgresConnString() string {
+	return PostgresConnString(core.GetEnv(PostgresUserVar, "postgres"), os.Getenv(PostgresPasswordVar), core.GetEnv(PostgresHostVar, "127.0.0.1"), core.GetEnvInt(PostgresPortVar, 5432), core.GetEnv(PostgresDatabaseVar, "postgres"))
+}
+
+// PostgresConnString builds a postgres connection string.
+func PostgresConnString(user, password, host string, port int, database string) string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable", host, user, password, database, port)
+}
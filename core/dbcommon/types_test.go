@@ -0,0 +1,24 @@
+package dbcommon_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+)
+
+func TestDBTypeFromString(t *testing.T) {
+	for _, dbType := range dbcommon.AllDBTypes {
+		parsed, err := dbcommon.DBTypeFromString(strings.ToUpper(dbType.String()))
+		Nil(t, err)
+		Equal(t, dbType, parsed)
+	}
+
+	parsed, err := dbcommon.DBTypeFromString("postgres")
+	Nil(t, err)
+	Equal(t, dbcommon.Postgres, parsed)
+
+	_, err = dbcommon.DBTypeFromString("oracle")
+	NotNil(t, err)
+}
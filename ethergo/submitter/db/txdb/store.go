@@ -32,6 +32,11 @@ type Store struct {
 	metrics metrics.Handler
 }
 
+// quote quotes a table or column name for the dialect in use, e.g. `name` on mysql and "name" on postgres.
+func (s *Store) quote(name string) string {
+	return s.db.Statement.Quote(name)
+}
+
 // DBTransaction is a function that can be used to execute a transaction on the database.
 func (s *Store) DBTransaction(parentCtx context.Context, f db.TransactionFunc) (err error) {
 	ctx, span := s.metrics.Tracer().Start(parentCtx, "db_transaction")
@@ -51,7 +56,7 @@ func (s *Store) MarkAllBeforeOrAtNonceReplacedOrConfirmed(ctx context.Context, s
 	dbTX := s.db.WithContext(ctx).Model(&ETHTX{}).
 		Where(fmt.Sprintf("%s = ?", chainIDFieldName), chainID.Uint64()).
 		Where(fmt.Sprintf("%s <= ?", nonceFieldName), nonce).
-		Where(fmt.Sprintf("`%s` = ?", fromFieldName), signer.String()).
+		Where(fmt.Sprintf("%s = ?", s.quote(fromFieldName)), signer.String()).
 		// just in case we're updating a tx already marked as confirmed
 		Where(fmt.Sprintf("%s IN ?", statusFieldName), []int{int(db.Submitted.Int()), int(db.FailedSubmit.Int())}).
 		Updates(map[string]interface{}{statusFieldName: db.ReplacedOrConfirmed.Int()})
@@ -128,10 +133,10 @@ func (s *Store) GetTXS(ctx context.Context, fromAddress common.Address, chainID
 		Limit(MaxResultsPerChain)
 
 	joinQuery, err := interpol.WithMap(
-		"INNER JOIN (?) as subquery on `{table}`.`{id}` = `subquery`.`{id}` AND `{table}`.`{chainID}` = `subquery`.`{chainID}`", map[string]string{
-			"table":   tableName,
-			"id":      idFieldName,
-			"chainID": chainIDFieldName,
+		"INNER JOIN (?) as subquery on {table}.{id} = subquery.{id} AND {table}.{chainID} = subquery.{chainID}", map[string]string{
+			"table":   s.quote(tableName),
+			"id":      s.quote(idFieldName),
+			"chainID": s.quote(chainIDFieldName),
 		},
 	)
 	if err != nil {
@@ -184,10 +189,10 @@ func (s *Store) GetAllTXAttemptByStatus(ctx context.Context, fromAddress common.
 
 	// one consequence of innerjoining on nonce is we can't cap the max results for the whole query. This is a known limitation
 	joinQuery, err := interpol.WithMap(
-		"INNER JOIN (?) as subquery on `{table}`.`{nonce}` = `subquery`.`{nonce}` AND `{table}`.`{chainID}` = `subquery`.`{chainID}`", map[string]string{
-			"table":   tableName,
-			"nonce":   nonceFieldName,
-			"chainID": chainIDFieldName,
+		"INNER JOIN (?) as subquery on {table}.{nonce} = subquery.{nonce} AND {table}.{chainID} = subquery.{chainID}", map[string]string{
+			"table":   s.quote(tableName),
+			"nonce":   s.quote(nonceFieldName),
+			"chainID": s.quote(chainIDFieldName),
 		},
 	)
 	if err != nil {
@@ -217,7 +222,7 @@ func (s *Store) GetAllTXAttemptByStatus(ctx context.Context, fromAddress common.
 func (s *Store) GetNonceForChainID(ctx context.Context, fromAddress common.Address, chainID *big.Int) (nonce uint64, err error) {
 	var newNonce sql.NullInt64
 
-	selectMaxNonce := fmt.Sprintf("max(`%s`)", nonceFieldName)
+	selectMaxNonce := fmt.Sprintf("max(%s)", s.quote(nonceFieldName))
 
 	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).Select(selectMaxNonce).Where(ETHTX{
 		From:    fromAddress.String(),
@@ -309,7 +314,7 @@ func (s Store) PutTXS(ctx context.Context, txs ...db.TX) error {
 func (s *Store) GetNonceStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64) (status db.Status, err error) {
 	var maxStatus sql.NullInt32
 
-	selectMaxStatus := fmt.Sprintf("max(`%s`)", statusFieldName)
+	selectMaxStatus := fmt.Sprintf("max(%s)", s.quote(statusFieldName))
 
 	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).Select(selectMaxStatus).Where(ETHTX{
 		From:    fromAddress.String(),
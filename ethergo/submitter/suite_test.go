@@ -18,6 +18,7 @@
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/config"
 	common_base "github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dbcommon/postgrestest"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/processlog"
@@ -34,6 +35,7 @@
 	"github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -163,7 +165,7 @@ func TestSubmitterSuite(t *testing.T) {
 	suite.Run(t, NewSubmitterSuite(t))
 }
 
-// TXSubmitterDBSuite is used to test db queries across mysql and sqlite
+// TXSubmitterDBSuite is used to test db queries across mysql, postgres and sqlite
 // this is ran here rather than in the db package to avoid having to export the sqlite
 // setup query to avoid confusion about how to use the library.
 type TXSubmitterDBSuite struct {
@@ -173,6 +175,8 @@ type TXSubmitterDBSuite struct {
 	testBackends []backends.SimulatedTestBackend
 	managers     map[uint]nonce.Manager
 	mockAccounts []*keystore.Key
+	// postgresConnString is the postgres database used when postgres tests are enabled
+	postgresConnString string
 }
 
 func NewTXSubmitterDBSuite(tb testing.TB) *TXSubmitterDBSuite {
@@ -201,6 +205,10 @@ func (t *TXSubmitterDBSuite) SetupSuite() {
 	var err error
 	t.metrics, err = metrics.NewByType(t.GetSuiteContext(), buildInfo, metricsHandler)
 	t.Require().NoError(err)
+
+	if os.Getenv(common_base.EnablePostgresTestVar) != "" {
+		t.postgresConnString = postgrestest.GetConnString(t.T())
+	}
 }
 
 func (t *TXSubmitterDBSuite) SetupTest() {
@@ -210,6 +218,7 @@ func (t *TXSubmitterDBSuite) SetupTest() {
 	t.testBackends = []backends.SimulatedTestBackend{}
 
 	t.setupMysqlDB()
+	t.setupPostgresDB()
 
 	sqliteStore, err := NewSqliteStore(t.GetTestContext(), filet.TmpDir(t.T(), ""), t.metrics)
 	t.Require().NoError(err)
@@ -287,6 +296,18 @@ func (t *TXSubmitterDBSuite) setupMysqlDB() {
 	t.dbs = append(t.dbs, mysqlStore)
 }
 
+func (t *TXSubmitterDBSuite) setupPostgresDB() {
+	// skip if postgres test disabled
+	if t.postgresConnString == "" {
+		return
+	}
+
+	postgresStore, err := NewPostgresStore(t.GetTestContext(), t.postgresConnString, t.metrics)
+	t.Require().NoError(err)
+
+	t.dbs = append(t.dbs, postgresStore)
+}
+
 // connString gets the mysql connection string.
 func (t *TXSubmitterDBSuite) connString(dbname string) string {
 	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", core.GetEnv("MYSQL_USER", "root"), os.Getenv("MYSQL_PASSWORD"), core.GetEnv("MYSQL_HOST", "127.0.0.1"), core.GetEnvInt("MYSQL_PORT", 3306), dbname)
@@ -346,6 +367,41 @@ func NewMysqlStore(parentCtx context.Context, dbURL string, handler metrics.Hand
 	return &Store{txdb.NewTXStore(gdb, handler)}, nil
 }
 
+// NewPostgresStore creates a new postgres data store. It emulates the way another caller would create a store.
+func NewPostgresStore(parentCtx context.Context, dbURL string, handler metrics.Handler) (_ *Store, err error) {
+	logger := log.Logger("postgres-store")
+
+	ctx, span := handler.Tracer().Start(parentCtx, "start-postgres")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	// postgres truncates identifiers over 63 characters, so this is kept shorter than the mysql prefix
+	namingStrategy := schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("test%d_", gofakeit.Uint32()),
+	}
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:                 common_base.GetGormLogger(logger),
+		FullSaveAssociations:   true,
+		NamingStrategy:         namingStrategy,
+		NowFunc:                time.Now,
+		SkipDefaultTransaction: true,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	handler.AddGormCallbacks(gdb)
+
+	err = gdb.WithContext(ctx).AutoMigrate(txdb.GetAllModels()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+	}
+	return &Store{txdb.NewTXStore(gdb, handler)}, nil
+}
+
 // NewSqliteStore creates a new sqlite data store.
 func NewSqliteStore(parentCtx context.Context, dbPath string, handler metrics.Handler) (_ *Store, err error) {
 	logger := log.Logger("sqlite-store")
@@ -11,10 +11,13 @@
 	"github.com/synapsecns/sanguine/services/cctp-relayer/attestation"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/base"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/relayer"
 	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	"github.com/synapsecns/sanguine/services/scribe/client"
 	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 var configFlag = &cli.StringFlag{
@@ -25,7 +28,7 @@
 
 var dbFlag = &cli.StringFlag{
 	Name:     "db",
-	Usage:    "--db <sqlite> or <mysql>",
+	Usage:    "--db <sqlite>, <mysql> or <postgres>",
 	Value:    "sqlite",
 	Required: true,
 }
@@ -94,3 +97,19 @@
 		return nil
 	},
 }
+
+// migrateCommand runs versioned migrations on the relayer database.
+var migrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{dbFlag, pathFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		dbType, err := dbcommon.DBTypeFromString(c.String(dbFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, core.ExpandOrReturnPath(c.String(pathFlag.Name)), dbcommon.SqliteFileName, schema.NamingStrategy{})
+	},
+})
@@ -6,6 +6,7 @@
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"gorm.io/gorm/clause"
 
 	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
@@ -42,16 +43,16 @@ func (s Store) StoreMessage(ctx context.Context, msg types.Message) error {
 
 	switch msg.State {
 	case types.Pending:
-		// ignore queries don't work w/ sqlite so we need to adjust this to do nothing
-		if s.db.Dialector.Name() == "sqlite" {
+		// ignore queries only work w/ mysql so we need to adjust this to do nothing on sqlite/postgres
+		if s.db.Dialector.Name() == dbcommon.Mysql.String() {
+			clauses = clause.Insert{
+				Modifier: "IGNORE",
+			}
+		} else {
 			clauses = clause.OnConflict{
 				Columns:   []clause.Column{{Name: MessageHashFieldName}},
 				DoNothing: true,
 			}
-		} else {
-			clauses = clause.Insert{
-				Modifier: "IGNORE",
-			}
 		}
 	case types.Attested:
 		clauses = clause.OnConflict{
@@ -12,12 +12,14 @@
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dbcommon/postgrestest"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/mysql"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/postgres"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/metadata"
 	"gorm.io/gorm/schema"
 )
@@ -26,6 +28,8 @@ type DBSuite struct {
 	*testsuite.TestSuite
 	dbs     []db.CCTPRelayerDB
 	metrics metrics.Handler
+	// postgresConnString is the postgres database used when postgres tests are enabled
+	postgresConnString string
 }
 
 // NewDBSuite creates a new DBSuite.
@@ -52,6 +56,10 @@ func (d *DBSuite) SetupSuite() {
 	var err error
 	d.metrics, err = metrics.NewByType(d.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
 	Nil(d.T(), err)
+
+	if os.Getenv(dbcommon.EnablePostgresTestVar) == "true" {
+		d.postgresConnString = postgrestest.GetConnString(d.T())
+	}
 }
 
 func (d *DBSuite) SetupTest() {
@@ -62,6 +70,7 @@ func (d *DBSuite) SetupTest() {
 
 	d.dbs = []db.CCTPRelayerDB{sqliteStore}
 	d.setupMysqlDB()
+	d.setupPostgresDB()
 }
 
 func (d *DBSuite) setupMysqlDB() {
@@ -89,6 +98,21 @@ func (d *DBSuite) setupMysqlDB() {
 	d.dbs = append(d.dbs, mysqlStore)
 }
 
+func (d *DBSuite) setupPostgresDB() {
+	if d.postgresConnString == "" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("cctp_%d", d.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(d.GetTestContext(), d.postgresConnString, d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs = append(d.dbs, postgresStore)
+}
+
 func (d *DBSuite) RunOnAllDBs(testFunc func(testDB db.CCTPRelayerDB)) {
 	d.T().Helper()
 
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+var logger = log.Logger("omnirpc-cache")
+
+// Cache is a response cache.
+type Cache interface {
+	// Get gets a response by key.
+	Get(key string) (value []byte, ok bool)
+	// Put stores a response by key.
+	Put(key string, value []byte) error
+	// Close closes the cache and any underlying stores.
+	Close() error
+}
+
+// NewCache creates a new cache from the config. An in memory lru is always used,
+// if a disk path is set responses are persisted to disk as well.
+func NewCache(cfg config.CacheConfig) (Cache, error) {
+	memCache, err := lru.New(cfg.Size)
+	if err != nil {
+		return nil, fmt.Errorf("could not create lru: %w", err)
+	}
+
+	c := &cacheImpl{
+		memCache: memCache,
+	}
+
+	if cfg.DiskPath != "" {
+		c.diskStore, err = newDiskStore(cfg.DiskPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not create disk store: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// cacheImpl is a tiered cache. Memory is checked first, then disk.
+type cacheImpl struct {
+	// memCache is the in memory lru
+	memCache *lru.Cache
+	// diskStore is the optional on disk store
+	diskStore *diskStore
+}
+
+func (c *cacheImpl) Get(key string) ([]byte, bool) {
+	if value, ok := c.memCache.Get(key); ok {
+		//nolint: forcetypeassert
+		return value.([]byte), true
+	}
+
+	if c.diskStore == nil {
+		return nil, false
+	}
+
+	value, ok := c.diskStore.get(key)
+	if !ok {
+		return nil, false
+	}
+
+	// promote to memory so subsequent gets don't hit the disk
+	c.memCache.Add(key, value)
+	return value, true
+}
+
+func (c *cacheImpl) Put(key string, value []byte) error {
+	c.memCache.Add(key, value)
+
+	if c.diskStore == nil {
+		return nil
+	}
+
+	err := c.diskStore.put(key, value)
+	if err != nil {
+		return fmt.Errorf("could not persist %s: %w", key, err)
+	}
+	return nil
+}
+
+func (c *cacheImpl) Close() error {
+	c.memCache.Purge()
+
+	if c.diskStore == nil {
+		return nil
+	}
+
+	return c.diskStore.close()
+}
+
+var _ Cache = &cacheImpl{}
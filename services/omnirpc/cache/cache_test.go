@@ -0,0 +1,60 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/cache"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c, err := cache.NewCache(config.CacheConfig{Size: 2})
+	Nil(t, err)
+
+	_, ok := c.Get("missing")
+	False(t, ok)
+
+	Nil(t, c.Put("a", []byte("1")))
+	Nil(t, c.Put("b", []byte("2")))
+	Nil(t, c.Put("c", []byte("3")))
+
+	// a should have been evicted
+	_, ok = c.Get("a")
+	False(t, ok)
+
+	res, ok := c.Get("c")
+	True(t, ok)
+	Equal(t, []byte("3"), res)
+
+	Nil(t, c.Close())
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := filet.TmpDir(t, "")
+	key := gofakeit.UUID()
+	value := []byte(gofakeit.Sentence(10))
+
+	c, err := cache.NewCache(config.CacheConfig{Size: 1, DiskPath: dir})
+	Nil(t, err)
+
+	Nil(t, c.Put(key, value))
+	// evict from memory
+	Nil(t, c.Put(gofakeit.UUID(), []byte(gofakeit.Word())))
+
+	res, ok := c.Get(key)
+	True(t, ok)
+	Equal(t, value, res)
+	Nil(t, c.Close())
+
+	// make sure responses survive a restart
+	c, err = cache.NewCache(config.CacheConfig{Size: 1, DiskPath: dir})
+	Nil(t, err)
+
+	res, ok = c.Get(key)
+	True(t, ok)
+	Equal(t, value, res)
+	Nil(t, c.Close())
+}
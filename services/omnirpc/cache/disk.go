@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// diskStore persists responses in a leveldb database.
+type diskStore struct {
+	db *leveldb.DB
+}
+
+func newDiskStore(path string) (*diskStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not open leveldb at %s: %w", path, err)
+	}
+
+	return &diskStore{db: db}, nil
+}
+
+func (d *diskStore) get(key string) ([]byte, bool) {
+	value, err := d.db.Get([]byte(key), nil)
+	if err != nil {
+		if !errors.Is(err, leveldb.ErrNotFound) {
+			logger.Warnf("could not read %s from disk: %v", key, err)
+		}
+		return nil, false
+	}
+	return value, true
+}
+
+func (d *diskStore) put(key string, value []byte) error {
+	//nolint: wrapcheck
+	return d.db.Put([]byte(key), value, nil)
+}
+
+func (d *diskStore) close() error {
+	//nolint: wrapcheck
+	return d.db.Close()
+}
@@ -0,0 +1,3 @@
+// Package cache stores rpc responses that can never change (e.g. blocks below the confirmation depth)
+// so they don't need to be fetched from upstreams again.
+package cache
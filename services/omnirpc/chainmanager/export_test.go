@@ -1,8 +1,23 @@
 package chainmanager
 
-import "github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
+import (
+	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
+	"time"
+)
 
 // SortInfoList exports sortInfoList for testing.
 func SortInfoList(rpcInfoList []rpcinfo.Result) []rpcinfo.Result {
 	return sortInfoList(rpcInfoList)
 }
+
+// SetRPCInfo exports setRPCInfo for testing.
+func SetRPCInfo(c Chain, rpcInfoList []rpcinfo.Result, now time.Time) {
+	//nolint: forcetypeassert
+	c.(*chain).setRPCInfo(rpcInfoList, now)
+}
+
+// SetHistory exports setHistory for testing.
+func SetHistory(c Chain, historyList []rpcinfo.HistoryResult, now time.Time) {
+	//nolint: forcetypeassert
+	c.(*chain).setHistory(historyList, now)
+}
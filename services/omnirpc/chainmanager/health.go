@@ -0,0 +1,337 @@
+package chainmanager
+
+import (
+	"math"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
+)
+
+// HealthStatus is the status of an upstream.
+type HealthStatus string
+
+const (
+	// Healthy upstreams are ordered by score.
+	Healthy HealthStatus = "healthy"
+	// Probation upstreams were recently ejected. They're used after all healthy upstreams
+	// until they've proven themselves again.
+	Probation HealthStatus = "probation"
+	// Ejected upstreams are not used unless there are no other upstreams.
+	Ejected HealthStatus = "ejected"
+	// Drained upstreams were removed from rotation by an operator and are never used.
+	Drained HealthStatus = "drained"
+)
+
+// NodeType is the type of node an upstream is, based on how much state history it serves.
+type NodeType string
+
+const (
+	// UnknownNode upstreams haven't had their state history probed yet.
+	UnknownNode NodeType = "unknown"
+	// ArchiveNode upstreams can serve state for every block.
+	ArchiveNode NodeType = "archive"
+	// FullNode upstreams can only serve state for recent blocks.
+	FullNode NodeType = "full"
+)
+
+const (
+	// defaultMaxBlockLag is the default number of blocks an upstream can lag before being ejected.
+	defaultMaxBlockLag = 50
+	// defaultMaxErrorRate is the default error rate an upstream is ejected at.
+	defaultMaxErrorRate = 0.5
+	// defaultEjectionDuration is the default amount of time an upstream is ejected for.
+	defaultEjectionDuration = time.Minute * 5
+	// errorWindowSize is the number of recent results used to compute the error rate.
+	errorWindowSize = 50
+	// minErrorSamples is the minimum number of results needed before an upstream can be ejected for errors.
+	minErrorSamples = 10
+	// probationSuccesses is the number of consecutive successes needed to leave probation.
+	probationSuccesses = 5
+	// latencyEWMAWeight is the weight given to new latency samples.
+	latencyEWMAWeight = 0.2
+)
+
+// score weights, these sum to 1.
+const (
+	lagWeight     = 0.4
+	errorWeight   = 0.4
+	latencyWeight = 0.2
+)
+
+// healthConfig is the parsed health config w/ defaults applied.
+type healthConfig struct {
+	maxBlockLag      uint64
+	maxErrorRate     float64
+	ejectionDuration time.Duration
+}
+
+func newHealthConfig(cfg config.HealthConfig) healthConfig {
+	hc := healthConfig{
+		maxBlockLag:      cfg.MaxBlockLag,
+		maxErrorRate:     cfg.MaxErrorRate,
+		ejectionDuration: time.Duration(cfg.EjectionDuration) * time.Second,
+	}
+
+	if hc.maxBlockLag == 0 {
+		hc.maxBlockLag = defaultMaxBlockLag
+	}
+
+	if hc.maxErrorRate == 0 {
+		hc.maxErrorRate = defaultMaxErrorRate
+	}
+
+	if hc.ejectionDuration == 0 {
+		hc.ejectionDuration = defaultEjectionDuration
+	}
+
+	return hc
+}
+
+// UpstreamHealth is a snapshot of the health of a single upstream.
+type UpstreamHealth struct {
+	// URL is the upstream url
+	URL string `json:"url"`
+	// Status is the current status of the upstream
+	Status HealthStatus `json:"status"`
+	// Score is the health score from 0 (worst) to 1 (best)
+	Score float64 `json:"score"`
+	// BlockNumber is the last block number seen from the upstream
+	BlockNumber uint64 `json:"block_number"`
+	// HeadLag is how many blocks the upstream is behind the best known head
+	HeadLag uint64 `json:"head_lag"`
+	// Latency is the rolling average latency
+	Latency time.Duration `json:"latency"`
+	// ErrorRate is the rolling error rate
+	ErrorRate float64 `json:"error_rate"`
+	// EjectedUntil is when the upstream will be put on probation if it's ejected
+	EjectedUntil *time.Time `json:"ejected_until,omitempty"`
+	// LastError is the last error received from the upstream
+	LastError string `json:"last_error,omitempty"`
+	// NodeType is whether the upstream is an archive or full node
+	NodeType NodeType `json:"node_type"`
+	// HistoryWindow is approximately how many blocks behind the head the upstream can serve state for
+	HistoryWindow uint64 `json:"history_window,omitempty"`
+}
+
+// upstreamHealth tracks the health of a single upstream.
+type upstreamHealth struct {
+	// url is the upstream url
+	url string
+	// status is the current status
+	status HealthStatus
+	// blockNumber is the last block number seen
+	blockNumber uint64
+	// latency is an exponentially weighted moving average of latency
+	latency time.Duration
+	// results is a ring buffer of recent results, true indicates an error
+	results [errorWindowSize]bool
+	// resultCount is the total number of results recorded
+	resultCount int
+	// consecutiveSuccesses is the number of successes since the last error
+	consecutiveSuccesses int
+	// ejectedUntil is when the ejection ends
+	ejectedUntil time.Time
+	// lastErr is the last error
+	lastErr error
+	// drained is set if the upstream was drained by an operator. Health is still tracked while drained.
+	drained bool
+	// nodeType is set once state history has been probed
+	nodeType NodeType
+	// historyWindow is approximately how many blocks behind the head the upstream can serve state for
+	historyWindow uint64
+	// historyCheckedAt is when state history was last successfully probed
+	historyCheckedAt time.Time
+}
+
+func newUpstreamHealth(url string) *upstreamHealth {
+	return &upstreamHealth{
+		url:      url,
+		status:   Healthy,
+		nodeType: UnknownNode,
+	}
+}
+
+// errorRate gets the rolling error rate.
+func (u *upstreamHealth) errorRate() float64 {
+	samples := u.resultCount
+	if samples > errorWindowSize {
+		samples = errorWindowSize
+	}
+
+	if samples == 0 {
+		return 0
+	}
+
+	errCount := 0
+	for i := 0; i < samples; i++ {
+		if u.results[i] {
+			errCount++
+		}
+	}
+
+	return float64(errCount) / float64(samples)
+}
+
+// recordLatency adds a latency sample to the moving average.
+func (u *upstreamHealth) recordLatency(latency time.Duration) {
+	if u.latency == 0 {
+		u.latency = latency
+		return
+	}
+
+	u.latency = time.Duration(latencyEWMAWeight*float64(latency) + (1-latencyEWMAWeight)*float64(u.latency))
+}
+
+// recordResult records the result of a request.
+func (u *upstreamHealth) recordResult(latency time.Duration, err error) {
+	u.results[u.resultCount%errorWindowSize] = err != nil
+	u.resultCount++
+
+	if err != nil {
+		u.lastErr = err
+		u.consecutiveSuccesses = 0
+		return
+	}
+
+	u.consecutiveSuccesses++
+	u.recordLatency(latency)
+}
+
+// recordInfo records the result of a latency check.
+func (u *upstreamHealth) recordInfo(info rpcinfo.Result) {
+	if info.HasError {
+		u.recordResult(0, info.Error)
+		return
+	}
+
+	u.blockNumber = info.BlockNumber
+	u.recordResult(info.Latency, nil)
+}
+
+// recordHistory records the result of a state history probe. Failed probes are ignored so
+// the previous node type is kept until the next probe.
+func (u *upstreamHealth) recordHistory(history rpcinfo.HistoryResult, now time.Time) {
+	if history.HasError {
+		return
+	}
+
+	u.nodeType = FullNode
+	if history.Archive {
+		u.nodeType = ArchiveNode
+	}
+	u.historyWindow = history.Window
+	u.historyCheckedAt = now
+}
+
+// servesDepth checks if the upstream is known to serve state depth blocks behind the head.
+func (u *upstreamHealth) servesDepth(depth uint64) bool {
+	switch u.nodeType {
+	case ArchiveNode:
+		return true
+	case FullNode:
+		return u.historyWindow >= depth
+	}
+	return false
+}
+
+// headLag gets how far behind the head the upstream is.
+func (u *upstreamHealth) headLag(head uint64) uint64 {
+	if u.blockNumber >= head {
+		return 0
+	}
+	return head - u.blockNumber
+}
+
+// updateStatus moves the upstream between statuses.
+func (u *upstreamHealth) updateStatus(cfg healthConfig, head uint64, now time.Time) {
+	unhealthy := u.headLag(head) > cfg.maxBlockLag ||
+		(u.resultCount >= minErrorSamples && u.errorRate() >= cfg.maxErrorRate)
+
+	switch u.status {
+	case Healthy:
+		if unhealthy {
+			u.eject(cfg, now)
+		}
+	case Ejected:
+		if now.After(u.ejectedUntil) {
+			u.status = Probation
+			u.consecutiveSuccesses = 0
+		}
+	case Probation:
+		// any error on probation results in an ejection
+		if u.resultCount > 0 && u.results[(u.resultCount-1)%errorWindowSize] {
+			u.eject(cfg, now)
+			return
+		}
+
+		if u.consecutiveSuccesses >= probationSuccesses && u.headLag(head) <= cfg.maxBlockLag {
+			u.status = Healthy
+			// start w/ a clean error window so old errors don't result in an immediate ejection
+			u.results = [errorWindowSize]bool{}
+			u.resultCount = 0
+		}
+	}
+}
+
+func (u *upstreamHealth) eject(cfg healthConfig, now time.Time) {
+	u.status = Ejected
+	u.ejectedUntil = now.Add(cfg.ejectionDuration)
+	u.consecutiveSuccesses = 0
+}
+
+// score computes a score from 0 to 1 where higher is better.
+func (u *upstreamHealth) score(cfg healthConfig, head uint64) float64 {
+	lagPenalty := math.Min(float64(u.headLag(head))/float64(cfg.maxBlockLag), 1)
+	latencyPenalty := math.Min(float64(u.latency)/float64(rpcTimeout), 1)
+
+	return 1 - (lagWeight*lagPenalty + errorWeight*u.errorRate() + latencyWeight*latencyPenalty)
+}
+
+// snapshot gets the exported health.
+func (u *upstreamHealth) snapshot(cfg healthConfig, head uint64) UpstreamHealth {
+	res := UpstreamHealth{
+		URL:         u.url,
+		Status:      u.status,
+		Score:       u.score(cfg, head),
+		BlockNumber: u.blockNumber,
+		HeadLag:     u.headLag(head),
+		Latency:     u.latency,
+		ErrorRate:   u.errorRate(),
+		NodeType:    u.nodeType,
+	}
+
+	if u.nodeType == FullNode {
+		res.HistoryWindow = u.historyWindow
+	}
+
+	if u.status == Ejected {
+		ejectedUntil := u.ejectedUntil
+		res.EjectedUntil = &ejectedUntil
+	}
+
+	if u.lastErr != nil {
+		res.LastError = u.lastErr.Error()
+	}
+
+	if u.drained {
+		res.Status = Drained
+	}
+
+	return res
+}
+
+// statusRank is used to order upstreams by status.
+func statusRank(status HealthStatus) int {
+	switch status {
+	case Healthy:
+		return 0
+	case Probation:
+		return 1
+	case Ejected:
+		return 2
+	case Drained:
+		return 3
+	}
+	return 4
+}
@@ -0,0 +1,156 @@
+package chainmanager_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/metadata"
+	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
+)
+
+func newTestChain(t *testing.T, urls ...string) chainmanager.Chain {
+	t.Helper()
+
+	nullHandler, err := metrics.NewByType(context.Background(), metadata.BuildInfo(), metrics.Null)
+	NoError(t, err)
+
+	cm := chainmanager.NewChainManager(nullHandler)
+	chainID := gofakeit.Uint32()
+	cm.PutChain(chainID, urls, 1)
+
+	return cm.GetChain(chainID)
+}
+
+func TestLaggingUpstreamEjected(t *testing.T) {
+	chain := newTestChain(t, "fast-lagging", "slow-synced")
+
+	chainmanager.SetRPCInfo(chain, []rpcinfo.Result{
+		{URL: "fast-lagging", Latency: time.Millisecond, BlockNumber: 800},
+		{URL: "slow-synced", Latency: time.Second, BlockNumber: 1000},
+	}, time.Now())
+
+	Equal(t, []string{"slow-synced"}, chain.URLs())
+	Equal(t, uint64(1000), chain.LatestBlockNumber())
+
+	health := chain.Health()
+	Equal(t, chainmanager.Ejected, health[1].Status)
+	Equal(t, uint64(200), health[1].HeadLag)
+	NotNil(t, health[1].EjectedUntil)
+}
+
+func TestErroringUpstreamEjected(t *testing.T) {
+	chain := newTestChain(t, "flaky", "stable")
+
+	for i := 0; i < 20; i++ {
+		chain.RecordResult("flaky", time.Millisecond, errors.New("rate limited"))
+		chain.RecordResult("stable", time.Millisecond*100, nil)
+	}
+
+	Equal(t, []string{"stable"}, chain.URLs())
+
+	health := chain.Health()
+	Equal(t, "flaky", health[1].URL)
+	Equal(t, chainmanager.Ejected, health[1].Status)
+	InDelta(t, 1, health[1].ErrorRate, 0.001)
+	Equal(t, "rate limited", health[1].LastError)
+}
+
+func TestAllEjectedFallback(t *testing.T) {
+	chain := newTestChain(t, "a", "b")
+
+	for i := 0; i < 20; i++ {
+		chain.RecordResult("a", time.Millisecond, errors.New("down"))
+		chain.RecordResult("b", time.Millisecond, errors.New("down"))
+	}
+
+	// we should still try something
+	Len(t, chain.URLs(), 2)
+}
+
+func TestConfirmationURLs(t *testing.T) {
+	chain := newTestChain(t, "flaky", "stable", "steady")
+
+	for i := 0; i < 20; i++ {
+		chain.RecordResult("flaky", time.Millisecond, errors.New("rate limited"))
+		chain.RecordResult("stable", time.Millisecond, nil)
+		chain.RecordResult("steady", time.Millisecond*2, nil)
+	}
+
+	// ejected upstreams are only used if there aren't enough others to confirm a request
+	Equal(t, []string{"stable", "steady"}, chain.ConfirmationURLs(2))
+	Equal(t, []string{"stable", "steady", "flaky"}, chain.ConfirmationURLs(3))
+}
+
+func TestProbation(t *testing.T) {
+	chain := newTestChain(t, "recovering", "stable")
+
+	for i := 0; i < 20; i++ {
+		chain.RecordResult("recovering", time.Millisecond, errors.New("down"))
+	}
+	Equal(t, []string{"stable"}, chain.URLs())
+
+	// once the ejection is over the upstream is on probation and used last
+	chainmanager.SetRPCInfo(chain, []rpcinfo.Result{
+		{URL: "recovering", Latency: time.Millisecond, BlockNumber: 10},
+		{URL: "stable", Latency: time.Second, BlockNumber: 10},
+	}, time.Now().Add(time.Hour))
+
+	Equal(t, []string{"stable", "recovering"}, chain.URLs())
+	Equal(t, chainmanager.Probation, chain.Health()[1].Status)
+
+	for i := 0; i < 5; i++ {
+		chain.RecordResult("recovering", time.Millisecond, nil)
+	}
+
+	// healthy again and faster
+	Equal(t, []string{"recovering", "stable"}, chain.URLs())
+}
+
+func TestScoreOrdering(t *testing.T) {
+	chain := newTestChain(t, "slow", "fast")
+
+	chainmanager.SetRPCInfo(chain, []rpcinfo.Result{
+		{URL: "slow", Latency: time.Second * 2, BlockNumber: 100},
+		{URL: "fast", Latency: time.Millisecond * 10, BlockNumber: 100},
+	}, time.Now())
+
+	Equal(t, []string{"fast", "slow"}, chain.URLs())
+}
+
+func TestHistoricalURLs(t *testing.T) {
+	chain := newTestChain(t, "pruned", "archive", "unprobed")
+
+	chainmanager.SetRPCInfo(chain, []rpcinfo.Result{
+		{URL: "pruned", Latency: time.Millisecond, BlockNumber: 100_000},
+		{URL: "archive", Latency: time.Millisecond * 10, BlockNumber: 100_000},
+		{URL: "unprobed", Latency: time.Millisecond * 20, BlockNumber: 100_000},
+	}, time.Now())
+
+	// nothing is known yet, so every upstream is tried
+	Equal(t, chain.URLs(), chain.HistoricalURLs(1))
+
+	chainmanager.SetHistory(chain, []rpcinfo.HistoryResult{
+		{URL: "pruned", Window: 1024},
+		{URL: "archive", Archive: true, Window: 100_000},
+		{URL: "unprobed", HasError: true},
+	}, time.Now())
+
+	// recent state can be served by anyone
+	Equal(t, chain.URLs(), chain.HistoricalURLs(99_950))
+	Equal(t, chain.URLs(), chain.HistoricalURLs(200_000))
+
+	Equal(t, []string{"pruned", "archive"}, chain.HistoricalURLs(99_000))
+	Equal(t, []string{"archive"}, chain.HistoricalURLs(1))
+
+	health := chain.Health()
+	Equal(t, chainmanager.FullNode, health[0].NodeType)
+	Equal(t, uint64(1024), health[0].HistoryWindow)
+	Equal(t, chainmanager.ArchiveNode, health[1].NodeType)
+	Equal(t, chainmanager.UnknownNode, health[2].NodeType)
+}
@@ -9,6 +9,7 @@
 	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/exp/slices"
 	"sort"
 	"sync"
 	"time"
@@ -19,6 +20,10 @@
 // rpcTimeout is how long to wait for a response.
 const rpcTimeout = time.Second * 5
 
+// historyProbeInterval is how often the state history of an upstream is probed. Node types rarely
+// change, so this is much less frequent than latency checks.
+const historyProbeInterval = time.Hour
+
 // ChainManager manages chain context.
 type ChainManager interface {
 	// GetChainIDs gets all chainids
@@ -29,6 +34,11 @@ type ChainManager interface {
 	GetChain(chainID uint32) Chain
 	// PutChain adds chain urls. Any previous chain data is overwritten
 	PutChain(chainID uint32, urls []string, confirmations uint16)
+	// UpdateChain adds a chain or updates an existing one from a chain config.
+	// Upstream health is kept for urls that are still in use.
+	UpdateChain(chainID uint32, chainConfig config.ChainConfig)
+	// RemoveChain removes a chain
+	RemoveChain(chainID uint32)
 }
 
 // NewChainManager creates a new chain manager.
@@ -39,39 +49,22 @@ func NewChainManager(handler metrics.Handler) ChainManager {
 		mux: sync.RWMutex{},
 		// handler is the metrics handler
 		handler: handler,
+		// healthConfig is the config used for scoring upstreams
+		healthConfig: newHealthConfig(config.HealthConfig{}),
 	}
 }
 
 // NewChainManagerFromConfig creates a new chain manager.
 func NewChainManagerFromConfig(configuration config.Config, handler metrics.Handler) ChainManager {
 	cm := &chainManager{
-		chainList: make(map[uint32]*chain),
-		mux:       sync.RWMutex{},
-		handler:   handler,
+		chainList:    make(map[uint32]*chain),
+		mux:          sync.RWMutex{},
+		handler:      handler,
+		healthConfig: newHealthConfig(configuration.Health),
 	}
 
 	for chainID, chn := range configuration.Chains {
-		// default the confirmation threshold to 1
-		confThreshold := uint16(1)
-
-		// if confirmation threshold is 1, set the checks to 1
-		if chn.Checks > 0 {
-			confThreshold = chn.Checks
-		}
-
-		// store all the chains w/ empty latency results
-		chains := make([]rpcinfo.Result, len(chn.RPCs))
-		for i := range chn.RPCs {
-			chains[i] = rpcinfo.Result{
-				URL: chn.RPCs[i],
-			}
-		}
-
-		cm.chainList[chainID] = &chain{
-			chainID:               chainID,
-			confirmationThreshold: confThreshold,
-			rpcs:                  chains,
-		}
+		cm.UpdateChain(chainID, chn)
 	}
 
 	err := cm.setupMetrics()
@@ -84,9 +77,10 @@ func NewChainManagerFromConfig(configuration config.Config, handler metrics.Hand
 
 // chainManager contains a chain manager.
 type chainManager struct {
-	chainList map[uint32]*chain
-	mux       sync.RWMutex
-	handler   metrics.Handler
+	chainList    map[uint32]*chain
+	mux          sync.RWMutex
+	handler      metrics.Handler
+	healthConfig healthConfig
 }
 
 func (c *chainManager) GetChain(chainID uint32) Chain {
@@ -116,21 +110,40 @@ func (c *chainManager) GetChainIDs() (chainIDs []uint32) {
 
 // PutChain puts new chain urls.
 func (c *chainManager) PutChain(chainID uint32, urls []string, confirmations uint16) {
-	rpcs := make([]rpcinfo.Result, len(urls))
-	for i, url := range urls {
-		rpcs[i] = rpcinfo.Result{
-			URL: url,
-		}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.chainList[chainID] = newChain(chainID, urls, confirmations, c.healthConfig)
+}
+
+// UpdateChain adds or updates a chain.
+func (c *chainManager) UpdateChain(chainID uint32, chainConfig config.ChainConfig) {
+	// default the confirmation threshold to 1
+	confThreshold := uint16(1)
+
+	// if confirmation threshold is 1, set the checks to 1
+	if chainConfig.Checks > 0 {
+		confThreshold = chainConfig.Checks
 	}
 
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
-	c.chainList[chainID] = &chain{
-		chainID:               chainID,
-		rpcs:                  rpcs,
-		confirmationThreshold: confirmations,
+	chn, ok := c.chainList[chainID]
+	if !ok {
+		chn = newChain(chainID, nil, confThreshold, c.healthConfig)
+		c.chainList[chainID] = chn
 	}
+
+	chn.update(chainConfig.RPCs, confThreshold, chainConfig.Drained)
+}
+
+// RemoveChain removes a chain.
+func (c *chainManager) RemoveChain(chainID uint32) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	delete(c.chainList, chainID)
 }
 
 // RefreshRPCInfo refreshes rpc info for a given chain id.
@@ -143,13 +156,21 @@ func (c *chainManager) RefreshRPCInfo(ctx context.Context, chainID uint32) {
 	if !ok {
 		return
 	}
-	rpcURLS := chainList.URLs()
+	// ejected urls are checked as well so they can be put back on probation
+	rpcURLS := chainList.allURLs()
 
 	rpcInfoList := sortInfoList(rpcinfo.GetRPCLatency(ctx, rpcTimeout, rpcURLS, c.handler))
 
-	c.mux.Lock()
-	c.chainList[chainID].rpcs = rpcInfoList
-	c.mux.Unlock()
+	now := time.Now()
+	chainList.setRPCInfo(rpcInfoList, now)
+
+	head := chainList.LatestBlockNumber()
+	staleURLs := chainList.staleHistoryURLs(now)
+	if head == 0 || len(staleURLs) == 0 {
+		return
+	}
+
+	chainList.setHistory(rpcinfo.GetStateHistory(ctx, rpcTimeout, staleURLs, head, c.handler), now)
 }
 
 const (
@@ -157,6 +178,8 @@ func (c *chainManager) RefreshRPCInfo(ctx context.Context, chainID uint32) {
 	blockNumberMetric = "block_number"
 	latencyMetric     = "latency"
 	blockAgeMetric    = "block_age"
+	scoreMetric       = "upstream_score"
+	errorRateMetric   = "upstream_error_rate"
 )
 
 // records metrics for various rpcs. Should only be called once.
@@ -182,12 +205,22 @@ func (c *chainManager) setupMetrics() error {
 		return fmt.Errorf("could not create histogram: %w", err)
 	}
 
+	scoreGauge, err := meterMaid.Float64ObservableGauge(scoreMetric)
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	errorRateGauge, err := meterMaid.Float64ObservableGauge(errorRateMetric)
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
 	if _, err := meterMaid.RegisterCallback(func(parentCtx context.Context, o metric.Observer) (err error) {
 		c.mux.RLock()
 		defer c.mux.RUnlock()
 
 		for chainID, chainInfo := range c.chainList {
-			for _, rpc := range chainInfo.rpcs {
+			for _, rpc := range chainInfo.rpcInfo() {
 				attributeSet := attribute.NewSet(attribute.Int64(metrics.ChainID, int64(chainID)), attribute.String("rpc_url", rpc.URL))
 
 				if rpc.HasError {
@@ -198,10 +231,17 @@ func (c *chainManager) setupMetrics() error {
 				o.ObserveFloat64(latencyGauge, rpc.Latency.Seconds(), metric.WithAttributeSet(attributeSet))
 				o.ObserveFloat64(ageGauge, rpc.BlockAge.Seconds(), metric.WithAttributeSet(attributeSet))
 			}
+
+			for _, health := range chainInfo.Health() {
+				attributeSet := attribute.NewSet(attribute.Int64(metrics.ChainID, int64(chainID)), attribute.String("rpc_url", health.URL), attribute.String("status", string(health.Status)))
+
+				o.ObserveFloat64(scoreGauge, health.Score, metric.WithAttributeSet(attributeSet))
+				o.ObserveFloat64(errorRateGauge, health.ErrorRate, metric.WithAttributeSet(attributeSet))
+			}
 		}
 
 		return nil
-	}, blockGauge, latencyGauge, ageGauge); err != nil {
+	}, blockGauge, latencyGauge, ageGauge, scoreGauge, errorRateGauge); err != nil {
 		return fmt.Errorf("could not register callback for gauges: %w", err)
 	}
 	return nil
@@ -235,10 +275,25 @@ func sortInfoList(rpcInfoList []rpcinfo.Result) []rpcinfo.Result {
 type Chain interface {
 	// ConfirmationsThreshold gets the confirmation count
 	ConfirmationsThreshold() uint16
-	// URLs gets the urls
+	// URLs gets the urls ordered by health score. Drained urls are always excluded, ejected urls are excluded
+	// unless there are no other urls.
 	URLs() []string
 	// ID returns the id of the chain
 	ID() uint32
+	// LatestBlockNumber gets the highest block number seen across all rpcs as of the last refresh.
+	// 0 is returned if no rpc has been successfully refreshed.
+	LatestBlockNumber() uint64
+	// RecordResult records the result of a request to an upstream. err should only be set
+	// if the upstream failed to return a valid response.
+	RecordResult(url string, latency time.Duration, err error)
+	// ConfirmationURLs gets the urls like URLs, adding ejected urls in order of health
+	// if there are fewer than confirmations.
+	ConfirmationURLs(confirmations uint16) []string
+	// Health gets the health of all upstreams ordered by health score.
+	Health() []UpstreamHealth
+	// HistoricalURLs gets the urls that can serve state at blockNumber, ordered like URLs. Recent blocks
+	// can be served by any upstream. If no upstream is known to serve the block, URLs is returned.
+	HistoricalURLs(blockNumber uint64) []string
 }
 
 // chain contains the settings for a single chain.
@@ -247,8 +302,35 @@ type chain struct {
 	chainID uint32
 	// confirmationThreshold is the confirmation threshold of the chain
 	confirmationThreshold uint16
+	// healthConfig is the config used to score upstreams
+	healthConfig healthConfig
+	// mux protects rpcs, health and confirmationThreshold
+	mux sync.RWMutex
 	// rpcs contains a list of rpcs sorted by speed
 	rpcs []rpcinfo.Result
+	// health contains the health of each rpc by url
+	health map[string]*upstreamHealth
+}
+
+func newChain(chainID uint32, urls []string, confirmations uint16, hc healthConfig) *chain {
+	rpcs := make([]rpcinfo.Result, len(urls))
+	health := make(map[string]*upstreamHealth, len(urls))
+
+	for i, url := range urls {
+		// store all the chains w/ empty latency results
+		rpcs[i] = rpcinfo.Result{
+			URL: url,
+		}
+		health[url] = newUpstreamHealth(url)
+	}
+
+	return &chain{
+		chainID:               chainID,
+		confirmationThreshold: confirmations,
+		healthConfig:          hc,
+		rpcs:                  rpcs,
+		health:                health,
+	}
 }
 
 func (c *chain) ID() uint32 {
@@ -256,11 +338,131 @@ func (c *chain) ID() uint32 {
 }
 
 func (c *chain) ConfirmationsThreshold() uint16 {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
 	return c.confirmationThreshold
 }
 
-// URLs gets all urls for a chain.
-func (c *chain) URLs() (res []string) {
+// URLs gets all non-ejected, non-drained urls for a chain ordered by health.
+func (c *chain) URLs() []string {
+	return usableURLs(c.Health())
+}
+
+// ConfirmationURLs gets the urls like URLs. If there are fewer than confirmations, ejected urls are added
+// in order of health until there are enough to confirm a request.
+func (c *chain) ConfirmationURLs(confirmations uint16) []string {
+	ranked := c.Health()
+	res := usableURLs(ranked)
+
+	for _, health := range ranked {
+		if len(res) >= int(confirmations) {
+			break
+		}
+		if health.Status == Ejected && !slices.Contains(res, health.URL) {
+			res = append(res, health.URL)
+		}
+	}
+
+	return res
+}
+
+// usableURLs gets the non-ejected, non-drained urls of upstreams ranked by health.
+func usableURLs(ranked []UpstreamHealth) (res []string) {
+	for _, health := range ranked {
+		if health.Status == Ejected || health.Status == Drained {
+			continue
+		}
+		res = append(res, health.URL)
+	}
+
+	// if every upstream is ejected, try them anyway
+	if len(res) == 0 {
+		for _, health := range ranked {
+			if health.Status == Drained {
+				continue
+			}
+			res = append(res, health.URL)
+		}
+	}
+
+	return res
+}
+
+// HistoricalURLs gets the urls that can serve state at a block.
+func (c *chain) HistoricalURLs(blockNumber uint64) []string {
+	urls := c.URLs()
+
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	// every node keeps the most recent state
+	head := c.latestBlockNumber()
+	if blockNumber >= head || head-blockNumber <= rpcinfo.HistoryDepths[0] {
+		return urls
+	}
+
+	depth := head - blockNumber
+	var res []string
+	for _, url := range urls {
+		if health, ok := c.health[url]; ok && health.servesDepth(depth) {
+			res = append(res, url)
+		}
+	}
+
+	// none of the upstreams are known to have the state, so try them all
+	if len(res) == 0 {
+		return urls
+	}
+
+	return res
+}
+
+// update sets the urls, confirmation threshold and drained urls of a chain. Latency results and
+// health are kept for urls that are still in use.
+func (c *chain) update(urls []string, confirmations uint16, drained []string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.confirmationThreshold = confirmations
+
+	inUse := make(map[string]bool, len(urls))
+	rpcs := make([]rpcinfo.Result, 0, len(urls))
+
+	// keep the previous (latency sorted) order for existing urls, new urls go last until the next refresh
+	for _, info := range c.rpcs {
+		if slices.Contains(urls, info.URL) && !inUse[info.URL] {
+			rpcs = append(rpcs, info)
+			inUse[info.URL] = true
+		}
+	}
+
+	for _, url := range urls {
+		if inUse[url] {
+			continue
+		}
+		rpcs = append(rpcs, rpcinfo.Result{URL: url})
+		inUse[url] = true
+	}
+
+	c.rpcs = rpcs
+
+	for url := range c.health {
+		if !inUse[url] {
+			delete(c.health, url)
+		}
+	}
+
+	for url := range inUse {
+		c.upstream(url).drained = slices.Contains(drained, url)
+	}
+}
+
+// allURLs gets all urls for a chain including ejected ones.
+func (c *chain) allURLs() (res []string) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
 	res = make([]string, len(c.rpcs))
 	for i, chainInfo := range c.rpcs {
 		res[i] = chainInfo.URL
@@ -268,4 +470,150 @@ func (c *chain) URLs() (res []string) {
 	return res
 }
 
+// rpcInfo gets a copy of the latest rpc info.
+func (c *chain) rpcInfo() []rpcinfo.Result {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	res := make([]rpcinfo.Result, len(c.rpcs))
+	copy(res, c.rpcs)
+	return res
+}
+
+// setRPCInfo stores the results of a latency check and updates the health of each upstream. The chain's
+// urls may have been updated while checking, so results for removed urls are dropped and added urls are kept.
+func (c *chain) setRPCInfo(rpcInfoList []rpcinfo.Result, now time.Time) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	configured := make(map[string]bool, len(c.rpcs))
+	for _, info := range c.rpcs {
+		configured[info.URL] = true
+	}
+
+	rpcs := make([]rpcinfo.Result, 0, len(c.rpcs))
+	checked := make(map[string]bool, len(c.rpcs))
+	for _, info := range rpcInfoList {
+		if !configured[info.URL] || checked[info.URL] {
+			continue
+		}
+		checked[info.URL] = true
+		rpcs = append(rpcs, info)
+		c.upstream(info.URL).recordInfo(info)
+	}
+
+	// urls added while checking go last until the next refresh
+	for _, info := range c.rpcs {
+		if !checked[info.URL] {
+			rpcs = append(rpcs, info)
+		}
+	}
+	c.rpcs = rpcs
+
+	head := c.latestBlockNumber()
+	for _, health := range c.health {
+		health.updateStatus(c.healthConfig, head, now)
+	}
+}
+
+// staleHistoryURLs gets the urls whose state history hasn't been probed recently.
+func (c *chain) staleHistoryURLs(now time.Time) (res []string) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	for _, info := range c.rpcs {
+		health, ok := c.health[info.URL]
+		if !ok || now.Sub(health.historyCheckedAt) >= historyProbeInterval {
+			res = append(res, info.URL)
+		}
+	}
+	return res
+}
+
+// setHistory stores the results of a state history probe.
+func (c *chain) setHistory(historyList []rpcinfo.HistoryResult, now time.Time) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for _, history := range historyList {
+		// the url may have been removed while probing
+		if _, ok := c.health[history.URL]; !ok {
+			continue
+		}
+		c.upstream(history.URL).recordHistory(history, now)
+	}
+}
+
+// RecordResult records the result of a request to an upstream. Results for urls that have been removed are dropped.
+func (c *chain) RecordResult(url string, latency time.Duration, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	health, ok := c.health[url]
+	if !ok {
+		return
+	}
+	health.recordResult(latency, err)
+	health.updateStatus(c.healthConfig, c.latestBlockNumber(), time.Now())
+}
+
+// Health gets the health of every upstream ordered by status, then score.
+func (c *chain) Health() []UpstreamHealth {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	head := c.latestBlockNumber()
+
+	res := make([]UpstreamHealth, len(c.rpcs))
+	for i, info := range c.rpcs {
+		health, ok := c.health[info.URL]
+		if !ok {
+			health = newUpstreamHealth(info.URL)
+		}
+		res[i] = health.snapshot(c.healthConfig, head)
+	}
+
+	// stable sort so latency ordering from rpc info is kept for ties
+	sort.SliceStable(res, func(i, j int) bool {
+		if statusRank(res[i].Status) != statusRank(res[j].Status) {
+			return statusRank(res[i].Status) < statusRank(res[j].Status)
+		}
+		return res[i].Score > res[j].Score
+	})
+
+	return res
+}
+
+// upstream gets the health tracker for a url. Callers must hold the lock.
+func (c *chain) upstream(url string) *upstreamHealth {
+	health, ok := c.health[url]
+	if !ok {
+		health = newUpstreamHealth(url)
+		c.health[url] = health
+	}
+	return health
+}
+
+// LatestBlockNumber gets the highest block number seen across all rpcs.
+func (c *chain) LatestBlockNumber() uint64 {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	return c.latestBlockNumber()
+}
+
+// latestBlockNumber gets the highest block number. Callers must hold the lock.
+func (c *chain) latestBlockNumber() (blockNumber uint64) {
+	for _, chainInfo := range c.rpcs {
+		if chainInfo.HasError {
+			continue
+		}
+
+		if chainInfo.BlockNumber > blockNumber {
+			blockNumber = chainInfo.BlockNumber
+		}
+	}
+	return blockNumber
+}
+
 var _ Chain = &chain{}
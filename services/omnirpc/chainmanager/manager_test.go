@@ -8,9 +8,13 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
 	"github.com/synapsecns/sanguine/services/omnirpc/metadata"
 	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
+	"net/http"
+	"net/http/httptest"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 )
@@ -90,3 +94,78 @@ func sortSlice(res []uint32) []uint32 {
 	})
 	return res
 }
+
+// TestUpdateChain makes sure health is kept for existing urls when a chain is updated.
+func TestUpdateChain(t *testing.T) {
+	nullHandler, err := metrics.NewByType(context.Background(), metadata.BuildInfo(), metrics.Null)
+	NoError(t, err)
+
+	cm := chainmanager.NewChainManager(nullHandler)
+	chainID := gofakeit.Uint32()
+	cm.UpdateChain(chainID, config.ChainConfig{RPCs: []string{"kept", "removed"}})
+
+	chain := cm.GetChain(chainID)
+	chain.RecordResult("kept", time.Millisecond, errors.New("timeout"))
+
+	cm.UpdateChain(chainID, config.ChainConfig{RPCs: []string{"kept", "added", "drained"}, Checks: 2, Drained: []string{"drained"}})
+
+	ElementsMatch(t, []string{"kept", "added"}, chain.URLs())
+	Equal(t, uint16(2), chain.ConfirmationsThreshold())
+
+	health := chain.Health()
+	Len(t, health, 3)
+	Equal(t, chainmanager.Drained, health[2].Status)
+	for _, upstream := range health {
+		if upstream.URL == "kept" {
+			Equal(t, "timeout", upstream.LastError)
+		}
+	}
+
+	cm.RemoveChain(chainID)
+	Nil(t, cm.GetChain(chainID))
+}
+
+// TestRefreshDuringRemoval makes sure a refresh that started before a url was removed doesn't put it back.
+func TestRefreshDuringRemoval(t *testing.T) {
+	nullHandler, err := metrics.NewByType(context.Background(), metadata.BuildInfo(), metrics.Null)
+	NoError(t, err)
+
+	// upstreams hold latency checks until the url has been removed
+	var checking sync.WaitGroup
+	checking.Add(2)
+	removed := make(chan struct{})
+	newUpstream := func() *httptest.Server {
+		var once sync.Once
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			once.Do(checking.Done)
+			<-removed
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	kept := newUpstream()
+	removedUpstream := newUpstream()
+
+	cm := chainmanager.NewChainManager(nullHandler)
+	chainID := gofakeit.Uint32()
+	cm.UpdateChain(chainID, config.ChainConfig{RPCs: []string{kept.URL, removedUpstream.URL}})
+
+	refreshed := make(chan struct{})
+	go func() {
+		cm.RefreshRPCInfo(context.Background(), chainID)
+		close(refreshed)
+	}()
+
+	checking.Wait()
+	cm.UpdateChain(chainID, config.ChainConfig{RPCs: []string{kept.URL}})
+	close(removed)
+	<-refreshed
+
+	chain := cm.GetChain(chainID)
+	chain.RecordResult(removedUpstream.URL, time.Millisecond, nil)
+
+	health := chain.Health()
+	Len(t, health, 1)
+	Equal(t, kept.URL, health[0].URL)
+}
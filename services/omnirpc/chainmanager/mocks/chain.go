@@ -2,13 +2,34 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+	chainmanager "github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+
+	time "time"
+)
 
 // Chain is an autogenerated mock type for the Chain type
 type Chain struct {
 	mock.Mock
 }
 
+// ConfirmationURLs provides a mock function with given fields: confirmations
+func (_m *Chain) ConfirmationURLs(confirmations uint16) []string {
+	ret := _m.Called(confirmations)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(uint16) []string); ok {
+		r0 = rf(confirmations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // ConfirmationsThreshold provides a mock function with given fields:
 func (_m *Chain) ConfirmationsThreshold() uint16 {
 	ret := _m.Called()
@@ -23,6 +44,38 @@ func (_m *Chain) ConfirmationsThreshold() uint16 {
 	return r0
 }
 
+// Health provides a mock function with given fields:
+func (_m *Chain) Health() []chainmanager.UpstreamHealth {
+	ret := _m.Called()
+
+	var r0 []chainmanager.UpstreamHealth
+	if rf, ok := ret.Get(0).(func() []chainmanager.UpstreamHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]chainmanager.UpstreamHealth)
+		}
+	}
+
+	return r0
+}
+
+// HistoricalURLs provides a mock function with given fields: blockNumber
+func (_m *Chain) HistoricalURLs(blockNumber uint64) []string {
+	ret := _m.Called(blockNumber)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(uint64) []string); ok {
+		r0 = rf(blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // ID provides a mock function with given fields:
 func (_m *Chain) ID() uint32 {
 	ret := _m.Called()
@@ -37,6 +90,25 @@ func (_m *Chain) ID() uint32 {
 	return r0
 }
 
+// LatestBlockNumber provides a mock function with given fields:
+func (_m *Chain) LatestBlockNumber() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// RecordResult provides a mock function with given fields: url, latency, err
+func (_m *Chain) RecordResult(url string, latency time.Duration, err error) {
+	_m.Called(url, latency, err)
+}
+
 // URLs provides a mock function with given fields:
 func (_m *Chain) URLs() []string {
 	ret := _m.Called()
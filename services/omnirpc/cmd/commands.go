@@ -108,6 +108,8 @@
 	Flags: []cli.Flag{
 		configFlag,
 		portFlag,
+		recordFlag,
+		replayFlag,
 	},
 	Action: func(c *cli.Context) error {
 		// Create a large heap allocation of 10 GiB
@@ -131,7 +133,18 @@
 			rConfig.Port = uint16(freeport.GetPort())
 		}
 
+		switch {
+		case c.IsSet(recordFlag.Name) && c.IsSet(replayFlag.Name):
+			return fmt.Errorf("only one of --%s and --%s can be set", recordFlag.Name, replayFlag.Name)
+		case c.IsSet(recordFlag.Name):
+			rConfig.Fixture = rpcConfig.FixtureConfig{Mode: rpcConfig.FixtureModeRecord, Path: core.ExpandOrReturnPath(c.String(recordFlag.Name))}
+		case c.IsSet(replayFlag.Name):
+			rConfig.Fixture = rpcConfig.FixtureConfig{Mode: rpcConfig.FixtureModeReplay, Path: core.ExpandOrReturnPath(c.String(replayFlag.Name))}
+		}
+
 		server := proxy.NewProxy(rConfig, metrics.Get())
+		// changes made through the admin api are written back to the config
+		server.SetConfigPath(c.String(configFlag.Name))
 
 		server.Run(c.Context)
 
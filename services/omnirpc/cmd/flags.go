@@ -41,6 +41,16 @@ func init() {
 	Usage: "path to json file to debug",
 }
 
+var recordFlag = &cli.StringFlag{
+	Name:  "record",
+	Usage: "path to a fixture archive to record every response to",
+}
+
+var replayFlag = &cli.StringFlag{
+	Name:  "replay",
+	Usage: "path to a fixture archive to serve responses from instead of upstreams",
+}
+
 var rpcFlag = &cli.StringFlag{
 	Name:  "rpc",
 	Usage: "rpc url to rewrite requests from",
@@ -18,14 +18,165 @@ type Config struct {
 	RefreshInterval int `yaml:"refresh_interval,omitempty"`
 	// ClientType is the client type to use
 	ClientType string `yaml:"client_type,omitempty"`
+	// Cache is the config for the immutable response cache
+	Cache CacheConfig `yaml:"cache,omitempty"`
+	// Health is the config for upstream health scoring
+	Health HealthConfig `yaml:"health,omitempty"`
+	// Admin is the config for the admin api
+	Admin AdminConfig `yaml:"admin,omitempty"`
+	// Fixture is the config for recording or replaying responses
+	Fixture FixtureConfig `yaml:"fixture,omitempty"`
+	// Clients are api clients by name. If any clients are configured, every rpc request must use a valid api key
+	Clients map[string]ClientConfig `yaml:"clients,omitempty"`
+	// Audit is the config for the upstream consistency auditor
+	Audit AuditConfig `yaml:"audit,omitempty"`
+}
+
+// AuditConfig configures the background auditor that compares responses across upstreams.
+type AuditConfig struct {
+	// Interval is how often each chain is audited, expressed in seconds. Auditing is disabled if this is 0
+	Interval int `yaml:"interval,omitempty"`
+	// BlockDepth is how many blocks behind the head sampled blocks are, so upstreams that are
+	// a few blocks behind aren't flagged (default 10)
+	BlockDepth uint64 `yaml:"block_depth,omitempty"`
+	// MaxDivergences is the number of recent divergences kept per chain for the report (default 100)
+	MaxDivergences int `yaml:"max_divergences,omitempty"`
+}
+
+// Enabled returns true if the auditor is enabled.
+func (a AuditConfig) Enabled() bool {
+	return a.Interval > 0
+}
+
+// ClientConfig is the config for a single api client.
+type ClientConfig struct {
+	// APIKey is the key the client authenticates with
+	APIKey string `yaml:"api_key"`
+	// RequestsPerSecond is the number of requests per second the client can make. Calls in a batch are counted
+	// individually. Requests are unlimited if this is 0
+	RequestsPerSecond float64 `yaml:"requests_per_second,omitempty"`
+	// Burst is the number of requests that can be made at once, defaults to requests per second. Batches
+	// larger than the burst are rejected
+	Burst int `yaml:"burst,omitempty"`
+	// DailyQuota is the number of requests the client can make per day (UTC). Requests are unlimited if this is 0
+	DailyQuota uint64 `yaml:"daily_quota,omitempty"`
+	// AllowedMethods is a list of method patterns (e.g. eth_*) the client can call. All methods are allowed if this is empty
+	AllowedMethods []string `yaml:"allowed_methods,omitempty"`
+	// DeniedMethods is a list of method patterns (e.g. debug_*) the client can't call. This takes precedence over AllowedMethods
+	DeniedMethods []string `yaml:"denied_methods,omitempty"`
+}
+
+const (
+	// FixtureModeRecord records every response to the fixture archive.
+	FixtureModeRecord = "record"
+	// FixtureModeReplay serves responses from the fixture archive without contacting upstreams.
+	FixtureModeReplay = "replay"
+)
+
+// FixtureConfig configures recording responses to and replaying responses from a fixture archive.
+type FixtureConfig struct {
+	// Mode is either record or replay. Fixtures are disabled if this is empty
+	Mode string `yaml:"mode,omitempty"`
+	// Path is the path to the fixture archive
+	Path string `yaml:"path,omitempty"`
+}
+
+// AdminConfig is the config for the admin api.
+type AdminConfig struct {
+	// Token is the bearer token required to use the admin api. The admin api is disabled if this is empty
+	Token string `yaml:"token,omitempty"`
+}
+
+// Enabled returns true if the admin api is enabled.
+func (a AdminConfig) Enabled() bool {
+	return a.Token != ""
+}
+
+// HealthConfig configures when upstreams are considered unhealthy. Zero values use defaults.
+type HealthConfig struct {
+	// MaxBlockLag is how many blocks an upstream can be behind the best known head before it's ejected
+	MaxBlockLag uint64 `yaml:"max_block_lag,omitempty"`
+	// MaxErrorRate is the rolling error rate (0-1) at which an upstream is ejected
+	MaxErrorRate float64 `yaml:"max_error_rate,omitempty"`
+	// EjectionDuration is how long an upstream is ejected for before being put on probation
+	// expressed in seconds
+	EjectionDuration int `yaml:"ejection_duration,omitempty"`
+}
+
+// CacheConfig is the config for the response cache.
+type CacheConfig struct {
+	// Size is the number of responses to hold in memory. The cache is disabled if this is 0
+	Size int `yaml:"size,omitempty"`
+	// DiskPath is an optional path to persist cached responses to. Responses are only kept in memory if this is empty
+	DiskPath string `yaml:"disk_path,omitempty"`
+	// ConfirmationDepth is how many blocks behind the head a response must be before it can be cached
+	ConfirmationDepth uint64 `yaml:"confirmation_depth,omitempty"`
+}
+
+// Enabled returns true if the response cache is enabled.
+func (c CacheConfig) Enabled() bool {
+	return c.Size > 0
 }
 
 // ChainConfig is the config for a single chain.
 type ChainConfig struct {
 	// RPCS is a list of rpcs to use
-	RPCs []string `yaml:"rpcs"`
+	RPCs []string `yaml:"rpcs" json:"rpcs"`
 	// Checks is how many rpcs must return the same result for it to be used. This does not apply to height/status based methods
-	Checks uint16 `yaml:"confirmations,omitempty"`
+	Checks uint16 `yaml:"confirmations,omitempty" json:"confirmations,omitempty"`
+	// BroadcastTransactions sends eth_sendRawTransaction to every healthy rpc rather than just one
+	BroadcastTransactions bool `yaml:"broadcast_transactions,omitempty" json:"broadcast_transactions,omitempty"`
+	// MaxGetLogsRange is the largest block range requested from upstreams in a single eth_getLogs call.
+	// Larger requests are split into chunks. Lower limits reported by upstreams are learned automatically
+	MaxGetLogsRange uint64 `yaml:"max_get_logs_range,omitempty" json:"max_get_logs_range,omitempty"`
+	// MaxBatchSize is the largest batch sent to an rpc in a single request. Larger batches are split into
+	// sub-batches that are forwarded in parallel. Batches aren't split if this is 0 and BatchLimits is empty
+	MaxBatchSize int `yaml:"max_batch_size,omitempty" json:"max_batch_size,omitempty"`
+	// BatchLimits is rpc -> max batch size, for rpcs that accept smaller batches than MaxBatchSize
+	BatchLimits map[string]int `yaml:"batch_limits,omitempty" json:"batch_limits,omitempty"`
+	// Drained is a list of rpcs that are kept in the config (and health checked) but not used
+	Drained []string `yaml:"drained,omitempty" json:"drained,omitempty"`
+	// Modules is a list of modules to run requests and responses through, in order
+	Modules []string `yaml:"modules,omitempty" json:"modules,omitempty"`
+	// Quorum is method (or method pattern, e.g. eth_get*) -> quorum policy. Methods without a policy
+	// must be confirmed by Checks rpcs
+	Quorum map[string]QuorumPolicy `yaml:"quorum,omitempty" json:"quorum,omitempty"`
+	// Trust is rpc -> trust weight used by weighted quorum policies and trusted divergence handling. Rpcs default to 1
+	Trust map[string]float64 `yaml:"trust,omitempty" json:"trust,omitempty"`
+}
+
+const (
+	// QuorumFirstResponse uses the first successful response.
+	QuorumFirstResponse = "first-response"
+	// QuorumAgreement requires Confirmations rpcs to return the same response.
+	QuorumAgreement = "agreement"
+	// QuorumWeighted requires rpcs w/ a combined trust of Weight to return the same response.
+	QuorumWeighted = "weighted"
+	// QuorumHighestBlock asks every rpc and uses the response w/ the highest block. This is meant for head queries.
+	QuorumHighestBlock = "highest-block"
+)
+
+const (
+	// DivergenceFail returns an error if the policy can't be met.
+	DivergenceFail = "fail"
+	// DivergenceMajority uses the response returned by the most rpcs if the policy can't be met.
+	DivergenceMajority = "majority"
+	// DivergenceTrusted uses the response from the most trusted rpc if the policy can't be met.
+	DivergenceTrusted = "trusted"
+)
+
+// QuorumPolicy configures how responses from rpcs are confirmed for a method.
+type QuorumPolicy struct {
+	// Type is first-response, agreement, weighted or highest-block
+	Type string `yaml:"type" json:"type"`
+	// Confirmations is how many rpcs must agree for agreement policies, defaults to the chain confirmations
+	Confirmations uint16 `yaml:"confirmations,omitempty" json:"confirmations,omitempty"`
+	// Upstreams is the maximum number of rpcs to ask, in health order. All rpcs are used if this is 0
+	Upstreams uint16 `yaml:"upstreams,omitempty" json:"upstreams,omitempty"`
+	// Weight is the combined trust weight rpcs returning the same response must have for weighted policies
+	Weight float64 `yaml:"weight,omitempty" json:"weight,omitempty"`
+	// OnDivergence is fail, majority or trusted and is used when the policy can't be met. Defaults to fail
+	OnDivergence string `yaml:"on_divergence,omitempty" json:"on_divergence,omitempty"`
 }
 
 // UnmarshallConfig unmarshalls a config.
@@ -0,0 +1,236 @@
+package fixture
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/rpckey"
+)
+
+// archiveVersion is the version of the archive format.
+const archiveVersion = 1
+
+// ErrNotRecorded is returned when a request is not in the archive.
+var ErrNotRecorded = errors.New("no recorded response")
+
+// Entry is a recorded request and its response.
+type Entry struct {
+	// Request is the request w/o an id
+	Request rpc.Request `json:"request"`
+	// Response is the json-rpc response w/o an id
+	Response json.RawMessage `json:"response"`
+}
+
+// archiveFile is the serialized archive.
+type archiveFile struct {
+	Version int                `json:"version"`
+	Chains  map[uint32][]Entry `json:"chains"`
+}
+
+// Archive holds recorded responses by chain.
+type Archive struct {
+	// mux protects chains and dirty
+	mux sync.RWMutex
+	// chains is chain id -> request key -> entry
+	chains map[uint32]map[string]Entry
+	// dirty is true if there are entries that haven't been saved
+	dirty bool
+}
+
+// NewArchive creates an empty archive.
+func NewArchive() *Archive {
+	return &Archive{
+		chains: make(map[uint32]map[string]Entry),
+	}
+}
+
+// LoadArchive loads an archive from a gzipped json file.
+func LoadArchive(path string) (*Archive, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("could not open archive %s: %w", path, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress archive %s: %w", path, err)
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not read archive %s: %w", path, err)
+	}
+
+	var serialized archiveFile
+	err = json.Unmarshal(contents, &serialized)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse archive %s: %w", path, err)
+	}
+
+	if serialized.Version != archiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d, expected %d", serialized.Version, archiveVersion)
+	}
+
+	archive := NewArchive()
+	for chainID, entries := range serialized.Chains {
+		archive.chains[chainID] = make(map[string]Entry, len(entries))
+		for _, entry := range entries {
+			key, err := rpckey.ForRequest(entry.Request)
+			if err != nil {
+				return nil, err
+			}
+			archive.chains[chainID][key] = entry
+		}
+	}
+
+	return archive, nil
+}
+
+// Record records the response to a single request. Responses to requests that were already
+// recorded replace the previous response.
+func (a *Archive) Record(chainID uint32, req rpc.Request, response []byte) error {
+	key, err := rpckey.ForRequest(req)
+	if err != nil {
+		return err
+	}
+
+	var message map[string]json.RawMessage
+	err = json.Unmarshal(response, &message)
+	if err != nil {
+		return fmt.Errorf("could not parse response: %w", err)
+	}
+	// ids are set from the request on replay
+	delete(message, "id")
+
+	stripped, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("could not marshall response: %w", err)
+	}
+
+	req.ID = 0
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	if _, ok := a.chains[chainID]; !ok {
+		a.chains[chainID] = make(map[string]Entry)
+	}
+	a.chains[chainID][key] = Entry{Request: req, Response: stripped}
+	a.dirty = true
+
+	return nil
+}
+
+// Lookup gets the recorded response to a request w/ the id set to the request id.
+// ErrNotRecorded is returned if the request was never recorded.
+func (a *Archive) Lookup(chainID uint32, req rpc.Request) ([]byte, error) {
+	key, err := rpckey.ForRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mux.RLock()
+	entry, ok := a.chains[chainID][key]
+	a.mux.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w for %s on chain %d", ErrNotRecorded, key, chainID)
+	}
+
+	var message map[string]json.RawMessage
+	err = json.Unmarshal(entry.Response, &message)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse recorded response: %w", err)
+	}
+
+	message["id"], err = json.Marshal(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshall id: %w", err)
+	}
+
+	response, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshall response: %w", err)
+	}
+	return response, nil
+}
+
+// ChainIDs gets the chain ids w/ recorded responses.
+func (a *Archive) ChainIDs() (chainIDs []uint32) {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+
+	for chainID := range a.chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	return chainIDs
+}
+
+// Save writes the archive to a gzipped json file if there are unsaved entries. Entries are sorted
+// so archives can be diffed.
+func (a *Archive) Save(path string) error {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	if !a.dirty {
+		return nil
+	}
+
+	serialized := archiveFile{
+		Version: archiveVersion,
+		Chains:  make(map[uint32][]Entry, len(a.chains)),
+	}
+
+	for chainID, entries := range a.chains {
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			serialized.Chains[chainID] = append(serialized.Chains[chainID], entries[key])
+		}
+	}
+
+	contents, err := json.MarshalIndent(serialized, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshall archive: %w", err)
+	}
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err = writer.Write(contents); err != nil {
+		return fmt.Errorf("could not compress archive: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("could not compress archive: %w", err)
+	}
+
+	// write to a temp file and rename so the archive is never partially written
+	tmpPath := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%s.tmp", filepath.Base(path)))
+	err = os.WriteFile(tmpPath, compressed.Bytes(), 0600)
+	if err != nil {
+		return fmt.Errorf("could not write archive %s: %w", tmpPath, err)
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return fmt.Errorf("could not replace archive %s: %w", path, err)
+	}
+
+	a.dirty = false
+	return nil
+}
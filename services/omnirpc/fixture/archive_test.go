@@ -0,0 +1,50 @@
+package fixture_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/fixture"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	archive := fixture.NewArchive()
+
+	recorded := rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  "eth_getBalance",
+		Params:  []json.RawMessage{json.RawMessage(`"0xABCD"`), json.RawMessage(`"0x10"`)},
+	}
+	Nil(t, archive.Record(1, recorded, []byte(`{"jsonrpc":"2.0","id":1,"result":"0x5"}`)))
+
+	path := filepath.Join(t.TempDir(), "fixtures.json.gz")
+	Nil(t, archive.Save(path))
+
+	loaded, err := fixture.LoadArchive(path)
+	Nil(t, err)
+	Equal(t, []uint32{1}, loaded.ChainIDs())
+
+	// ids, whitespace and hex casing are ignored
+	replayed := rpc.Request{
+		ID:      7,
+		JSONRPC: "2.0",
+		Method:  "eth_getBalance",
+		Params:  []json.RawMessage{json.RawMessage(`"0xabcd"`), json.RawMessage(` "0x10" `)},
+	}
+	response, err := loaded.Lookup(1, replayed)
+	Nil(t, err)
+	JSONEq(t, `{"jsonrpc":"2.0","id":7,"result":"0x5"}`, string(response))
+
+	// other chains and params aren't matched
+	_, err = loaded.Lookup(2, replayed)
+	True(t, errors.Is(err, fixture.ErrNotRecorded))
+
+	replayed.Params[1] = json.RawMessage(`"0x11"`)
+	_, err = loaded.Lookup(1, replayed)
+	True(t, errors.Is(err, fixture.ErrNotRecorded))
+}
@@ -0,0 +1,3 @@
+// Package fixture records rpc responses to an archive so they can be replayed without network access.
+// Requests are matched by method and params, so request ids and formatting don't matter.
+package fixture
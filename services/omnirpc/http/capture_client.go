@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"sync"
 
 	"github.com/synapsecns/sanguine/core/bytemap"
 )
@@ -10,6 +11,8 @@
 type CaptureClient struct {
 	requests     []*CapturedRequest
 	responseFunc MakeResponseFunc
+	// mux protects requests since requests can be created concurrently
+	mux sync.Mutex
 }
 
 // MakeResponseFunc is used for mocking responses.
@@ -22,6 +25,9 @@ func NewCaptureClient(responseFunc MakeResponseFunc) *CaptureClient {
 
 // Requests turns a list of sent requests. These are not mutation safe.
 func (c *CaptureClient) Requests() []*CapturedRequest {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
 	return c.requests
 }
 
@@ -31,7 +37,10 @@ func (c *CaptureClient) NewRequest() Request {
 		Client:        c,
 		StringHeaders: make(map[string]string),
 	}
+	c.mux.Lock()
 	c.requests = append(c.requests, &request)
+	c.mux.Unlock()
+
 	return &request
 }
 
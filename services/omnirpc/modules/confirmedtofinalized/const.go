@@ -1,6 +1,7 @@
 package confirmedtofinalized
 
 import (
+	"encoding/json"
 	"errors"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -8,6 +9,8 @@
 var (
 	latestBlock    []byte
 	finalizedBlock []byte
+	// finalizedParam is the finalized block tag as a json param
+	finalizedParam []byte
 )
 
 func init() {
@@ -21,4 +24,9 @@ func init() {
 	if err != nil {
 		panic(errors.New("could not marshall test from finalized block number"))
 	}
+
+	finalizedParam, err = json.Marshal(string(finalizedBlock))
+	if err != nil {
+		panic(errors.New("could not marshall finalized block param"))
+	}
 }
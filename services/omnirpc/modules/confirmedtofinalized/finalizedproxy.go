@@ -143,9 +143,14 @@ func (r *finalizedProxyImpl) ProxyRequest(c *gin.Context) (err error) {
 func rewriteConfirmableRequest(r rpc.Request) rpc.Request {
 	//nolint: exhaustive
 	switch client.RPCMethod(r.Method) {
-	case client.BlockByNumberMethod:
-		r.Params[0] = bytes.Replace(r.Params[0], latestBlock, finalizedBlock, 1)
-	case client.BlockNumberMethod:
+	case client.BlockByNumberMethod, client.BlockNumberMethod:
+		// eth_blockNumber has no params, it's handled by the module
+		if len(r.Params) == 0 {
+			return r
+		}
+
+		// copy the params so the original request isn't modified
+		r.Params = append([]json.RawMessage{}, r.Params...)
 		r.Params[0] = bytes.Replace(r.Params[0], latestBlock, finalizedBlock, 1)
 	}
 	return r
@@ -0,0 +1,71 @@
+package confirmedtofinalized
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/modules"
+)
+
+// ModuleName is the name used to enable the module in a chain config.
+const ModuleName = "confirmed-to-finalized"
+
+// NewModule creates a module that rewrites requests for the latest block to the latest finalized block.
+func NewModule() modules.Module {
+	return finalizedModule{}
+}
+
+// finalizedModule is the module version of the finalized proxy.
+type finalizedModule struct{}
+
+func (f finalizedModule) Name() string {
+	return ModuleName
+}
+
+func (f finalizedModule) RewriteRequest(_ context.Context, req rpc.Request) (rpc.Request, error) {
+	// eth_blockNumber has no block param, so fetch the finalized block instead and return its number
+	if client.RPCMethod(req.Method) == client.BlockNumberMethod {
+		return rpc.Request{
+			ID:      req.ID,
+			JSONRPC: req.JSONRPC,
+			Method:  string(client.BlockByNumberMethod),
+			Params:  []json.RawMessage{finalizedParam, json.RawMessage("false")},
+		}, nil
+	}
+
+	return rewriteConfirmableRequest(req), nil
+}
+
+func (f finalizedModule) TransformResponse(_ context.Context, req rpc.Request, response []byte) ([]byte, error) {
+	if client.RPCMethod(req.Method) != client.BlockNumberMethod {
+		return response, nil
+	}
+
+	var message map[string]json.RawMessage
+	if err := json.Unmarshal(response, &message); err != nil {
+		return nil, fmt.Errorf("could not parse response: %w", err)
+	}
+
+	result, ok := message["result"]
+	if !ok || string(result) == "null" {
+		return response, nil
+	}
+
+	var block struct {
+		Number json.RawMessage `json:"number"`
+	}
+	if err := json.Unmarshal(result, &block); err != nil {
+		return nil, fmt.Errorf("could not parse block: %w", err)
+	}
+
+	message["result"] = block.Number
+
+	transformed, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshall response: %w", err)
+	}
+	return transformed, nil
+}
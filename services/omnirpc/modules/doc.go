@@ -0,0 +1,2 @@
+// Package modules defines the interface for modules that modify requests and responses in the omnirpc proxy.
+package modules
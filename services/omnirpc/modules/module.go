@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"context"
+
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+)
+
+// Module modifies requests before they're forwarded to upstreams and responses before they're returned.
+// Modules are enabled per chain by name and run in the order they're listed in the chain config.
+// Requests pass through modules in order and responses pass back through them in reverse order.
+type Module interface {
+	// Name is the name used to enable the module in a chain config.
+	Name() string
+	// RewriteRequest rewrites a single request before it's forwarded. Batches are rewritten one request at a time.
+	RewriteRequest(ctx context.Context, req rpc.Request) (rpc.Request, error)
+	// TransformResponse transforms a single json-rpc response after it's been confirmed. req is the request
+	// as it was passed to RewriteRequest, before this module rewrote it.
+	TransformResponse(ctx context.Context, req rpc.Request, response []byte) ([]byte, error)
+}
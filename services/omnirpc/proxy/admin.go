@@ -0,0 +1,399 @@
+package proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// errChainNotFound is returned when an admin request references a chain that doesn't exist.
+	errChainNotFound = errors.New("chain not found")
+	// errInvalidChainConfig is returned when an admin request would result in an invalid chain config.
+	errInvalidChainConfig = errors.New("invalid chain config")
+)
+
+// urlRequest is the body of admin requests that operate on a single rpc url.
+type urlRequest struct {
+	URL string `json:"url" binding:"required"`
+}
+
+// confirmationsRequest is the body of a request to change the required confirmations of a chain.
+type confirmationsRequest struct {
+	Confirmations uint16 `json:"confirmations" binding:"required"`
+}
+
+// adminAuth rejects requests that don't have the admin token as a bearer token.
+func adminAuth(token string) gin.HandlerFunc {
+	expected := []byte("Bearer " + token)
+
+	return func(c *gin.Context) {
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader("Authorization")), expected) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "invalid admin token",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// registerAdminRoutes registers routes for managing chains at runtime.
+func (r *RPCProxy) registerAdminRoutes(admin gin.IRoutes) {
+	// gets the config of every chain
+	admin.GET("/chains", func(c *gin.Context) {
+		r.configMux.RLock()
+		defer r.configMux.RUnlock()
+
+		c.JSON(http.StatusOK, r.config.Chains)
+	})
+
+	// adds a chain or replaces an existing chain's config
+	admin.PUT("/chains/:id", func(c *gin.Context) {
+		var req config.ChainConfig
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("could not parse chain config: %v", err),
+			})
+			return
+		}
+
+		r.handleChainUpdate(c, true, func(chainConfig *config.ChainConfig) error {
+			*chainConfig = req
+			return nil
+		})
+	})
+
+	// removes a chain
+	admin.DELETE("/chains/:id", func(c *gin.Context) {
+		chainID, ok := parseAdminChainID(c)
+		if !ok {
+			return
+		}
+
+		err := r.removeChainConfig(chainID)
+		if err != nil {
+			writeAdminError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	})
+
+	// adds an rpc url to a chain
+	admin.POST("/chains/:id/rpcs", func(c *gin.Context) {
+		var req urlRequest
+		if !bindAdminRequest(c, &req) {
+			return
+		}
+
+		r.handleChainUpdate(c, false, func(chainConfig *config.ChainConfig) error {
+			if slices.Contains(chainConfig.RPCs, req.URL) {
+				return fmt.Errorf("%w: %s is already an rpc", errInvalidChainConfig, req.URL)
+			}
+
+			chainConfig.RPCs = append(chainConfig.RPCs, req.URL)
+			return nil
+		})
+	})
+
+	// removes an rpc url from a chain. The url is passed as a query param.
+	admin.DELETE("/chains/:id/rpcs", func(c *gin.Context) {
+		rpcURL := c.Query("url")
+
+		r.handleChainUpdate(c, false, func(chainConfig *config.ChainConfig) error {
+			index := slices.Index(chainConfig.RPCs, rpcURL)
+			if index == -1 {
+				return fmt.Errorf("%w: %s is not an rpc", errInvalidChainConfig, rpcURL)
+			}
+
+			chainConfig.RPCs = slices.Delete(chainConfig.RPCs, index, index+1)
+			chainConfig.Drained = removeURL(chainConfig.Drained, rpcURL)
+			return nil
+		})
+	})
+
+	// changes the number of rpcs that must return the same result
+	admin.PUT("/chains/:id/confirmations", func(c *gin.Context) {
+		var req confirmationsRequest
+		if !bindAdminRequest(c, &req) {
+			return
+		}
+
+		r.handleChainUpdate(c, false, func(chainConfig *config.ChainConfig) error {
+			chainConfig.Checks = req.Confirmations
+			return nil
+		})
+	})
+
+	// stops sending requests to an rpc without removing it
+	admin.POST("/chains/:id/drain", func(c *gin.Context) {
+		var req urlRequest
+		if !bindAdminRequest(c, &req) {
+			return
+		}
+
+		r.handleChainUpdate(c, false, func(chainConfig *config.ChainConfig) error {
+			if !slices.Contains(chainConfig.RPCs, req.URL) {
+				return fmt.Errorf("%w: %s is not an rpc", errInvalidChainConfig, req.URL)
+			}
+
+			if !slices.Contains(chainConfig.Drained, req.URL) {
+				chainConfig.Drained = append(chainConfig.Drained, req.URL)
+			}
+			return nil
+		})
+	})
+
+	// puts a drained rpc back into rotation
+	admin.POST("/chains/:id/undrain", func(c *gin.Context) {
+		var req urlRequest
+		if !bindAdminRequest(c, &req) {
+			return
+		}
+
+		r.handleChainUpdate(c, false, func(chainConfig *config.ChainConfig) error {
+			if !slices.Contains(chainConfig.Drained, req.URL) {
+				return fmt.Errorf("%w: %s is not drained", errInvalidChainConfig, req.URL)
+			}
+
+			chainConfig.Drained = removeURL(chainConfig.Drained, req.URL)
+			return nil
+		})
+	})
+}
+
+// handleChainUpdate applies an update to the chain in the request and writes the new chain config.
+// if create is false, the chain must already exist.
+func (r *RPCProxy) handleChainUpdate(c *gin.Context, create bool, update func(chainConfig *config.ChainConfig) error) {
+	chainID, ok := parseAdminChainID(c)
+	if !ok {
+		return
+	}
+
+	chainConfig, err := r.updateChainConfig(chainID, create, update)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	// refresh now so new urls are ranked before the next scan
+	go r.chainManager.RefreshRPCInfo(context.WithoutCancel(c.Request.Context()), chainID)
+
+	c.JSON(http.StatusOK, chainConfig)
+}
+
+// updateChainConfig updates the config for a chain, persists it and applies it to the chain manager.
+func (r *RPCProxy) updateChainConfig(chainID uint32, create bool, update func(chainConfig *config.ChainConfig) error) (config.ChainConfig, error) {
+	r.configMux.Lock()
+	defer r.configMux.Unlock()
+
+	chainConfig, ok := r.config.Chains[chainID]
+	if !ok && !create {
+		return config.ChainConfig{}, fmt.Errorf("%w: %d", errChainNotFound, chainID)
+	}
+
+	// copy so a failed update doesn't modify the current config
+	chainConfig.RPCs = slices.Clone(chainConfig.RPCs)
+	chainConfig.Drained = slices.Clone(chainConfig.Drained)
+
+	err := update(&chainConfig)
+	if err != nil {
+		return config.ChainConfig{}, err
+	}
+
+	err = validateChainConfig(chainConfig)
+	if err != nil {
+		return config.ChainConfig{}, err
+	}
+
+	err = r.validateModules(chainConfig.Modules)
+	if err != nil {
+		return config.ChainConfig{}, err
+	}
+
+	chains := maps.Clone(r.config.Chains)
+	if chains == nil {
+		chains = make(map[uint32]config.ChainConfig)
+	}
+	chains[chainID] = chainConfig
+
+	err = r.persistChains(chains)
+	if err != nil {
+		return config.ChainConfig{}, err
+	}
+
+	r.config.Chains = chains
+	r.chainManager.UpdateChain(chainID, chainConfig)
+
+	return chainConfig, nil
+}
+
+// removeChainConfig removes a chain, persists the config and removes it from the chain manager.
+func (r *RPCProxy) removeChainConfig(chainID uint32) error {
+	r.configMux.Lock()
+	defer r.configMux.Unlock()
+
+	if _, ok := r.config.Chains[chainID]; !ok {
+		return fmt.Errorf("%w: %d", errChainNotFound, chainID)
+	}
+
+	chains := maps.Clone(r.config.Chains)
+	delete(chains, chainID)
+
+	err := r.persistChains(chains)
+	if err != nil {
+		return err
+	}
+
+	r.config.Chains = chains
+	r.chainManager.RemoveChain(chainID)
+
+	return nil
+}
+
+// persistChains writes chains to the config file. Everything else in the config file is kept as is
+// so flags that override the config (e.g. port) aren't persisted. Callers must hold the config lock.
+func (r *RPCProxy) persistChains(chains map[uint32]config.ChainConfig) error {
+	if r.configPath == "" {
+		return nil
+	}
+
+	path := core.ExpandOrReturnPath(r.configPath)
+
+	fileContents, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read config %s: %w", path, err)
+	}
+
+	fileConfig, err := config.UnmarshallConfig(fileContents)
+	if err != nil {
+		return fmt.Errorf("could not unmarshall config: %w", err)
+	}
+
+	fileConfig.Chains = chains
+
+	output, err := fileConfig.Marshall()
+	if err != nil {
+		return fmt.Errorf("could not marshall config: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("could not stat config %s: %w", path, err)
+	}
+
+	// write to a temp file and rename so the config is never partially written
+	tmpPath := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%s.tmp", filepath.Base(path)))
+	err = os.WriteFile(tmpPath, output, info.Mode().Perm())
+	if err != nil {
+		return fmt.Errorf("could not write config %s: %w", tmpPath, err)
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return fmt.Errorf("could not replace config %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// validateChainConfig makes sure a chain config can be used.
+func validateChainConfig(chainConfig config.ChainConfig) error {
+	if len(chainConfig.RPCs) == 0 {
+		return fmt.Errorf("%w: at least one rpc is required", errInvalidChainConfig)
+	}
+
+	seen := make(map[string]bool, len(chainConfig.RPCs))
+	for _, rpcURL := range chainConfig.RPCs {
+		parsedURL, err := url.ParseRequestURI(rpcURL)
+		if err != nil || parsedURL.Host == "" {
+			return fmt.Errorf("%w: %s is not a valid url", errInvalidChainConfig, rpcURL)
+		}
+
+		if seen[rpcURL] {
+			return fmt.Errorf("%w: %s is duplicated", errInvalidChainConfig, rpcURL)
+		}
+		seen[rpcURL] = true
+	}
+
+	available := len(chainConfig.RPCs)
+	for _, drained := range chainConfig.Drained {
+		if !seen[drained] {
+			return fmt.Errorf("%w: drained url %s is not an rpc", errInvalidChainConfig, drained)
+		}
+		available--
+	}
+
+	if available == 0 {
+		return fmt.Errorf("%w: at least one rpc must not be drained", errInvalidChainConfig)
+	}
+
+	if int(chainConfig.Checks) > available {
+		return fmt.Errorf("%w: %d confirmations required but only %d rpcs are available", errInvalidChainConfig, chainConfig.Checks, available)
+	}
+
+	return validateQuorum(chainConfig)
+}
+
+// removeURL removes a url from a list of urls.
+func removeURL(urls []string, toRemove string) []string {
+	res := make([]string, 0, len(urls))
+	for _, u := range urls {
+		if u != toRemove {
+			res = append(res, u)
+		}
+	}
+	return res
+}
+
+// parseAdminChainID parses the chain id param. A response is written if it's invalid.
+func parseAdminChainID(c *gin.Context) (_ uint32, ok bool) {
+	chainID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("chainid must be a number: %s", c.Param("id")),
+		})
+		return 0, false
+	}
+
+	return uint32(chainID), true
+}
+
+// bindAdminRequest binds the request body. A response is written if it's invalid.
+func bindAdminRequest(c *gin.Context, req interface{}) (ok bool) {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("could not parse request: %v", err),
+		})
+		return false
+	}
+	return true
+}
+
+// writeAdminError writes an error from an admin update.
+func writeAdminError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, errChainNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, errInvalidChainConfig):
+		status = http.StatusBadRequest
+	}
+
+	c.JSON(status, gin.H{
+		"error": err.Error(),
+	})
+}
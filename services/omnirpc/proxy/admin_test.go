@@ -0,0 +1,146 @@
+package proxy_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+const adminToken = "test-token"
+
+// adminRequest makes a request to the admin api.
+func (p *ProxySuite) adminRequest(router *gin.Engine, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var reqBody []byte
+	if body != nil {
+		reqBody = p.MustMarshall(body)
+	}
+
+	req, err := http.NewRequestWithContext(p.GetTestContext(), method, path, bytes.NewReader(reqBody))
+	Nil(p.T(), err)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func (p *ProxySuite) TestAdminAuth() {
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{1: {RPCs: []string{gofakeit.URL()}}},
+		Admin:  config.AdminConfig{Token: adminToken},
+	}, p.metrics)
+	router := prxy.AdminRouter()
+
+	w := p.adminRequest(router, http.MethodGet, "/admin/chains", "wrong-token", nil)
+	Equal(p.T(), http.StatusUnauthorized, w.Code)
+
+	w = p.adminRequest(router, http.MethodGet, "/admin/chains", adminToken, nil)
+	Equal(p.T(), http.StatusOK, w.Code)
+}
+
+// TestAdminPersist makes sure admin changes are applied to the chain manager and persisted to the config file.
+func (p *ProxySuite) TestAdminPersist() {
+	const chainID = 1
+	originalURL := gofakeit.URL()
+	newURL := gofakeit.URL()
+
+	cfg := config.Config{
+		Port:   1234,
+		Chains: map[uint32]config.ChainConfig{chainID: {RPCs: []string{originalURL}}},
+		Admin:  config.AdminConfig{Token: adminToken},
+	}
+
+	fileContents, err := cfg.Marshall()
+	Nil(p.T(), err)
+
+	configPath := filepath.Join(p.T().TempDir(), "omnirpc.yaml")
+	Nil(p.T(), os.WriteFile(configPath, fileContents, 0600))
+
+	// port is overridden by a flag, this should not be persisted
+	cfg.Port = 5678
+	prxy := proxy.NewProxy(cfg, p.metrics)
+	prxy.SetConfigPath(configPath)
+	router := prxy.AdminRouter()
+
+	w := p.adminRequest(router, http.MethodPost, fmt.Sprintf("/admin/chains/%d/rpcs", chainID), adminToken, map[string]string{"url": newURL})
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = p.adminRequest(router, http.MethodPut, fmt.Sprintf("/admin/chains/%d/confirmations", chainID), adminToken, map[string]uint16{"confirmations": 2})
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = p.adminRequest(router, http.MethodPut, "/admin/chains/10", adminToken, config.ChainConfig{RPCs: []string{gofakeit.URL()}})
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+
+	chain := prxy.ChainManager().GetChain(chainID)
+	ElementsMatch(p.T(), []string{originalURL, newURL}, chain.URLs())
+	Equal(p.T(), uint16(2), chain.ConfirmationsThreshold())
+	NotNil(p.T(), prxy.ChainManager().GetChain(10))
+
+	persisted, err := os.ReadFile(configPath)
+	Nil(p.T(), err)
+	persistedConfig, err := config.UnmarshallConfig(persisted)
+	Nil(p.T(), err)
+
+	Equal(p.T(), uint16(1234), persistedConfig.Port)
+	Equal(p.T(), []string{originalURL, newURL}, persistedConfig.Chains[chainID].RPCs)
+	Equal(p.T(), uint16(2), persistedConfig.Chains[chainID].Checks)
+	Len(p.T(), persistedConfig.Chains, 2)
+
+	w = p.adminRequest(router, http.MethodDelete, "/admin/chains/10", adminToken, nil)
+	Equal(p.T(), http.StatusNoContent, w.Code, w.Body.String())
+	Nil(p.T(), prxy.ChainManager().GetChain(10))
+
+	persisted, err = os.ReadFile(configPath)
+	Nil(p.T(), err)
+	persistedConfig, err = config.UnmarshallConfig(persisted)
+	Nil(p.T(), err)
+	Len(p.T(), persistedConfig.Chains, 1)
+}
+
+func (p *ProxySuite) TestAdminDrain() {
+	const chainID = 1
+	drainedURL := gofakeit.URL()
+	activeURL := gofakeit.URL()
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{chainID: {RPCs: []string{drainedURL, activeURL}}},
+		Admin:  config.AdminConfig{Token: adminToken},
+	}, p.metrics)
+	router := prxy.AdminRouter()
+
+	w := p.adminRequest(router, http.MethodPost, fmt.Sprintf("/admin/chains/%d/drain", chainID), adminToken, map[string]string{"url": drainedURL})
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), []string{activeURL}, prxy.ChainManager().GetChain(chainID).URLs())
+
+	// the last rpc can't be drained
+	w = p.adminRequest(router, http.MethodPost, fmt.Sprintf("/admin/chains/%d/drain", chainID), adminToken, map[string]string{"url": activeURL})
+	Equal(p.T(), http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = p.adminRequest(router, http.MethodPost, fmt.Sprintf("/admin/chains/%d/undrain", chainID), adminToken, map[string]string{"url": drainedURL})
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	ElementsMatch(p.T(), []string{drainedURL, activeURL}, prxy.ChainManager().GetChain(chainID).URLs())
+
+	// removing the last rpc is rejected
+	w = p.adminRequest(router, http.MethodDelete, fmt.Sprintf("/admin/chains/%d/rpcs?url=%s", chainID, url.QueryEscape(activeURL)), adminToken, nil)
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	w = p.adminRequest(router, http.MethodDelete, fmt.Sprintf("/admin/chains/%d/rpcs?url=%s", chainID, url.QueryEscape(drainedURL)), adminToken, nil)
+	Equal(p.T(), http.StatusBadRequest, w.Code, w.Body.String())
+
+	var errRes map[string]string
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &errRes))
+	Contains(p.T(), errRes["error"], "at least one rpc")
+
+	w = p.adminRequest(router, http.MethodPost, "/admin/chains/2/rpcs", adminToken, map[string]string{"url": gofakeit.URL()})
+	Equal(p.T(), http.StatusNotFound, w.Code, w.Body.String())
+}
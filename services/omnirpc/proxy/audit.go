@@ -0,0 +1,659 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	goHTTP "net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	jd "github.com/josephburnett/jd/lib"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// auditChecksMetric counts audited responses by upstream and result.
+	auditChecksMetric = "audit_checks"
+	// defaultAuditBlockDepth is the default number of blocks behind the head sampled blocks are.
+	defaultAuditBlockDepth = 10
+	// defaultMaxDivergences is the default number of divergences kept per chain.
+	defaultMaxDivergences = 100
+	// auditWindowSize is the number of recent checks used to compute the mismatch rate.
+	auditWindowSize = 20
+	// minAuditSamples is the minimum number of checks before an upstream can be flagged as inconsistent.
+	minAuditSamples = 5
+	// maxMismatchRate is the rolling mismatch rate (0-1) an upstream is flagged as inconsistent at.
+	maxMismatchRate = 0.5
+	// auditCallTimeout is how long each upstream has to respond to an audit request.
+	auditCallTimeout = time.Second * 10
+	// methodNotFoundCode is the json-rpc error code for unsupported methods.
+	methodNotFoundCode = -32601
+)
+
+// AuditStatus is the status of an upstream according to the auditor.
+type AuditStatus string
+
+const (
+	// AuditOK upstreams agree with the other upstreams.
+	AuditOK AuditStatus = "ok"
+	// AuditInconsistent upstreams frequently disagree with the other upstreams.
+	AuditInconsistent AuditStatus = "inconsistent"
+	// AuditForked upstreams returned a different block than the other upstreams in the last audit.
+	AuditForked AuditStatus = "forked"
+	// AuditBehind upstreams didn't have the block the other upstreams returned in the last audit.
+	AuditBehind AuditStatus = "behind"
+)
+
+// auditResult is the result of comparing a single upstream response.
+type auditResult string
+
+const (
+	auditMatch       auditResult = "match"
+	auditMismatch    auditResult = "mismatch"
+	auditError       auditResult = "error"
+	auditNoConsensus auditResult = "no_consensus"
+	auditBehind      auditResult = "behind"
+)
+
+// Divergence is a response from an upstream that didn't match the other upstreams.
+type Divergence struct {
+	// Time is when the divergence was found
+	Time time.Time `json:"time"`
+	// URL is the upstream that diverged
+	URL string `json:"url"`
+	// Method is the sampled method
+	Method string `json:"method"`
+	// Params are the sampled params
+	Params []json.RawMessage `json:"params"`
+	// BlockNumber is the block that was sampled
+	BlockNumber uint64 `json:"block_number"`
+	// MatchingURLs are the upstreams that returned the consensus response
+	MatchingURLs []string `json:"matching_urls"`
+	// Diff is the jd diff from the consensus response to the upstream's response
+	Diff string `json:"diff"`
+}
+
+// UpstreamAudit is the audit summary of a single upstream.
+type UpstreamAudit struct {
+	// URL is the upstream url
+	URL string `json:"url"`
+	// Status is the current audit status
+	Status AuditStatus `json:"status"`
+	// Checks is the number of responses compared
+	Checks uint64 `json:"checks"`
+	// Mismatches is the number of responses that didn't match the consensus
+	Mismatches uint64 `json:"mismatches"`
+	// Errors is the number of requests that failed or couldn't be standardized
+	Errors uint64 `json:"errors"`
+	// MismatchRate is the rolling mismatch rate
+	MismatchRate float64 `json:"mismatch_rate"`
+	// ForkedAt is the block the upstream returned a different block for, if it's forked
+	ForkedAt uint64 `json:"forked_at,omitempty"`
+	// BehindAt is the block the upstream didn't have, if it's behind
+	BehindAt uint64 `json:"behind_at,omitempty"`
+	// LastMismatch is when the upstream last diverged
+	LastMismatch *time.Time `json:"last_mismatch,omitempty"`
+}
+
+// AuditReport is the audit report for a chain.
+type AuditReport struct {
+	// ChainID is the chain id
+	ChainID uint32 `json:"chain_id"`
+	// LastAudit is when the chain was last audited
+	LastAudit *time.Time `json:"last_audit,omitempty"`
+	// Upstreams are the audit summaries of each upstream
+	Upstreams []UpstreamAudit `json:"upstreams"`
+	// Divergences are the most recent divergences, newest first
+	Divergences []Divergence `json:"divergences"`
+}
+
+// auditor periodically sends the same requests to every upstream of a chain and compares the results.
+type auditor struct {
+	// interval is how often each chain is audited
+	interval time.Duration
+	// blockDepth is how many blocks behind the head sampled blocks are
+	blockDepth uint64
+	// maxDivergences is the number of divergences kept per chain
+	maxDivergences int
+	// checks counts audited responses
+	checks metric.Int64Counter
+	// mux protects chains
+	mux sync.Mutex
+	// chains is chain id -> audit state
+	chains map[uint32]*chainAudit
+}
+
+// chainAudit is the audit state of a single chain.
+type chainAudit struct {
+	// lastAudit is when the chain was last audited
+	lastAudit time.Time
+	// upstreams is url -> audit state
+	upstreams map[string]*upstreamAudit
+	// divergences are the most recent divergences, oldest first
+	divergences []Divergence
+}
+
+// upstreamAudit is the audit state of a single upstream.
+type upstreamAudit struct {
+	checks     uint64
+	mismatches uint64
+	errors     uint64
+	// recent is a ring buffer of recent checks, true indicates a mismatch
+	recent [auditWindowSize]bool
+	// recentCount is the total number of checks recorded in recent
+	recentCount int
+	// forkedAt is the block the upstream returned a different block for, 0 if not forked
+	forkedAt uint64
+	// behindAt is the block the upstream didn't have, 0 if not behind
+	behindAt uint64
+	// lastMismatch is when the upstream last diverged
+	lastMismatch time.Time
+}
+
+func newAuditor(cfg config.AuditConfig, handler metrics.Handler) (*auditor, error) {
+	a := &auditor{
+		interval:       time.Duration(cfg.Interval) * time.Second,
+		blockDepth:     cfg.BlockDepth,
+		maxDivergences: cfg.MaxDivergences,
+		chains:         make(map[uint32]*chainAudit),
+	}
+
+	if a.blockDepth == 0 {
+		a.blockDepth = defaultAuditBlockDepth
+	}
+
+	if a.maxDivergences == 0 {
+		a.maxDivergences = defaultMaxDivergences
+	}
+
+	var err error
+	a.checks, err = handler.Meter(proxyMeter).Int64Counter(auditChecksMetric, metric.WithDescription("audited responses by upstream and result"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+
+	return a, nil
+}
+
+// chain gets the audit state for a chain. Callers must hold the lock.
+func (a *auditor) chain(chainID uint32) *chainAudit {
+	chn, ok := a.chains[chainID]
+	if !ok {
+		chn = &chainAudit{upstreams: make(map[string]*upstreamAudit)}
+		a.chains[chainID] = chn
+	}
+	return chn
+}
+
+// upstream gets the audit state for an upstream. Callers must hold the lock.
+func (c *chainAudit) upstream(url string) *upstreamAudit {
+	upstream, ok := c.upstreams[url]
+	if !ok {
+		upstream = &upstreamAudit{}
+		c.upstreams[url] = upstream
+	}
+	return upstream
+}
+
+// recordCheck records a compared response.
+func (u *upstreamAudit) recordCheck(mismatch bool, now time.Time) {
+	u.checks++
+	u.recent[u.recentCount%auditWindowSize] = mismatch
+	u.recentCount++
+
+	if mismatch {
+		u.mismatches++
+		u.lastMismatch = now
+	}
+}
+
+// mismatchRate gets the rolling mismatch rate.
+func (u *upstreamAudit) mismatchRate() float64 {
+	samples := u.recentCount
+	if samples > auditWindowSize {
+		samples = auditWindowSize
+	}
+
+	if samples == 0 {
+		return 0
+	}
+
+	mismatches := 0
+	for i := 0; i < samples; i++ {
+		if u.recent[i] {
+			mismatches++
+		}
+	}
+
+	return float64(mismatches) / float64(samples)
+}
+
+// status gets the audit status of the upstream.
+func (u *upstreamAudit) status() AuditStatus {
+	if u.forkedAt != 0 {
+		return AuditForked
+	}
+
+	if u.behindAt != 0 {
+		return AuditBehind
+	}
+
+	if u.recentCount >= minAuditSamples && u.mismatchRate() >= maxMismatchRate {
+		return AuditInconsistent
+	}
+
+	return AuditOK
+}
+
+// addDivergence stores a divergence, dropping the oldest if over the limit. Callers must hold the lock.
+func (a *auditor) addDivergence(chn *chainAudit, divergence Divergence) {
+	chn.divergences = append(chn.divergences, divergence)
+	if len(chn.divergences) > a.maxDivergences {
+		chn.divergences = chn.divergences[len(chn.divergences)-a.maxDivergences:]
+	}
+}
+
+// report gets the audit report for the given upstreams of a chain.
+func (a *auditor) report(chainID uint32, urls []string) AuditReport {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	res := AuditReport{
+		ChainID:     chainID,
+		Upstreams:   []UpstreamAudit{},
+		Divergences: []Divergence{},
+	}
+
+	chn, ok := a.chains[chainID]
+	if !ok {
+		return res
+	}
+
+	if !chn.lastAudit.IsZero() {
+		lastAudit := chn.lastAudit
+		res.LastAudit = &lastAudit
+	}
+
+	sortedURLs := append([]string{}, urls...)
+	sort.Strings(sortedURLs)
+
+	for _, url := range sortedURLs {
+		upstream, ok := chn.upstreams[url]
+		if !ok {
+			continue
+		}
+
+		upstreamReport := UpstreamAudit{
+			URL:          url,
+			Status:       upstream.status(),
+			Checks:       upstream.checks,
+			Mismatches:   upstream.mismatches,
+			Errors:       upstream.errors,
+			MismatchRate: upstream.mismatchRate(),
+			ForkedAt:     upstream.forkedAt,
+			BehindAt:     upstream.behindAt,
+		}
+
+		if !upstream.lastMismatch.IsZero() {
+			lastMismatch := upstream.lastMismatch
+			upstreamReport.LastMismatch = &lastMismatch
+		}
+
+		res.Upstreams = append(res.Upstreams, upstreamReport)
+	}
+
+	for i := len(chn.divergences) - 1; i >= 0; i-- {
+		res.Divergences = append(res.Divergences, chn.divergences[i])
+	}
+
+	return res
+}
+
+// auditResponse is a single upstream's response to an audit request.
+type auditResponse struct {
+	// body is the raw response body
+	body []byte
+	// standardized is the standardized response, used for comparison
+	standardized []byte
+	// hash is the hash of the standardized response
+	hash string
+	// err is set if the request failed or the response couldn't be standardized
+	err error
+	// missing is set if the upstream returned null or a not found error, e.g. because it doesn't have the block yet
+	missing bool
+}
+
+// auditSample is the result of sending an audit request to every upstream.
+type auditSample struct {
+	// consensus is the response returned by the most upstreams, nil if there's no consensus
+	consensus *auditResponse
+	// matching are the upstreams that returned the consensus response
+	matching []string
+	// mismatching are the upstreams that returned a different response
+	mismatching []string
+	// behind are the upstreams that didn't have the response
+	behind []string
+}
+
+// startAuditLoop audits every chain at the audit interval.
+func (r *RPCProxy) startAuditLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.auditor.interval):
+			var wg sync.WaitGroup
+
+			for _, chainID := range r.chainManager.GetChainIDs() {
+				wg.Add(1)
+
+				go func(chainID uint32) {
+					defer wg.Done()
+
+					// the chain may have been removed by the admin api
+					if chain := r.chainManager.GetChain(chainID); chain != nil {
+						r.auditChain(ctx, chain)
+					}
+				}(chainID)
+			}
+
+			wg.Wait()
+		}
+	}
+}
+
+// auditURLs gets the upstreams to audit. Ejected upstreams are audited since they're often the ones serving
+// bad data, but drained upstreams are not.
+func auditURLs(chain chainmanager.Chain) (urls []string) {
+	for _, health := range chain.Health() {
+		if health.Status == chainmanager.Drained {
+			continue
+		}
+		urls = append(urls, health.URL)
+	}
+	return urls
+}
+
+// auditChain samples a block, its logs and one of its receipts from every upstream of a chain and
+// compares the results.
+func (r *RPCProxy) auditChain(parentCtx context.Context, chain chainmanager.Chain) {
+	chainID := chain.ID()
+
+	ctx, span := r.tracer.Start(parentCtx, "auditChain", trace.WithAttributes(attribute.Int64(metrics.ChainID, int64(chainID))))
+	defer func() {
+		metrics.EndSpan(span)
+	}()
+
+	// responses can't be compared w/ fewer than 2 upstreams
+	urls := auditURLs(chain)
+	if len(urls) < 2 {
+		return
+	}
+
+	head := chain.LatestBlockNumber()
+	if head <= r.auditor.blockDepth {
+		return
+	}
+
+	blockNumber := head - r.auditor.blockDepth
+	blockParam := json.RawMessage(fmt.Sprintf("%q", hexutil.EncodeUint64(blockNumber)))
+
+	blockSample := r.auditRequest(ctx, chainID, blockNumber, urls, rpc.Request{
+		Method: string(client.BlockByNumberMethod),
+		Params: []json.RawMessage{blockParam, json.RawMessage("false")},
+	})
+	r.recordForks(chainID, blockNumber, blockSample)
+
+	r.auditRequest(ctx, chainID, blockNumber, urls, rpc.Request{
+		Method: string(client.GetLogsMethod),
+		Params: []json.RawMessage{json.RawMessage(fmt.Sprintf(`{"fromBlock":%s,"toBlock":%s}`, blockParam, blockParam))},
+	})
+
+	if txHash, ok := sampleTransaction(blockSample.consensus); ok {
+		r.auditRequest(ctx, chainID, blockNumber, urls, rpc.Request{
+			Method: string(client.TransactionReceiptByHashMethod),
+			Params: []json.RawMessage{json.RawMessage(fmt.Sprintf("%q", txHash.Hex()))},
+		})
+	}
+
+	r.auditor.mux.Lock()
+	r.auditor.chain(chainID).lastAudit = time.Now()
+	r.auditor.mux.Unlock()
+}
+
+// sampleTransaction picks a random transaction from a block response.
+func sampleTransaction(block *auditResponse) (txHash common.Hash, ok bool) {
+	if block == nil {
+		return txHash, false
+	}
+
+	var rpcMessage struct {
+		Result struct {
+			Transactions []common.Hash `json:"transactions"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(block.body, &rpcMessage); err != nil || len(rpcMessage.Result.Transactions) == 0 {
+		return txHash, false
+	}
+
+	//nolint: gosec
+	return rpcMessage.Result.Transactions[rand.Intn(len(rpcMessage.Result.Transactions))], true
+}
+
+// recordForks flags upstreams that returned a different block than the consensus as forked, upstreams that didn't
+// have the block as behind and clears the flags for upstreams that agree.
+func (r *RPCProxy) recordForks(chainID uint32, blockNumber uint64, sample auditSample) {
+	if sample.consensus == nil {
+		return
+	}
+
+	r.auditor.mux.Lock()
+	defer r.auditor.mux.Unlock()
+
+	chn := r.auditor.chain(chainID)
+	for _, url := range sample.matching {
+		chn.upstream(url).forkedAt = 0
+		chn.upstream(url).behindAt = 0
+	}
+	for _, url := range sample.mismatching {
+		chn.upstream(url).forkedAt = blockNumber
+		chn.upstream(url).behindAt = 0
+	}
+	for _, url := range sample.behind {
+		chn.upstream(url).behindAt = blockNumber
+	}
+}
+
+// auditRequest sends a request to every upstream, compares the standardized responses and records the results.
+func (r *RPCProxy) auditRequest(ctx context.Context, chainID uint32, blockNumber uint64, urls []string, req rpc.Request) (sample auditSample) {
+	req.ID = 1
+	req.JSONRPC = "2.0"
+
+	responses := make([]auditResponse, len(urls))
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		logger.Warnf("could not marshall audit request: %v", err)
+		return sample
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i, url := range urls {
+		i, url := i, url
+		g.Go(func() error {
+			responses[i] = r.auditCall(gctx, url, &req, body)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	// group the upstreams by response
+	groups := make(map[string][]int)
+	for i, response := range responses {
+		if response.err == nil && !response.missing {
+			groups[response.hash] = append(groups[response.hash], i)
+		}
+	}
+
+	// the consensus is the response returned by the most upstreams. Ties have no consensus
+	var consensusHash string
+	tied := false
+	for hash, group := range groups {
+		switch {
+		case consensusHash == "" || len(group) > len(groups[consensusHash]):
+			consensusHash = hash
+			tied = false
+		case len(group) == len(groups[consensusHash]):
+			tied = true
+		}
+	}
+
+	hasConsensus := consensusHash != "" && !tied
+	if hasConsensus {
+		sample.consensus = &responses[groups[consensusHash][0]]
+		for _, i := range groups[consensusHash] {
+			sample.matching = append(sample.matching, urls[i])
+		}
+	}
+
+	now := time.Now()
+
+	r.auditor.mux.Lock()
+	defer r.auditor.mux.Unlock()
+
+	chn := r.auditor.chain(chainID)
+	for i, response := range responses {
+		upstream := chn.upstream(urls[i])
+
+		var result auditResult
+		switch {
+		case response.err != nil:
+			result = auditError
+			upstream.errors++
+		case response.missing:
+			// upstreams that don't have the block yet are behind rather than diverging
+			result = auditBehind
+			sample.behind = append(sample.behind, urls[i])
+		case !hasConsensus:
+			result = auditNoConsensus
+		case response.hash == consensusHash:
+			result = auditMatch
+			upstream.recordCheck(false, now)
+		default:
+			result = auditMismatch
+			upstream.recordCheck(true, now)
+			sample.mismatching = append(sample.mismatching, urls[i])
+
+			r.auditor.addDivergence(chn, Divergence{
+				Time:         now,
+				URL:          urls[i],
+				Method:       req.Method,
+				Params:       req.Params,
+				BlockNumber:  blockNumber,
+				MatchingURLs: sample.matching,
+				Diff:         diffResponses(sample.consensus.standardized, response.standardized),
+			})
+		}
+
+		r.auditor.checks.Add(ctx, 1, metric.WithAttributes(
+			attribute.Int64(metrics.ChainID, int64(chainID)),
+			attribute.String("rpc_url", urls[i]),
+			attribute.String("method", req.Method),
+			attribute.String("result", string(result)),
+		))
+	}
+
+	return sample
+}
+
+// auditCall sends an audit request to a single upstream and standardizes the response.
+func (r *RPCProxy) auditCall(parentCtx context.Context, url string, req *rpc.Request, body []byte) (res auditResponse) {
+	ctx, cancel := context.WithTimeout(parentCtx, auditCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.NewRequest().
+		SetContext(ctx).
+		SetRequestURI(url).
+		SetBody(body).
+		SetHeaderBytes(http.XForwardedFor, http.OmniRPCValue).
+		SetHeaderBytes(http.ContentType, http.JSONType).
+		SetHeaderBytes(http.Accept, http.JSONType).
+		Do()
+	if err != nil {
+		res.err = fmt.Errorf("could not get response from %s: %w", url, err)
+		return res
+	}
+
+	if resp.StatusCode() < 200 || resp.StatusCode() > 400 {
+		res.err = fmt.Errorf("invalid response code: %d (%s)", resp.StatusCode(), goHTTP.StatusText(resp.StatusCode()))
+		return res
+	}
+
+	res.body = resp.Body()
+
+	var rpcMessage JSONRPCMessage
+	if err = json.Unmarshal(res.body, &rpcMessage); err != nil {
+		res.err = fmt.Errorf("could not parse response: %w", err)
+		return res
+	}
+
+	if isMissingResponse(rpcMessage) {
+		res.missing = true
+		return res
+	}
+
+	if rpcMessage.Error != nil {
+		res.err = upstreamError(rpcMessage.Error)
+		return res
+	}
+
+	res.standardized, err = standardizeResponse(ctx, req, rpcMessage)
+	if err != nil {
+		res.err = fmt.Errorf("could not standardize response: %w", err)
+		return res
+	}
+
+	res.hash = hashBytes(res.standardized)
+	return res
+}
+
+// isMissingResponse checks if an upstream returned null or a not found error (e.g. header not found).
+func isMissingResponse(rpcMessage JSONRPCMessage) bool {
+	if rpcMessage.Error != nil {
+		return rpcMessage.Error.Code != methodNotFoundCode && strings.Contains(strings.ToLower(rpcMessage.Error.Message), "not found")
+	}
+
+	result := bytes.TrimSpace(rpcMessage.Result)
+	return len(result) == 0 || bytes.Equal(result, []byte("null"))
+}
+
+// diffResponses renders the jd diff between two standardized responses.
+func diffResponses(expected, actual []byte) string {
+	expectedNode, err := jd.ReadJsonString(string(expected))
+	if err != nil {
+		return fmt.Sprintf("could not parse consensus response: %v", err)
+	}
+
+	actualNode, err := jd.ReadJsonString(string(actual))
+	if err != nil {
+		return fmt.Sprintf("could not parse response: %v", err)
+	}
+
+	return expectedNode.Diff(actualNode).Render()
+}
@@ -0,0 +1,158 @@
+package proxy_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	chainManagerMocks "github.com/synapsecns/sanguine/services/omnirpc/chainmanager/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func (p *ProxySuite) TestAuditor() {
+	const goodA, goodB, bad = "https://good-a", "https://good-b", "https://bad"
+
+	chain := new(chainManagerMocks.Chain)
+	chain.On("ID").Return(uint32(1))
+	chain.On("LatestBlockNumber").Return(uint64(100))
+	chain.On("Health").Return([]chainmanager.UpstreamHealth{
+		{URL: goodA, Status: chainmanager.Healthy},
+		{URL: goodB, Status: chainmanager.Healthy},
+		{URL: bad, Status: chainmanager.Ejected},
+		{URL: gofakeit.URL(), Status: chainmanager.Drained},
+	})
+
+	block := p.mockBlock(90)
+	forkedHeader := p.MustMarshall(&types.Header{Number: big.NewInt(90), Difficulty: big.NewInt(0), Extra: []byte("fork")})
+	forkedBlock := strings.TrimSuffix(string(forkedHeader), "}") + `,"uncles":[]}`
+	extraLogs := p.MustMarshall([]types.Log{{
+		Address:     common.BigToAddress(big.NewInt(1)),
+		Topics:      []common.Hash{common.BigToHash(big.NewInt(2))},
+		Data:        []byte{1},
+		BlockNumber: 90,
+	}})
+
+	forked := true
+	prxy := proxy.NewProxy(config.Config{Audit: config.AuditConfig{Interval: 1}}, p.metrics)
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		var req rpc.Request
+		Nil(p.T(), json.Unmarshal(c.Body, &req))
+
+		result := "[]"
+		if req.Method == string(client.BlockByNumberMethod) {
+			Equal(p.T(), `"0x5a"`, string(req.Params[0]))
+			result = block
+			if c.RequestURI == bad && forked {
+				result = forkedBlock
+			}
+		} else if c.RequestURI == bad {
+			result = string(extraLogs)
+		}
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+		return bodyRes, nil
+	}))
+
+	prxy.AuditChain(p.GetTestContext(), chain)
+
+	report := prxy.AuditReport(chain)
+	NotNil(p.T(), report.LastAudit)
+	// drained upstreams aren't audited
+	Len(p.T(), report.Upstreams, 3)
+	Equal(p.T(), bad, report.Upstreams[0].URL)
+	Equal(p.T(), proxy.AuditForked, report.Upstreams[0].Status)
+	Equal(p.T(), uint64(90), report.Upstreams[0].ForkedAt)
+	Equal(p.T(), proxy.AuditOK, report.Upstreams[1].Status)
+
+	// newest first
+	Len(p.T(), report.Divergences, 2)
+	Equal(p.T(), string(client.GetLogsMethod), report.Divergences[0].Method)
+	Equal(p.T(), string(client.BlockByNumberMethod), report.Divergences[1].Method)
+	Equal(p.T(), bad, report.Divergences[1].URL)
+	ElementsMatch(p.T(), []string{goodA, goodB}, report.Divergences[1].MatchingURLs)
+	Contains(p.T(), report.Divergences[1].Diff, "extraData")
+
+	// back on the canonical chain, but logs are still wrong
+	forked = false
+	prxy.AuditChain(p.GetTestContext(), chain)
+	prxy.AuditChain(p.GetTestContext(), chain)
+
+	report = prxy.AuditReport(chain)
+	Equal(p.T(), proxy.AuditInconsistent, report.Upstreams[0].Status)
+	Zero(p.T(), report.Upstreams[0].ForkedAt)
+	Equal(p.T(), uint64(6), report.Upstreams[0].Checks)
+	Equal(p.T(), uint64(4), report.Upstreams[0].Mismatches)
+	Equal(p.T(), proxy.AuditOK, report.Upstreams[2].Status)
+}
+
+func (p *ProxySuite) TestAuditorBehind() {
+	const goodA, goodB, behind = "https://good-a", "https://good-b", "https://behind"
+
+	chain := new(chainManagerMocks.Chain)
+	chain.On("ID").Return(uint32(1))
+	chain.On("LatestBlockNumber").Return(uint64(100))
+	chain.On("Health").Return([]chainmanager.UpstreamHealth{
+		{URL: goodA, Status: chainmanager.Healthy},
+		{URL: goodB, Status: chainmanager.Healthy},
+		{URL: behind, Status: chainmanager.Healthy},
+	})
+
+	block := p.mockBlock(90)
+
+	synced := false
+	prxy := proxy.NewProxy(config.Config{Audit: config.AuditConfig{Interval: 1}}, p.metrics)
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		// every call has its own deadline so a hanging upstream can't stall the audit
+		_, hasDeadline := c.Context.Deadline()
+		True(p.T(), hasDeadline)
+
+		var req rpc.Request
+		Nil(p.T(), json.Unmarshal(c.Body, &req))
+
+		body := `{"jsonrpc":"2.0","id":1,"result":[]}`
+		switch {
+		case c.RequestURI == behind && !synced && req.Method == string(client.BlockByNumberMethod):
+			body = `{"jsonrpc":"2.0","id":1,"result":null}`
+		case c.RequestURI == behind && !synced:
+			body = `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`
+		case req.Method == string(client.BlockByNumberMethod):
+			body = `{"jsonrpc":"2.0","id":1,"result":` + block + `}`
+		}
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(body))
+		return bodyRes, nil
+	}))
+
+	prxy.AuditChain(p.GetTestContext(), chain)
+
+	report := prxy.AuditReport(chain)
+	Len(p.T(), report.Upstreams, 3)
+	Equal(p.T(), behind, report.Upstreams[0].URL)
+	Equal(p.T(), proxy.AuditBehind, report.Upstreams[0].Status)
+	Equal(p.T(), uint64(90), report.Upstreams[0].BehindAt)
+	Zero(p.T(), report.Upstreams[0].ForkedAt)
+	Zero(p.T(), report.Upstreams[0].Mismatches)
+	Zero(p.T(), report.Upstreams[0].Errors)
+	Empty(p.T(), report.Divergences)
+
+	synced = true
+	prxy.AuditChain(p.GetTestContext(), chain)
+
+	report = prxy.AuditReport(chain)
+	Equal(p.T(), proxy.AuditOK, report.Upstreams[0].Status)
+	Zero(p.T(), report.Upstreams[0].BehindAt)
+}
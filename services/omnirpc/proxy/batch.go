@@ -0,0 +1,307 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxParallelBatchChunks is the maximum number of sub-batches forwarded at once for a single request.
+	maxParallelBatchChunks = 8
+	// maxParallelBatchElements is the maximum number of elements of a sub-batch forwarded at once when the
+	// sub-batch couldn't be confirmed.
+	maxParallelBatchElements = 8
+	// batchChunksHeader is the number of sub-batches a batch was split into.
+	batchChunksHeader = "x-batch-chunks"
+	// batchElementErrorCode is returned for batch elements that couldn't be confirmed.
+	batchElementErrorCode = -32603
+)
+
+// batchSize gets the largest batch every url accepts, 0 if batches shouldn't be split.
+func (f *Forwarder) batchSize(urls []string) int {
+	chainConfig := f.r.chainConfig(f.chain.ID())
+
+	size := chainConfig.MaxBatchSize
+	for _, url := range urls {
+		if limit, ok := chainConfig.BatchLimits[url]; ok && limit > 0 && (size == 0 || limit < size) {
+			size = limit
+		}
+	}
+	return size
+}
+
+// shouldSplitBatch checks if the request is a batch that should be split into sub-batches.
+func (f *Forwarder) shouldSplitBatch() (size int, ok bool) {
+	if !rpc.IsBatch(f.body) || len(f.rpcRequest) == 0 {
+		return 0, false
+	}
+
+	size = f.batchSize(f.urls)
+	return size, size > 0
+}
+
+// batchGroup is a set of batch elements that share a quorum and required confirmations.
+type batchGroup struct {
+	quorum                quorum
+	requiredConfirmations uint16
+	urls                  []string
+	// indexes are the positions of requests in the batch
+	indexes  []int
+	requests rpc.Requests
+}
+
+// batchGroupKey is the comparable part of a batch group's policy.
+type batchGroupKey struct {
+	policy                string
+	upstreams             uint16
+	weight                float64
+	onDivergence          string
+	requiredConfirmations uint16
+}
+
+// batchGroups groups the elements of a batch by quorum and required confirmations, in order of first appearance.
+// Unlike the batch as a whole, a confirmable element keeps its confirmations even if other elements aren't confirmable.
+func (f *Forwarder) batchGroups() ([]*batchGroup, error) {
+	byKey := make(map[batchGroupKey]*batchGroup)
+	var groups []*batchGroup
+
+	for i, request := range f.rpcRequest {
+		requests := rpc.Requests{request}
+
+		confirmable, err := areConfirmable(requests)
+		if err != nil {
+			return nil, err
+		}
+
+		q, requiredConfirmations := f.requestPolicy(requests, confirmable)
+		key := batchGroupKey{
+			policy:                q.policy,
+			upstreams:             q.upstreams,
+			weight:                q.weight,
+			onDivergence:          q.onDivergence,
+			requiredConfirmations: requiredConfirmations,
+		}
+
+		group, ok := byKey[key]
+		if !ok {
+			group = &batchGroup{quorum: q, requiredConfirmations: requiredConfirmations}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+		group.indexes = append(group.indexes, i)
+		group.requests = append(group.requests, request)
+	}
+
+	for _, group := range groups {
+		group.urls = f.upstreamURLs(group.requests, group.quorum, group.requiredConfirmations)
+	}
+
+	return groups, nil
+}
+
+// batchChunk is a sub-batch of a batch group.
+type batchChunk struct {
+	group    *batchGroup
+	indexes  []int
+	requests rpc.Requests
+}
+
+// splitBatch splits requests into sub-batches of at most size requests.
+func splitBatch(requests rpc.Requests, size int) (chunks []rpc.Requests) {
+	for start := 0; start < len(requests); start += size {
+		end := start + size
+		if end > len(requests) {
+			end = len(requests)
+		}
+		chunks = append(chunks, requests[start:end])
+	}
+	return chunks
+}
+
+// forwardBatch splits a batch into sub-batches of elements w/ the same confirmations that fit within the limits of
+// every upstream, forwards them in parallel (with confirmations) and reassembles the responses in order. Sub-batches
+// that can't be confirmed are retried element by element, so a bad element only results in an error for that element.
+func (f *Forwarder) forwardBatch(ctx context.Context, size int) {
+	groups, err := f.batchGroups()
+	if err != nil {
+		f.c.JSON(http.StatusBadRequest, gin.H{
+			"error": err,
+		})
+		return
+	}
+
+	var chunks []batchChunk
+	for _, group := range groups {
+		groupSize := size
+		if limit := f.batchSize(group.urls); limit > 0 && limit < groupSize {
+			groupSize = limit
+		}
+
+		for start, requests := range splitBatch(group.requests, groupSize) {
+			offset := start * groupSize
+			chunks = append(chunks, batchChunk{
+				group:    group,
+				indexes:  group.indexes[offset : offset+len(requests)],
+				requests: requests,
+			})
+		}
+	}
+
+	f.c.Header(batchChunksHeader, strconv.Itoa(len(chunks)))
+
+	responses := make([]json.RawMessage, len(f.rpcRequest))
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallelBatchChunks)
+
+	for i, chunk := range chunks {
+		i := i
+		chunk := chunk
+		g.Go(func() error {
+			chunkResponses, err := f.forwardBatchChunk(groupCtx, chunk.group, chunk.requests, i)
+			if err != nil {
+				return err
+			}
+
+			for j, index := range chunk.indexes {
+				responses[index] = chunkResponses[j]
+			}
+			return nil
+		})
+	}
+
+	// errors are only returned if the request was canceled
+	if err := g.Wait(); err != nil {
+		return
+	}
+	f.setDivergenceHeader()
+
+	body, err := json.Marshal(responses)
+	if err != nil {
+		f.c.JSON(http.StatusInternalServerError, gin.H{
+			"error": fmt.Sprintf("could not marshall batch: %v", err),
+		})
+		return
+	}
+
+	f.writeData(ctx, body)
+}
+
+// forwardBatchChunk forwards a sub-batch and returns the responses in request order. If the sub-batch can't
+// be confirmed, each element is forwarded on its own.
+func (f *Forwarder) forwardBatchChunk(ctx context.Context, group *batchGroup, requests rpc.Requests, offset int) ([]json.RawMessage, error) {
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshall sub-batch: %w", err)
+	}
+
+	sub := f.batchSubForwarder(group, body, requests, offset)
+	responses, errResponse := sub.forwardAndConfirm(ctx)
+	f.releaseSubForwarder(sub)
+
+	if errResponse == nil && len(responses) == 0 {
+		return nil, fmt.Errorf("could not get sub-batch: %w", ctx.Err())
+	}
+
+	if errResponse == nil {
+		if elements, err := batchResponsesByID(requests, responses[0].body); err == nil {
+			return elements, nil
+		}
+	}
+
+	// fall back to confirming each element separately
+	elements := make([]json.RawMessage, len(requests))
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallelBatchElements)
+
+	for i, request := range requests {
+		i := i
+		request := request
+		g.Go(func() (err error) {
+			elements[i], err = f.forwardBatchElement(groupCtx, group, request, offset+i)
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("could not get sub-batch elements: %w", err)
+	}
+
+	return elements, nil
+}
+
+// forwardBatchElement forwards a single element of a batch. If it can't be confirmed, a json-rpc error w/ the
+// details of the upstream responses is returned in place of the response.
+func (f *Forwarder) forwardBatchElement(ctx context.Context, group *batchGroup, request rpc.Request, offset int) (json.RawMessage, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshall element: %w", err)
+	}
+
+	sub := f.batchSubForwarder(group, body, rpc.Requests{request}, offset)
+	responses, errResponse := sub.forwardAndConfirm(ctx)
+	f.releaseSubForwarder(sub)
+
+	switch {
+	case errResponse != nil:
+		version := request.JSONRPC
+		if version == "" {
+			version = "2.0"
+		}
+
+		//nolint: wrapcheck
+		return json.Marshal(JSONRPCMessage{
+			Version: version,
+			ID:      request.ID,
+			Error:   &JSONError{Code: batchElementErrorCode, Message: errResponse.Error, Data: errResponse},
+		})
+	case len(responses) == 0:
+		return nil, fmt.Errorf("could not get element: %w", ctx.Err())
+	default:
+		return responses[0].body, nil
+	}
+}
+
+// batchSubForwarder creates a sub-forwarder for requests in a batch group. The caller must release it.
+func (f *Forwarder) batchSubForwarder(group *batchGroup, body []byte, requests rpc.Requests, offset int) *Forwarder {
+	sub := f.subForwarder(body, requests, rotate(group.urls, offset))
+	sub.quorum = group.quorum
+	sub.requiredConfirmations = group.requiredConfirmations
+	return sub
+}
+
+// batchResponsesByID parses a batch response and orders the responses like the requests.
+func batchResponsesByID(requests rpc.Requests, body []byte) ([]json.RawMessage, error) {
+	var rawResponses []json.RawMessage
+	if err := json.Unmarshal(body, &rawResponses); err != nil {
+		return nil, fmt.Errorf("could not parse batch response: %w", err)
+	}
+
+	byID := make(map[int]json.RawMessage, len(rawResponses))
+	for _, rawResponse := range rawResponses {
+		var rpcMessage JSONRPCMessage
+		if err := json.Unmarshal(rawResponse, &rpcMessage); err != nil {
+			return nil, fmt.Errorf("could not parse response: %w", err)
+		}
+		byID[rpcMessage.ID] = rawResponse
+	}
+
+	res := make([]json.RawMessage, len(requests))
+	for i, request := range requests {
+		response, ok := byID[request.ID]
+		if !ok {
+			return nil, fmt.Errorf("no response for id %d", request.ID)
+		}
+		res[i] = response
+	}
+
+	return res, nil
+}
@@ -0,0 +1,160 @@
+package proxy_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func (p *ProxySuite) TestBatchSplitting() {
+	const badID = 4
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{1: {
+			RPCs:         []string{upstreamA, upstreamB, upstreamC},
+			Checks:       2,
+			MaxBatchSize: 5,
+			BatchLimits:  map[string]int{upstreamB: 2},
+		}},
+	}, p.metrics)
+
+	var mux sync.Mutex
+	var batchSizes []int
+
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		requests, err := rpc.ParseRPCPayload(c.Body)
+		Nil(p.T(), err)
+
+		mux.Lock()
+		batchSizes = append(batchSizes, len(requests))
+		mux.Unlock()
+
+		responses := make([]string, len(requests))
+		for i, request := range requests {
+			if request.ID == badID {
+				responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"error":{"code":-32000,"message":"header not found"}}`, request.ID)
+				continue
+			}
+			responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":"0x%x"}`, request.ID, request.ID)
+		}
+
+		body := responses[0]
+		if rpc.IsBatch(c.Body) {
+			body = "[" + strings.Join(responses, ",") + "]"
+		}
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(body))
+		return bodyRes, nil
+	}))
+
+	var batch rpc.Requests
+	for i := 1; i <= 5; i++ {
+		batch = append(batch, rpc.Request{ID: i, JSONRPC: "2.0", Method: string(client.ChainIDMethod)})
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(batch)))
+
+	prxy.Forward(c, 1, nil)
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	// upstream b only accepts 2 requests per batch
+	Equal(p.T(), "3", w.Header().Get("x-batch-chunks"))
+	for _, size := range batchSizes {
+		LessOrEqual(p.T(), size, 2)
+	}
+
+	var responses []proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &responses))
+	Len(p.T(), responses, 5)
+
+	for i, response := range responses {
+		Equal(p.T(), i+1, response.ID)
+
+		if response.ID == badID {
+			NotNil(p.T(), response.Error)
+			Nil(p.T(), response.Result)
+			continue
+		}
+
+		Nil(p.T(), response.Error)
+		Equal(p.T(), fmt.Sprintf(`"0x%x"`, response.ID), string(response.Result))
+	}
+}
+
+func (p *ProxySuite) TestBatchSplittingConfirmations() {
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{1: {
+			RPCs:         []string{upstreamA, upstreamB, upstreamC},
+			Checks:       2,
+			MaxBatchSize: 1,
+		}},
+	}, p.metrics)
+
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		requests, err := rpc.ParseRPCPayload(c.Body)
+		Nil(p.T(), err)
+
+		responses := make([]string, len(requests))
+		for i, request := range requests {
+			result := "0x1"
+			// every upstream has a different balance, so it can never be confirmed
+			if request.Method == string(client.GetBalanceMethod) {
+				result = fmt.Sprintf("0x%x", c.RequestURI)
+			}
+			responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":"%s"}`, request.ID, result)
+		}
+
+		body := responses[0]
+		if rpc.IsBatch(c.Body) {
+			body = "[" + strings.Join(responses, ",") + "]"
+		}
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(body))
+		return bodyRes, nil
+	}))
+
+	batch := rpc.Requests{
+		{ID: 1, JSONRPC: "2.0", Method: string(client.BlockNumberMethod)},
+		{ID: 2, JSONRPC: "2.0", Method: string(client.GetBalanceMethod), Params: []json.RawMessage{json.RawMessage(`"0x0000000000000000000000000000000000000001"`), json.RawMessage(`"0x1"`)}},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(batch)))
+
+	prxy.Forward(c, 1, nil)
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), "2", w.Header().Get("x-batch-chunks"))
+
+	var responses []proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &responses))
+	Len(p.T(), responses, 2)
+
+	// the non-confirmable block number only needs one upstream
+	Equal(p.T(), 1, responses[0].ID)
+	Nil(p.T(), responses[0].Error)
+
+	// but it shouldn't lower the confirmations of the balance
+	Equal(p.T(), 2, responses[1].ID)
+	NotNil(p.T(), responses[1].Error)
+}
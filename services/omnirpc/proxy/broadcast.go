@@ -0,0 +1,219 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/exp/slices"
+)
+
+// broadcastTimeout is how long upstreams have to respond to a broadcast transaction.
+// this is independent of the request since we keep broadcasting after the first success.
+const broadcastTimeout = time.Second * 30
+
+// broadcastResultsMetric counts the results of broadcasting to each upstream.
+const broadcastResultsMetric = "broadcast_results"
+
+// broadcastStatus is the result of broadcasting to a single upstream.
+type broadcastStatus string
+
+const (
+	// broadcastAccepted means the upstream accepted the transaction.
+	broadcastAccepted broadcastStatus = "accepted"
+	// broadcastKnown means the upstream already had the transaction, usually because another upstream propagated it.
+	broadcastKnown broadcastStatus = "known"
+	// broadcastRejected means the upstream returned a json-rpc error.
+	broadcastRejected broadcastStatus = "rejected"
+	// broadcastFailed means the upstream did not return a valid response.
+	broadcastFailed broadcastStatus = "failed"
+)
+
+// benignBroadcastErrors are errors returned by upstreams that already have the transaction.
+// nonce too low is included since the transaction might have been mined after being propagated by another upstream.
+var benignBroadcastErrors = []string{"already known", "known transaction", "already imported", "nonce too low"}
+
+// broadcastResult is the result of sending a transaction to a single upstream.
+type broadcastResult struct {
+	url     string
+	status  broadcastStatus
+	body    []byte
+	latency time.Duration
+	err     error
+}
+
+// shouldBroadcast determines whether the request should be sent to every upstream.
+func (f *Forwarder) shouldBroadcast() bool {
+	if len(f.rpcRequest) != 1 || client.RPCMethod(f.rpcRequest[0].Method) != client.SendRawTransactionMethod {
+		return false
+	}
+
+	return f.r.chainConfig(f.chain.ID()).BroadcastTransactions
+}
+
+// broadcast sends the transaction to every healthy upstream concurrently and returns the first
+// success. Upstreams that haven't responded yet keep going in the background so the transaction
+// propagates as widely as possible.
+func (f *Forwarder) broadcast(ctx context.Context) {
+	// these are copied since the forwarder is released as soon as we respond
+	urls := slices.Clone(f.urls)
+	body := slices.Clone(f.body)
+	requestID := slices.Clone(f.requestID)
+	recorder := broadcastRecorder{
+		chain:   f.chain,
+		counter: f.r.broadcastCounter,
+	}
+
+	broadcastCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), broadcastTimeout)
+
+	// buffered so upstreams that respond after we're done never block
+	resChan := make(chan broadcastResult, len(urls))
+	for _, url := range urls {
+		go func(url string) {
+			resChan <- sendBroadcast(broadcastCtx, f.client, url, body, requestID)
+		}(url)
+	}
+
+	f.c.Header("x-broadcast", "true")
+
+	var results []broadcastResult
+	for received := 1; received <= len(urls); received++ {
+		res := <-resChan
+		recorder.record(broadcastCtx, res)
+		results = append(results, res)
+
+		if res.status != broadcastAccepted {
+			continue
+		}
+
+		f.c.Header(forwardedFrom, res.url)
+		f.writeData(ctx, res.body)
+
+		go func(remaining int) {
+			defer cancel()
+			for i := 0; i < remaining; i++ {
+				recorder.record(broadcastCtx, <-resChan)
+			}
+		}(len(urls) - received)
+		return
+	}
+
+	cancel()
+	f.writeBroadcastFailure(ctx, results)
+}
+
+// writeBroadcastFailure writes the response when no upstream accepted the transaction.
+// benign responses are preferred since they indicate the transaction is in the mempool.
+func (f *Forwarder) writeBroadcastFailure(ctx context.Context, results []broadcastResult) {
+	for _, preferred := range []broadcastStatus{broadcastKnown, broadcastRejected} {
+		for _, res := range results {
+			if res.status == preferred {
+				f.c.Header(forwardedFrom, res.url)
+				f.writeData(ctx, res.body)
+				return
+			}
+		}
+	}
+
+	errResponse := ErrorResponse{
+		Error:          "could not broadcast transaction",
+		FailedForwards: make(map[string]string),
+	}
+
+	for _, res := range results {
+		errResponse.ErroredURLS = append(errResponse.ErroredURLS, res.url)
+		errResponse.FailedForwards[res.url] = res.err.Error()
+	}
+
+	f.c.JSON(http.StatusBadGateway, errResponse)
+}
+
+// sendBroadcast sends a transaction to a single upstream.
+func sendBroadcast(ctx context.Context, httpClient omniHTTP.Client, url string, body, requestID []byte) (res broadcastResult) {
+	res = broadcastResult{url: url, status: broadcastFailed}
+
+	startTime := time.Now()
+	defer func() {
+		res.latency = time.Since(startTime)
+	}()
+
+	resp, err := httpClient.NewRequest().
+		SetContext(ctx).
+		SetRequestURI(url).
+		SetBody(body).
+		SetHeaderBytes(omniHTTP.XRequestID, requestID).
+		SetHeaderBytes(omniHTTP.XForwardedFor, omniHTTP.OmniRPCValue).
+		SetHeaderBytes(omniHTTP.ContentType, omniHTTP.JSONType).
+		SetHeaderBytes(omniHTTP.Accept, omniHTTP.JSONType).
+		Do()
+	if err != nil {
+		res.err = fmt.Errorf("could not get response from %s: %w", url, err)
+		return res
+	}
+
+	if resp.StatusCode() < 200 || resp.StatusCode() > 400 {
+		res.err = fmt.Errorf("invalid response code: %d (%s)", resp.StatusCode(), http.StatusText(resp.StatusCode()))
+		return res
+	}
+
+	var rpcMessage JSONRPCMessage
+	if err := json.Unmarshal(resp.Body(), &rpcMessage); err != nil {
+		res.err = fmt.Errorf("could not parse response: %w", err)
+		return res
+	}
+
+	res.body = resp.Body()
+	res.status = classifyBroadcast(rpcMessage)
+	if rpcMessage.Error != nil {
+		res.err = fmt.Errorf("upstream returned error: %s", rpcMessage.Error.Message)
+	}
+
+	return res
+}
+
+// classifyBroadcast classifies a valid json-rpc response to eth_sendRawTransaction.
+func classifyBroadcast(rpcMessage JSONRPCMessage) broadcastStatus {
+	if rpcMessage.Error == nil {
+		return broadcastAccepted
+	}
+
+	errMessage := strings.ToLower(rpcMessage.Error.Message)
+	for _, benign := range benignBroadcastErrors {
+		if strings.Contains(errMessage, benign) {
+			return broadcastKnown
+		}
+	}
+
+	return broadcastRejected
+}
+
+// broadcastRecorder records broadcast results. This is separate from the forwarder so results
+// can be recorded after the forwarder is released.
+type broadcastRecorder struct {
+	chain   chainmanager.Chain
+	counter metric.Int64Counter
+}
+
+func (b broadcastRecorder) record(ctx context.Context, res broadcastResult) {
+	b.counter.Add(ctx, 1, metric.WithAttributes(
+		attribute.Int64(metrics.ChainID, int64(b.chain.ID())),
+		attribute.String("rpc_url", res.url),
+		attribute.String("result", string(res.status)),
+	))
+
+	// json-rpc errors are the transactions fault, not the upstreams
+	if res.status == broadcastFailed {
+		b.chain.RecordResult(res.url, res.latency, res.err)
+	} else if ctx.Err() == nil {
+		b.chain.RecordResult(res.url, res.latency, nil)
+	}
+}
@@ -0,0 +1,89 @@
+package proxy_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// broadcastTest sends a raw transaction to a proxy where each url returns the given response.
+func (p *ProxySuite) broadcastTest(responses map[string]string) (*httptest.ResponseRecorder, *omniHTTP.CaptureClient) {
+	const chainID = 1
+
+	var urls []string
+	for url := range responses {
+		urls = append(urls, url)
+	}
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: urls, Checks: 1, BroadcastTransactions: true},
+		},
+	}, p.metrics)
+
+	var mux sync.Mutex
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		mux.Lock()
+		defer mux.Unlock()
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("Body").Return([]byte(responses[c.RequestURI]))
+		bodyRes.On("StatusCode").Return(200)
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  string(client.SendRawTransactionMethod),
+		Params:  []json.RawMessage{[]byte(`"0x01"`)},
+	})))
+
+	prxy.Forward(c, chainID, nil)
+	return w, captureClient
+}
+
+func (p *ProxySuite) TestBroadcastFirstSuccess() {
+	acceptedURL := gofakeit.URL()
+	w, _ := p.broadcastTest(map[string]string{
+		gofakeit.URL(): `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"already known"}}`,
+		gofakeit.URL(): `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"nonce too low"}}`,
+		acceptedURL:    `{"jsonrpc":"2.0","id":1,"result":"0xabc"}`,
+	})
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), acceptedURL, w.Header().Get("x-forwarded-from"))
+
+	var res proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res))
+	Nil(p.T(), res.Error)
+	Equal(p.T(), json.RawMessage(`"0xabc"`), res.Result)
+}
+
+func (p *ProxySuite) TestBroadcastPrefersBenign() {
+	knownURL := gofakeit.URL()
+	w, captureClient := p.broadcastTest(map[string]string{
+		gofakeit.URL(): `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"insufficient funds"}}`,
+		knownURL:       `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"already known"}}`,
+	})
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), knownURL, w.Header().Get("x-forwarded-from"))
+	// every upstream should have been sent the transaction
+	Len(p.T(), captureClient.Requests(), 2)
+}
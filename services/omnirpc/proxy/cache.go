@@ -0,0 +1,310 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/cache"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/rpckey"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/exp/slices"
+)
+
+// cacheHeader is a header specifying whether the response was served from the cache.
+const cacheHeader = "x-omnirpc-cache"
+
+// defaultCacheConfirmationDepth is the default number of blocks a response must be behind the head to be cached.
+const defaultCacheConfirmationDepth = 64
+
+const (
+	cacheHitsMetric   = "cache_hits"
+	cacheMissesMetric = "cache_misses"
+)
+
+// cacheableMethods are methods that can be served from the cache. Whether a specific response
+// can be cached is determined by isCacheable.
+var cacheableMethods = []client.RPCMethod{
+	client.ChainIDMethod,
+	client.BlockByHashMethod,
+	client.BlockByNumberMethod,
+	client.TransactionByHashMethod,
+	client.TransactionByBlockHashAndIndexMethod,
+	client.TransactionReceiptByHashMethod,
+	client.GetBalanceMethod,
+	client.GetCodeMethod,
+	client.TransactionCountMethod,
+	client.CallMethod,
+	client.StorageAtMethod,
+	client.GetLogsMethod,
+}
+
+// responseCache caches responses that can no longer change.
+type responseCache struct {
+	// cache is the underlying store
+	cache cache.Cache
+	// confirmationDepth is how many blocks behind the head a response must be to be cached
+	confirmationDepth uint64
+	// hits counts cache hits
+	hits metric.Int64Counter
+	// misses counts cache misses
+	misses metric.Int64Counter
+}
+
+func newResponseCache(cfg config.CacheConfig, handler metrics.Handler) (*responseCache, error) {
+	store, err := cache.NewCache(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cache: %w", err)
+	}
+
+	rc := &responseCache{
+		cache:             store,
+		confirmationDepth: cfg.ConfirmationDepth,
+	}
+
+	if rc.confirmationDepth == 0 {
+		rc.confirmationDepth = defaultCacheConfirmationDepth
+	}
+
+	meter := handler.Meter(proxyMeter)
+	rc.hits, err = meter.Int64Counter(cacheHitsMetric, metric.WithDescription("number of requests served from the cache"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+
+	rc.misses, err = meter.Int64Counter(cacheMissesMetric, metric.WithDescription("number of cacheable requests not found in the cache"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+
+	return rc, nil
+}
+
+// get gets a cached result and records the hit/miss.
+func (r *responseCache) get(ctx context.Context, chainID uint32, req rpc.Request) (result []byte, ok bool) {
+	key, err := cacheKey(chainID, req)
+	if err != nil {
+		return nil, false
+	}
+
+	attributes := metric.WithAttributes(attribute.Int64(metrics.ChainID, int64(chainID)), attribute.String("method", req.Method))
+
+	result, ok = r.cache.Get(key)
+	if ok {
+		r.hits.Add(ctx, 1, attributes)
+	} else {
+		r.misses.Add(ctx, 1, attributes)
+	}
+
+	return result, ok
+}
+
+// put stores a result if it is immutable.
+func (r *responseCache) put(chainID uint32, head uint64, req rpc.Request, result json.RawMessage) error {
+	if !r.isCacheable(req, result, head) {
+		return nil
+	}
+
+	key, err := cacheKey(chainID, req)
+	if err != nil {
+		return err
+	}
+
+	err = r.cache.Put(key, result)
+	if err != nil {
+		return fmt.Errorf("could not cache response: %w", err)
+	}
+	return nil
+}
+
+// closeOnDone closes the cache once the context is done.
+func (r *responseCache) closeOnDone(ctx context.Context) {
+	<-ctx.Done()
+	if err := r.cache.Close(); err != nil {
+		logger.Errorf("could not close response cache: %v", err)
+	}
+}
+
+// cacheKey creates a key for a request. Request ids and formatting are ignored so
+// identical requests from different clients share a key.
+func cacheKey(chainID uint32, req rpc.Request) (string, error) {
+	requestKey, err := rpckey.ForRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return hashBytes([]byte(fmt.Sprintf("%d:%s", chainID, requestKey))), nil
+}
+
+// isCacheable determines whether a result is immutable, that is, it does not depend on the head or
+// is at least confirmationDepth blocks behind the head.
+//
+//nolint:cyclop
+func (r *responseCache) isCacheable(req rpc.Request, result json.RawMessage, head uint64) bool {
+	if len(result) == 0 || bytes.Equal(result, []byte("null")) {
+		return false
+	}
+
+	var blockNumber uint64
+	var ok bool
+
+	//nolint: exhaustive
+	switch client.RPCMethod(req.Method) {
+	case client.ChainIDMethod:
+		return true
+	case client.BlockByHashMethod, client.TransactionByHashMethod, client.TransactionByBlockHashAndIndexMethod, client.TransactionReceiptByHashMethod:
+		blockNumber, ok = resultBlockNumber(result)
+	case client.BlockByNumberMethod:
+		blockNumber, ok = paramBlockNumber(req.Params, 0)
+	case client.GetBalanceMethod, client.GetCodeMethod, client.TransactionCountMethod, client.CallMethod:
+		blockNumber, ok = paramBlockNumber(req.Params, 1)
+	case client.StorageAtMethod:
+		blockNumber, ok = paramBlockNumber(req.Params, 2)
+	case client.GetLogsMethod:
+		if len(req.Params) == 0 {
+			return false
+		}
+		blockNumber, ok = logsBlockNumber(req.Params[0], result)
+	default:
+		return false
+	}
+
+	if !ok || head < r.confirmationDepth {
+		return false
+	}
+
+	return blockNumber <= head-r.confirmationDepth
+}
+
+// resultBlockNumber gets the block number from a block, transaction or receipt result.
+// ok is false for pending results.
+func resultBlockNumber(result json.RawMessage) (_ uint64, ok bool) {
+	var blockInfo struct {
+		BlockNumber *hexutil.Uint64 `json:"blockNumber"`
+		Number      *hexutil.Uint64 `json:"number"`
+	}
+
+	if err := json.Unmarshal(result, &blockInfo); err != nil {
+		return 0, false
+	}
+
+	if blockInfo.BlockNumber != nil {
+		return uint64(*blockInfo.BlockNumber), true
+	}
+
+	if blockInfo.Number != nil {
+		return uint64(*blockInfo.Number), true
+	}
+
+	return 0, false
+}
+
+// paramBlockNumber gets a numeric block number from the param at index. ok is false for tags (e.g. latest)
+// or block hashes.
+func paramBlockNumber(params []json.RawMessage, index int) (_ uint64, ok bool) {
+	if len(params) <= index {
+		return 0, false
+	}
+
+	var blockNumber gethRPC.BlockNumber
+	if err := blockNumber.UnmarshalJSON(params[index]); err != nil {
+		return 0, false
+	}
+
+	if blockNumber < 0 {
+		return 0, false
+	}
+
+	return uint64(blockNumber.Int64()), true
+}
+
+// logsBlockNumber gets the highest block a log filter can return.
+func logsBlockNumber(filterArg json.RawMessage, result json.RawMessage) (_ uint64, ok bool) {
+	filterCriteria := filters.FilterCriteria{}
+	if err := filterCriteria.UnmarshalJSON(filterArg); err != nil {
+		return 0, false
+	}
+
+	// block hash filters have no range, use the logs themselves
+	if filterCriteria.BlockHash != nil {
+		var logs []types.Log
+		if err := json.Unmarshal(result, &logs); err != nil || len(logs) == 0 {
+			return 0, false
+		}
+		return logs[0].BlockNumber, true
+	}
+
+	if filterCriteria.ToBlock == nil || filterCriteria.ToBlock.Sign() < 0 {
+		return 0, false
+	}
+
+	if filterCriteria.FromBlock != nil && filterCriteria.FromBlock.Sign() < 0 {
+		return 0, false
+	}
+
+	return filterCriteria.ToBlock.Uint64(), true
+}
+
+// usesCache determines whether the request can be served from or stored in the cache. Requests that override the
+// required confirmations skip the cache, since cached responses were confirmed by the chain's threshold.
+func (f *Forwarder) usesCache() bool {
+	return f.r.cache != nil && len(f.rpcRequest) == 1 && f.confirmationsOverride == 0
+}
+
+// checkCache serves the request from the cache if possible.
+func (f *Forwarder) checkCache(ctx context.Context) (ok bool) {
+	if !f.usesCache() {
+		return false
+	}
+
+	request := f.rpcRequest[0]
+	if !slices.Contains(cacheableMethods, client.RPCMethod(request.Method)) {
+		return false
+	}
+
+	result, ok := f.r.cache.get(ctx, f.chain.ID(), request)
+	if !ok {
+		f.c.Header(cacheHeader, "miss")
+		return false
+	}
+
+	f.c.Header(cacheHeader, "hit")
+	f.writeMessage(ctx, result)
+	return true
+}
+
+// storeCache stores a validated response in the cache if it is immutable.
+func (f *Forwarder) storeCache(res rawResponse) {
+	if !f.usesCache() || res.hasError {
+		return
+	}
+
+	var rpcMessage JSONRPCMessage
+	if err := json.Unmarshal(res.body, &rpcMessage); err != nil {
+		return
+	}
+
+	f.storeResult(rpcMessage.Result)
+}
+
+// storeResult stores the result of the request in the cache if it is immutable.
+func (f *Forwarder) storeResult(result json.RawMessage) {
+	if !f.usesCache() {
+		return
+	}
+
+	err := f.r.cache.put(f.chain.ID(), f.chain.LatestBlockNumber(), f.rpcRequest[0], result)
+	if err != nil {
+		logger.Warnf("could not store response: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package proxy_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func (p *ProxySuite) TestCacheKey() {
+	chainID := gofakeit.Uint32()
+
+	keyA, err := proxy.CacheKey(chainID, rpc.Request{
+		ID:     1,
+		Method: string(client.GetBalanceMethod),
+		Params: []json.RawMessage{[]byte(`"0xAbC"`), []byte(` "0x1" `)},
+	})
+	Nil(p.T(), err)
+
+	// ids, whitespace and hex casing should be ignored
+	keyB, err := proxy.CacheKey(chainID, rpc.Request{
+		ID:     2,
+		Method: string(client.GetBalanceMethod),
+		Params: []json.RawMessage{[]byte(`"0xabc"`), []byte(`"0x1"`)},
+	})
+	Nil(p.T(), err)
+	Equal(p.T(), keyA, keyB)
+
+	keyC, err := proxy.CacheKey(chainID+1, rpc.Request{
+		ID:     2,
+		Method: string(client.GetBalanceMethod),
+		Params: []json.RawMessage{[]byte(`"0xabc"`), []byte(`"0x1"`)},
+	})
+	Nil(p.T(), err)
+	NotEqual(p.T(), keyA, keyC)
+}
+
+func (p *ProxySuite) TestIsCacheable() {
+	const depth = 10
+	const head = 100
+
+	makeReq := func(method client.RPCMethod, params ...string) rpc.Request {
+		req := rpc.Request{ID: 1, Method: string(method)}
+		for _, param := range params {
+			req.Params = append(req.Params, []byte(param))
+		}
+		return req
+	}
+
+	tests := []struct {
+		name      string
+		req       rpc.Request
+		result    string
+		cacheable bool
+	}{
+		{"chain id", makeReq(client.ChainIDMethod), `"0x1"`, true},
+		{"null result", makeReq(client.ChainIDMethod), `null`, false},
+		{"old block by number", makeReq(client.BlockByNumberMethod, `"0x5"`, `false`), `{"number":"0x5"}`, true},
+		{"recent block by number", makeReq(client.BlockByNumberMethod, `"0x60"`, `false`), `{"number":"0x60"}`, false},
+		{"latest block", makeReq(client.BlockByNumberMethod, `"latest"`, `false`), `{"number":"0x5"}`, false},
+		{"old block by hash", makeReq(client.BlockByHashMethod, `"0x01"`, `false`), `{"number":"0x5"}`, true},
+		{"recent block by hash", makeReq(client.BlockByHashMethod, `"0x01"`, `false`), `{"number":"0x5f"}`, false},
+		{"old receipt", makeReq(client.TransactionReceiptByHashMethod, `"0x01"`), `{"blockNumber":"0x5"}`, true},
+		{"pending transaction", makeReq(client.TransactionByHashMethod, `"0x01"`), `{"blockNumber":null}`, false},
+		{"old balance", makeReq(client.GetBalanceMethod, `"0x01"`, `"0x5"`), `"0x10"`, true},
+		{"latest balance", makeReq(client.GetBalanceMethod, `"0x01"`, `"latest"`), `"0x10"`, false},
+		{"old logs", makeReq(client.GetLogsMethod, `{"fromBlock":"0x1","toBlock":"0x5"}`), `[]`, true},
+		{"latest logs", makeReq(client.GetLogsMethod, `{"fromBlock":"0x1","toBlock":"latest"}`), `[]`, false},
+		{"block number", makeReq(client.BlockNumberMethod), `"0x5"`, false},
+	}
+
+	for _, tt := range tests {
+		Equal(p.T(), tt.cacheable, proxy.IsCacheable(depth, tt.req, []byte(tt.result), head), tt.name)
+	}
+
+	// nothing height dependent should be cached if the head is unknown
+	False(p.T(), proxy.IsCacheable(depth, makeReq(client.GetBalanceMethod, `"0x01"`, `"0x1"`), []byte(`"0x10"`), 0))
+}
+
+func (p *ProxySuite) TestForwardFromCache() {
+	const chainID = 1
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{gofakeit.URL()}, Checks: 1},
+		},
+		Cache: config.CacheConfig{Size: 10, DiskPath: filet.TmpDir(p.T(), "")},
+	}, p.metrics)
+
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		bodyRes := new(mocks.Response)
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		bodyRes.On("StatusCode").Return(200)
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	forward := func(id int, confirmations *uint16) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+			ID:      id,
+			JSONRPC: "2.0",
+			Method:  string(client.ChainIDMethod),
+		})))
+
+		prxy.Forward(c, chainID, confirmations)
+		return w
+	}
+
+	first := forward(1, nil)
+	Equal(p.T(), http.StatusOK, first.Code)
+	Equal(p.T(), "miss", first.Header().Get("x-omnirpc-cache"))
+	Len(p.T(), captureClient.Requests(), 1)
+
+	second := forward(5, nil)
+	Equal(p.T(), http.StatusOK, second.Code)
+	Equal(p.T(), "hit", second.Header().Get("x-omnirpc-cache"))
+	// the upstream should not have been hit again
+	Len(p.T(), captureClient.Requests(), 1)
+
+	var res proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(second.Body.Bytes(), &res))
+	Equal(p.T(), 5, res.ID)
+	Equal(p.T(), json.RawMessage(`"0x1"`), res.Result)
+
+	// overriding the confirmations skips the cache
+	confirmations := uint16(1)
+	third := forward(6, &confirmations)
+	Equal(p.T(), http.StatusOK, third.Code)
+	Empty(p.T(), third.Header().Get("x-omnirpc-cache"))
+	Len(p.T(), captureClient.Requests(), 2)
+}
@@ -0,0 +1,301 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+)
+
+// apiKeyHeader is the header clients can pass their api key in. Keys can also be passed as a path segment.
+const apiKeyHeader = "x-api-key"
+
+// clientContextKey is the key the authenticated client is stored under in the gin context.
+const clientContextKey = "omnirpc-client"
+
+// clientRequestsMetric counts requests by client and result.
+const clientRequestsMetric = "client_requests"
+
+// json-rpc error codes returned to clients, see EIP-1474.
+const (
+	// unauthorizedErrorCode is returned when the api key is missing or invalid.
+	unauthorizedErrorCode = -32000
+	// methodNotAllowedErrorCode is returned when the client can't call a method.
+	methodNotAllowedErrorCode = -32004
+	// limitExceededErrorCode is returned when the client is over its rate limit or daily quota.
+	limitExceededErrorCode = -32005
+)
+
+// clientResult is the result of authorizing a client request.
+type clientResult string
+
+const (
+	clientAllowed          clientResult = "allowed"
+	clientMethodDenied     clientResult = "method_denied"
+	clientRateLimited      clientResult = "rate_limited"
+	clientQuotaExceeded    clientResult = "quota_exceeded"
+	clientUnauthorizedCall clientResult = "unauthorized"
+)
+
+// apiClient is an authenticated client.
+type apiClient struct {
+	// name is the name of the client
+	name string
+	// allowedMethods are method patterns the client can call, all methods are allowed if empty
+	allowedMethods []string
+	// deniedMethods are method patterns the client can't call
+	deniedMethods []string
+	// limiter is the rate limiter, nil if unlimited
+	limiter *rate.Limiter
+	// dailyQuota is the number of requests per day, 0 if unlimited
+	dailyQuota uint64
+	// mux protects day and dailyCount
+	mux sync.Mutex
+	// day is the current day (UTC) the count is for
+	day string
+	// dailyCount is the number of requests made today
+	dailyCount uint64
+}
+
+func newAPIClient(name string, cfg config.ClientConfig) *apiClient {
+	client := &apiClient{
+		name:           name,
+		allowedMethods: cfg.AllowedMethods,
+		deniedMethods:  cfg.DeniedMethods,
+		dailyQuota:     cfg.DailyQuota,
+	}
+
+	if cfg.RequestsPerSecond > 0 {
+		burst := cfg.Burst
+		if burst == 0 {
+			burst = int(cfg.RequestsPerSecond)
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		client.limiter = rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), burst)
+	}
+
+	return client
+}
+
+// methodAllowed checks the method against the client's allow and deny lists.
+func (a *apiClient) methodAllowed(method string) bool {
+	if matchesMethod(a.deniedMethods, method) {
+		return false
+	}
+
+	return len(a.allowedMethods) == 0 || matchesMethod(a.allowedMethods, method)
+}
+
+// matchesMethod checks if a method matches any of the patterns.
+func matchesMethod(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		// patterns are validated when the registry is created
+		if matched, _ := path.Match(pattern, method); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// take consumes count requests from the client's daily quota and rate limit. A batch larger than the burst
+// can never be paid for, so it is always rate limited.
+func (a *apiClient) take(count int, now time.Time) (clientResult, string) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	day := now.UTC().Format(time.DateOnly)
+	if day != a.day {
+		a.day = day
+		a.dailyCount = 0
+	}
+
+	if a.dailyQuota > 0 && a.dailyCount+uint64(count) > a.dailyQuota {
+		return clientQuotaExceeded, fmt.Sprintf("daily quota of %d requests exceeded", a.dailyQuota)
+	}
+
+	if a.limiter != nil && count > a.limiter.Burst() {
+		return clientRateLimited, fmt.Sprintf("batch of %d requests exceeds the rate limit burst of %d", count, a.limiter.Burst())
+	}
+	if a.limiter != nil && !a.limiter.AllowN(now, count) {
+		return clientRateLimited, fmt.Sprintf("rate limit of %g requests per second exceeded", float64(a.limiter.Limit()))
+	}
+
+	a.dailyCount += uint64(count)
+	return clientAllowed, ""
+}
+
+// clientRegistry holds the api clients by key.
+type clientRegistry struct {
+	// required is true if requests must use an api key
+	required bool
+	// byKey is api key -> client
+	byKey map[string]*apiClient
+	// requests counts requests by client and result
+	requests metric.Int64Counter
+}
+
+func newClientRegistry(clients map[string]config.ClientConfig, handler metrics.Handler) (*clientRegistry, error) {
+	registry := &clientRegistry{
+		required: true,
+		byKey:    make(map[string]*apiClient, len(clients)),
+	}
+
+	for name, cfg := range clients {
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("client %s has no api key", name)
+		}
+
+		if _, ok := registry.byKey[cfg.APIKey]; ok {
+			return nil, fmt.Errorf("client %s uses the same api key as another client", name)
+		}
+
+		for _, pattern := range append(append([]string{}, cfg.AllowedMethods...), cfg.DeniedMethods...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("client %s has an invalid method pattern %s: %w", name, pattern, err)
+			}
+		}
+
+		registry.byKey[cfg.APIKey] = newAPIClient(name, cfg)
+	}
+
+	var err error
+	registry.requests, err = handler.Meter(proxyMeter).Int64Counter(clientRequestsMetric, metric.WithDescription("rpc calls by client and result"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+
+	return registry, nil
+}
+
+// enabled returns true if requests must be authenticated.
+func (c *clientRegistry) enabled() bool {
+	return c != nil && c.required
+}
+
+// authenticateClient is middleware that rejects rpc requests without a valid api key if clients are configured.
+func (r *RPCProxy) authenticateClient(c *gin.Context) {
+	if !r.clients.enabled() {
+		c.Next()
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		key = c.GetHeader(apiKeyHeader)
+	}
+
+	client, ok := r.clients.byKey[key]
+	if !ok {
+		chainID, _ := strconv.ParseUint(c.Param("id"), 10, 32)
+		r.clients.requests.Add(c, 1, metric.WithAttributes(
+			attribute.Int64(metrics.ChainID, int64(chainID)),
+			attribute.String("client", ""),
+			attribute.String("result", string(clientUnauthorizedCall)),
+		))
+
+		c.AbortWithStatusJSON(http.StatusUnauthorized, JSONRPCMessage{
+			Version: "2.0",
+			Error:   &JSONError{Code: unauthorizedErrorCode, Message: "missing or invalid api key"},
+		})
+		return
+	}
+
+	c.Set(clientContextKey, client)
+	c.Next()
+}
+
+// authorizeClient checks the request against the client's method lists and quotas. If the request is
+// rejected, a json-rpc error is written.
+func (f *Forwarder) authorizeClient(ctx context.Context) (ok bool) {
+	value, exists := f.c.Get(clientContextKey)
+	if !exists {
+		return true
+	}
+	//nolint: forcetypeassert
+	client := value.(*apiClient)
+
+	// invalid requests are rejected later
+	requests, err := rpc.ParseRPCPayload(f.body)
+	if err != nil || len(requests) == 0 {
+		return true
+	}
+
+	result := clientAllowed
+	var message string
+
+	for _, request := range requests {
+		if !client.methodAllowed(request.Method) {
+			result = clientMethodDenied
+			message = fmt.Sprintf("method %s is not allowed for client %s", request.Method, client.name)
+			break
+		}
+	}
+
+	if result == clientAllowed {
+		result, message = client.take(len(requests), time.Now())
+	}
+
+	f.r.clients.requests.Add(ctx, int64(len(requests)), metric.WithAttributes(
+		attribute.Int64(metrics.ChainID, int64(f.chain.ID())),
+		attribute.String("client", client.name),
+		attribute.String("method", requests.Method()),
+		attribute.String("result", string(result)),
+	))
+
+	switch result {
+	case clientAllowed:
+		return true
+	case clientMethodDenied:
+		f.writeRPCError(requests, http.StatusForbidden, methodNotAllowedErrorCode, message)
+	default:
+		f.writeRPCError(requests, http.StatusTooManyRequests, limitExceededErrorCode, message)
+	}
+	return false
+}
+
+// writeRPCError writes a json-rpc error for every request.
+func (f *Forwarder) writeRPCError(requests rpc.Requests, status int, code int, message string) {
+	responses := make([]JSONRPCMessage, len(requests))
+	for i, request := range requests {
+		version := request.JSONRPC
+		if version == "" {
+			version = "2.0"
+		}
+
+		responses[i] = JSONRPCMessage{
+			Version: version,
+			ID:      request.ID,
+			Error:   &JSONError{Code: code, Message: message},
+		}
+	}
+
+	var body []byte
+	var err error
+	if rpc.IsBatch(f.body) {
+		body, err = json.Marshal(responses)
+	} else {
+		body, err = json.Marshal(responses[0])
+	}
+	if err != nil {
+		f.c.JSON(http.StatusInternalServerError, gin.H{
+			"error": fmt.Sprintf("could not marshall error: %v", err),
+		})
+		return
+	}
+
+	f.c.Data(status, gin.MIMEJSON, body)
+}
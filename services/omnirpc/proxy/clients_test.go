@@ -0,0 +1,143 @@
+package proxy_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// newClientProxy creates a proxy w/ api clients and an upstream that always returns a chain id.
+func (p *ProxySuite) newClientProxy(clients map[string]config.ClientConfig) *gin.Engine {
+	prxy := proxy.NewProxy(config.Config{
+		Chains:  map[uint32]config.ChainConfig{1: {RPCs: []string{gofakeit.URL()}}},
+		Clients: clients,
+	}, p.metrics)
+
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		return bodyRes, nil
+	}))
+
+	return prxy.RPCRouter()
+}
+
+// clientRequest makes a request to the rpc router and parses the response.
+func (p *ProxySuite) clientRequest(router *gin.Engine, path, apiKey string, req interface{}) (int, proxy.JSONRPCMessage) {
+	httpReq, err := http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, path, bytes.NewReader(p.MustMarshall(req)))
+	Nil(p.T(), err)
+	if apiKey != "" {
+		httpReq.Header.Set("x-api-key", apiKey)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httpReq)
+
+	var res proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res), w.Body.String())
+	return w.Code, res
+}
+
+func (p *ProxySuite) TestClientAuthentication() {
+	router := p.newClientProxy(map[string]config.ClientConfig{
+		"relayer": {APIKey: "relayer-key"},
+	})
+	req := rpc.Request{ID: 1, JSONRPC: "2.0", Method: string(client.ChainIDMethod)}
+
+	code, res := p.clientRequest(router, "/rpc/1", "", req)
+	Equal(p.T(), http.StatusUnauthorized, code)
+	NotNil(p.T(), res.Error)
+
+	code, res = p.clientRequest(router, "/rpc/1", "wrong-key", req)
+	Equal(p.T(), http.StatusUnauthorized, code)
+	NotNil(p.T(), res.Error)
+
+	code, res = p.clientRequest(router, "/rpc/1", "relayer-key", req)
+	Equal(p.T(), http.StatusOK, code)
+	Equal(p.T(), `"0x1"`, string(res.Result))
+
+	code, res = p.clientRequest(router, "/key/relayer-key/rpc/1", "", req)
+	Equal(p.T(), http.StatusOK, code)
+	Equal(p.T(), `"0x1"`, string(res.Result))
+}
+
+func (p *ProxySuite) TestClientMethodLists() {
+	router := p.newClientProxy(map[string]config.ClientConfig{
+		"readonly": {APIKey: "readonly-key", AllowedMethods: []string{"eth_*"}, DeniedMethods: []string{string(client.SendRawTransactionMethod)}},
+	})
+
+	code, res := p.clientRequest(router, "/rpc/1", "readonly-key", rpc.Request{ID: 3, JSONRPC: "2.0", Method: string(client.ChainIDMethod)})
+	Equal(p.T(), http.StatusOK, code)
+	Nil(p.T(), res.Error)
+
+	code, res = p.clientRequest(router, "/rpc/1", "readonly-key", rpc.Request{ID: 3, JSONRPC: "2.0", Method: string(client.SendRawTransactionMethod), Params: []json.RawMessage{json.RawMessage(`"0x00"`)}})
+	Equal(p.T(), http.StatusForbidden, code)
+	Equal(p.T(), 3, res.ID)
+	Equal(p.T(), -32004, res.Error.Code)
+
+	code, res = p.clientRequest(router, "/rpc/1", "readonly-key", rpc.Request{ID: 3, JSONRPC: "2.0", Method: "debug_traceTransaction"})
+	Equal(p.T(), http.StatusForbidden, code)
+	Equal(p.T(), -32004, res.Error.Code)
+}
+
+func (p *ProxySuite) TestClientQuotas() {
+	router := p.newClientProxy(map[string]config.ClientConfig{
+		"backfill": {APIKey: "backfill-key", DailyQuota: 2},
+		"relayer":  {APIKey: "relayer-key", RequestsPerSecond: 0.001, Burst: 1},
+	})
+	req := rpc.Request{ID: 1, JSONRPC: "2.0", Method: string(client.ChainIDMethod)}
+
+	for i := 0; i < 2; i++ {
+		code, _ := p.clientRequest(router, "/rpc/1", "backfill-key", req)
+		Equal(p.T(), http.StatusOK, code)
+	}
+
+	code, res := p.clientRequest(router, "/rpc/1", "backfill-key", req)
+	Equal(p.T(), http.StatusTooManyRequests, code)
+	Equal(p.T(), -32005, res.Error.Code)
+	Contains(p.T(), res.Error.Message, "daily quota")
+
+	// a runaway client doesn't affect other clients
+	code, _ = p.clientRequest(router, "/rpc/1", "relayer-key", req)
+	Equal(p.T(), http.StatusOK, code)
+
+	code, res = p.clientRequest(router, "/rpc/1", "relayer-key", req)
+	Equal(p.T(), http.StatusTooManyRequests, code)
+	Contains(p.T(), res.Error.Message, "rate limit")
+}
+
+func (p *ProxySuite) TestClientBatchLargerThanBurst() {
+	router := p.newClientProxy(map[string]config.ClientConfig{
+		"relayer": {APIKey: "relayer-key", RequestsPerSecond: 0.001, Burst: 2},
+	})
+	batch := rpc.Requests{
+		{ID: 1, JSONRPC: "2.0", Method: string(client.ChainIDMethod)},
+		{ID: 2, JSONRPC: "2.0", Method: string(client.ChainIDMethod)},
+		{ID: 3, JSONRPC: "2.0", Method: string(client.ChainIDMethod)},
+	}
+
+	// even an idle client can't send a batch larger than its burst
+	httpReq, err := http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/rpc/1", bytes.NewReader(p.MustMarshall(batch)))
+	Nil(p.T(), err)
+	httpReq.Header.Set("x-api-key", "relayer-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httpReq)
+	Equal(p.T(), http.StatusTooManyRequests, w.Code)
+	Contains(p.T(), w.Body.String(), "exceeds the rate limit burst of 2")
+
+	// and the rejected batch isn't charged
+	code, _ := p.clientRequest(router, "/rpc/1", "relayer-key", batch[0])
+	NotEqual(p.T(), http.StatusTooManyRequests, code)
+}
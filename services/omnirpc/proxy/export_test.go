@@ -9,6 +9,7 @@
 	"github.com/synapsecns/sanguine/ethergo/client"
 	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
 	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -126,6 +127,10 @@ func (f *Forwarder) SetRPCRequest(rpcRequest []rpc.Request) {
 	f.rpcRequest = rpcRequest
 }
 
+func (f *Forwarder) URLs() []string {
+	return f.urls
+}
+
 func (r rawResponse) Hash() string {
 	return r.hash
 }
@@ -204,3 +209,73 @@ func StandardizeResponseFalseParams(ctx context.Context, req []rpc.Request, body
 func (f *Forwarder) CheckAndSetConfirmability() (ok bool) {
 	return f.checkAndSetConfirmability()
 }
+
+// CacheKey exports cacheKey for testing.
+func CacheKey(chainID uint32, req rpc.Request) (string, error) {
+	return cacheKey(chainID, req)
+}
+
+// IsCacheable exports isCacheable for testing.
+func IsCacheable(confirmationDepth uint64, req rpc.Request, result json.RawMessage, head uint64) bool {
+	rc := responseCache{confirmationDepth: confirmationDepth}
+	return rc.isCacheable(req, result, head)
+}
+
+// ParseLogsLimitError exports parseLogsLimitError for testing.
+func ParseLogsLimitError(message string) (maxRange, maxResults uint64, ok bool) {
+	limit, ok := parseLogsLimitError(message)
+	return limit.maxRange, limit.maxResults, ok
+}
+
+// SplitRange exports splitRange for testing.
+func SplitRange(from, to, size uint64) (res [][2]uint64) {
+	for _, chunk := range splitRange(from, to, size) {
+		res = append(res, [2]uint64{chunk.from, chunk.to})
+	}
+	return res
+}
+
+// AdminRouter creates a router w/ only the admin routes registered.
+func (r *RPCProxy) AdminRouter() *gin.Engine {
+	router := gin.New()
+	r.registerAdminRoutes(router.Group("/admin", adminAuth(r.config.Admin.Token)))
+	return router
+}
+
+// ChainManager exports the chain manager for testing.
+func (r *RPCProxy) ChainManager() chainmanager.ChainManager {
+	return r.chainManager
+}
+
+// SaveFixtures saves recorded fixtures.
+func (r *RPCProxy) SaveFixtures() error {
+	//nolint: wrapcheck
+	return r.fixtures.archive.Save(r.fixtures.path)
+}
+
+// RPCRouter creates a router w/ only the rpc routes registered.
+func (r *RPCProxy) RPCRouter() *gin.Engine {
+	router := gin.New()
+	r.registerRPCRoutes(router)
+	return router
+}
+
+// HistoricalBlock exports historicalBlock for testing.
+func HistoricalBlock(requests rpc.Requests) (uint64, bool) {
+	return historicalBlock(requests)
+}
+
+// AuditChain audits a chain once.
+func (r *RPCProxy) AuditChain(ctx context.Context, chain chainmanager.Chain) {
+	r.auditChain(ctx, chain)
+}
+
+// AuditReport gets the audit report for a chain.
+func (r *RPCProxy) AuditReport(chain chainmanager.Chain) AuditReport {
+	return r.auditor.report(chain.ID(), auditURLs(chain))
+}
+
+// ValidateQuorum exports validateQuorum for testing.
+func ValidateQuorum(chainConfig config.ChainConfig) error {
+	return validateQuorum(chainConfig)
+}
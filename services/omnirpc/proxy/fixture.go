@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/fixture"
+)
+
+// fixtureSaveInterval is how often recorded responses are written to the archive.
+const fixtureSaveInterval = time.Second * 5
+
+// fixtureHeader is a header specifying the response was replayed from a fixture archive.
+const fixtureHeader = "x-omnirpc-fixture"
+
+// fixtures records or replays responses.
+type fixtures struct {
+	// mode is either record or replay
+	mode string
+	// path is the path of the archive
+	path string
+	// archive holds the recorded responses
+	archive *fixture.Archive
+}
+
+// newFixtures loads or creates the fixture archive. When recording, responses are added to an existing archive.
+func newFixtures(cfg config.FixtureConfig) (*fixtures, error) {
+	f := &fixtures{
+		mode: cfg.Mode,
+		path: cfg.Path,
+	}
+
+	if f.path == "" {
+		return nil, errors.New("fixture path is required")
+	}
+
+	var err error
+	switch cfg.Mode {
+	case config.FixtureModeRecord:
+		f.archive, err = fixture.LoadArchive(f.path)
+		if errors.Is(err, os.ErrNotExist) {
+			f.archive, err = fixture.NewArchive(), nil
+		}
+	case config.FixtureModeReplay:
+		f.archive, err = fixture.LoadArchive(f.path)
+	default:
+		return nil, fmt.Errorf("unknown fixture mode %s, must be %s or %s", cfg.Mode, config.FixtureModeRecord, config.FixtureModeReplay)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load fixtures: %w", err)
+	}
+
+	return f, nil
+}
+
+// replaying returns true if responses are served from the archive.
+func (f *fixtures) replaying() bool {
+	return f != nil && f.mode == config.FixtureModeReplay
+}
+
+// recording returns true if responses are recorded to the archive.
+func (f *fixtures) recording() bool {
+	return f != nil && f.mode == config.FixtureModeRecord
+}
+
+// saveLoop periodically saves recorded responses until the context is canceled.
+func (f *fixtures) saveLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			if err := f.archive.Save(f.path); err != nil {
+				logger.Errorf("could not save fixtures: %v", err)
+			}
+			return
+		case <-time.After(fixtureSaveInterval):
+			if err := f.archive.Save(f.path); err != nil {
+				logger.Errorf("could not save fixtures: %v", err)
+			}
+		}
+	}
+}
+
+// recordFixture records a successful response to the request. Batch responses are recorded per request.
+func (f *Forwarder) recordFixture(body []byte) {
+	if !f.r.fixtures.recording() {
+		return
+	}
+
+	if !rpc.IsBatch(body) {
+		if err := f.r.fixtures.archive.Record(f.chain.ID(), f.rpcRequest[0], body); err != nil {
+			logger.Warnf("could not record response: %v", err)
+		}
+		return
+	}
+
+	var responses []json.RawMessage
+	if err := json.Unmarshal(body, &responses); err != nil {
+		logger.Warnf("could not parse batch response: %v", err)
+		return
+	}
+
+	for _, response := range responses {
+		var message struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(response, &message); err != nil {
+			logger.Warnf("could not parse response: %v", err)
+			continue
+		}
+
+		request := f.rpcRequest.ByID(message.ID)
+		if request == nil {
+			continue
+		}
+
+		if err := f.r.fixtures.archive.Record(f.chain.ID(), *request, response); err != nil {
+			logger.Warnf("could not record response: %v", err)
+		}
+	}
+}
+
+// replayFixture serves the request from the fixture archive. Requests that weren't recorded
+// fail the whole request so missing fixtures are caught rather than silently fetched.
+func (f *Forwarder) replayFixture(ctx context.Context) {
+	responses := make([]json.RawMessage, len(f.rpcRequest))
+
+	for i, request := range f.rpcRequest {
+		response, err := f.r.fixtures.archive.Lookup(f.chain.ID(), request)
+		if err != nil {
+			logger.Errorf("could not replay request: %v", err)
+			f.c.JSON(http.StatusInternalServerError, gin.H{
+				"error": fmt.Sprintf("could not replay request: %v", err),
+			})
+			return
+		}
+		responses[i] = response
+	}
+
+	body := []byte(responses[0])
+	if rpc.IsBatch(f.body) {
+		var err error
+		body, err = json.Marshal(responses)
+		if err != nil {
+			f.c.JSON(http.StatusInternalServerError, gin.H{
+				"error": fmt.Sprintf("could not marshall batch response: %v", err),
+			})
+			return
+		}
+	}
+
+	f.c.Header(fixtureHeader, config.FixtureModeReplay)
+	f.writeData(ctx, body)
+}
@@ -0,0 +1,80 @@
+package proxy_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// forwardFixture forwards a request to chain 1 and returns the response.
+func (p *ProxySuite) forwardFixture(prxy *proxy.RPCProxy, body []byte) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(body))
+
+	prxy.Forward(c, 1, nil)
+	return w
+}
+
+func (p *ProxySuite) TestRecordReplay() {
+	archivePath := filepath.Join(p.T().TempDir(), "fixtures.json.gz")
+
+	recorder := proxy.NewProxy(config.Config{
+		Chains:  map[uint32]config.ChainConfig{1: {RPCs: []string{gofakeit.URL()}}},
+		Fixture: config.FixtureConfig{Mode: config.FixtureModeRecord, Path: archivePath},
+	}, p.metrics)
+
+	upstreamCalls := 0
+	recorder.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		upstreamCalls++
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(`[{"jsonrpc":"2.0","id":2,"result":"0x2"},{"jsonrpc":"2.0","id":1,"result":"0x1"}]`))
+		return bodyRes, nil
+	}))
+
+	batch := p.MustMarshall([]rpc.Request{
+		{ID: 1, JSONRPC: "2.0", Method: string(client.ChainIDMethod)},
+		{ID: 2, JSONRPC: "2.0", Method: string(client.BlockNumberMethod)},
+	})
+
+	w := p.forwardFixture(recorder, batch)
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), 1, upstreamCalls)
+	Nil(p.T(), recorder.SaveFixtures())
+
+	// replayed chains don't need to be configured and upstreams are never called
+	replayer := proxy.NewProxy(config.Config{
+		Fixture: config.FixtureConfig{Mode: config.FixtureModeReplay, Path: archivePath},
+	}, p.metrics)
+	replayer.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		p.T().Error("upstream should not be called when replaying")
+		return nil, nil
+	}))
+
+	w = p.forwardFixture(replayer, p.MustMarshall(rpc.Request{ID: 5, JSONRPC: "2.0", Method: string(client.BlockNumberMethod)}))
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), config.FixtureModeReplay, w.Header().Get("x-omnirpc-fixture"))
+
+	var res proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res))
+	Equal(p.T(), 5, res.ID)
+	Equal(p.T(), `"0x2"`, string(res.Result))
+
+	// misses fail loudly
+	w = p.forwardFixture(replayer, p.MustMarshall(rpc.Request{ID: 1, JSONRPC: "2.0", Method: string(client.GasPriceMethod)}))
+	Equal(p.T(), http.StatusInternalServerError, w.Code)
+	Contains(p.T(), w.Body.String(), "no recorded response")
+}
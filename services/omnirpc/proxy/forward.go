@@ -4,6 +4,7 @@
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"github.com/ImVexed/fasturl"
 	"github.com/goccy/go-json"
@@ -15,6 +16,7 @@
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 	goHTTP "net/http"
+	"regexp"
 	"strings"
 )
 
@@ -54,8 +56,17 @@ func (f *Forwarder) newRawResponse(ctx context.Context, body []byte, url string)
 
 		hasErr = rpcMessage.Error != nil
 
+		// errors caused by the upstream (e.g. rate limits) aren't a response to the request
+		if hasErr && isUpstreamFault(rpcMessage.Error) {
+			return nil, upstreamError(rpcMessage.Error)
+		}
+
 		standardizedResponse, err = standardizeResponse(ctx, &f.rpcRequest[0], rpcMessage)
 		if err != nil {
+			// the result is usually empty if the upstream returned an error, so include the error for debugging
+			if rpcMessage.Error != nil {
+				return nil, upstreamError(rpcMessage.Error)
+			}
 			return nil, fmt.Errorf("could not standardize response: %w", err)
 		}
 	}
@@ -63,11 +74,51 @@ func (f *Forwarder) newRawResponse(ctx context.Context, body []byte, url string)
 	return &rawResponse{
 		body:     body,
 		url:      url,
-		hash:     fmt.Sprintf("%x", sha256.Sum256(standardizedResponse)),
+		hash:     hashBytes(standardizedResponse),
 		hasError: hasErr,
 	}, nil
 }
 
+// errUpstreamError is returned when an upstream returns an error that can't be standardized.
+var errUpstreamError = errors.New("upstream returned error")
+
+// errUpstreamUnavailable is returned when an upstream returns an error caused by the upstream rather than the
+// request, e.g. rate limits or internal errors.
+var errUpstreamUnavailable = errors.New("upstream unavailable")
+
+// upstreamFaultCodes are json-rpc error codes caused by the upstream rather than the request.
+var upstreamFaultCodes = []int{
+	-32603, // internal error
+	-32002, // resource unavailable
+	-32005, // limit exceeded
+}
+
+// upstreamFaultRegex matches json-rpc errors caused by the upstream rather than the request.
+var upstreamFaultRegex = regexp.MustCompile(`(?i)(rate limit|too many requests|limit exceeded|capacity|internal error|service unavailable|temporarily unavailable|overloaded|timed out|timeout)`)
+
+// isUpstreamFault checks if a json-rpc error was caused by the upstream (e.g. rate limits or server errors) rather
+// than the request (e.g. reverts or invalid params). eth_getLogs limits are caused by the request.
+func isUpstreamFault(rpcError *JSONError) bool {
+	if _, ok := parseLogsLimitError(rpcError.Message); ok {
+		return false
+	}
+	return slices.Contains(upstreamFaultCodes, rpcError.Code) || upstreamFaultRegex.MatchString(rpcError.Message)
+}
+
+// upstreamError wraps a json-rpc error returned by an upstream in errUpstreamUnavailable if it was caused by the
+// upstream, errUpstreamError otherwise.
+func upstreamError(rpcError *JSONError) error {
+	if isUpstreamFault(rpcError) {
+		return fmt.Errorf("%w: %s", errUpstreamUnavailable, rpcError.Message)
+	}
+	return fmt.Errorf("%w: %s", errUpstreamError, rpcError.Message)
+}
+
+// hashBytes produces the hex encoded sha256 hash used to compare standardized payloads.
+func hashBytes(body []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(body))
+}
+
 // standardizes a batch request. anyErr indicates *any* response in the batch had an error
 // (not at the decoding step).
 func (f *Forwarder) standardizeBatch(ctx context.Context, body []byte) (res []byte, anyErr bool, err error) {
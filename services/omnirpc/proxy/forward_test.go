@@ -12,6 +12,7 @@
 	"github.com/stretchr/testify/mock"
 	"github.com/synapsecns/sanguine/ethergo/client"
 	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
 	chainManagerMocks "github.com/synapsecns/sanguine/services/omnirpc/chainmanager/mocks"
 	"github.com/synapsecns/sanguine/services/omnirpc/config"
 	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
@@ -192,7 +193,7 @@ func (p *ProxySuite) TestOverrideConfirmability() {
 
 	chainManager := new(chainManagerMocks.Chain)
 	chainManager.On("ConfirmationsThreshold").Return(chainConfirmations)
-	chainManager.On("URLs").Return(urls)
+	chainManager.On("ConfirmationURLs", mock.Anything).Return(urls)
 	forwarder.SetChain(chainManager)
 
 	forwarder.SetBody(p.MustMarshall(rpc.Request{
@@ -209,3 +210,84 @@ func (p *ProxySuite) TestOverrideConfirmability() {
 	True(p.T(), forwarder.CheckAndSetConfirmability())
 	Equal(p.T(), forwarder.RequiredConfirmations(), overridedConfirmations)
 }
+
+// TestRevertsDontEjectUpstream makes sure json-rpc errors caused by the request don't count against upstream health.
+func (p *ProxySuite) TestRevertsDontEjectUpstream() {
+	const chainID = 1
+	upstreams := []string{gofakeit.URL(), gofakeit.URL()}
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: upstreams, Checks: 1},
+		},
+	}, p.metrics)
+
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		bodyRes := new(mocks.Response)
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`))
+		bodyRes.On("StatusCode").Return(200)
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	for i := 0; i < 20; i++ {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+			ID:      1,
+			JSONRPC: "2.0",
+			Method:  string(client.EstimateGasMethod),
+			Params:  []json.RawMessage{[]byte(`{"to":"0x0000000000000000000000000000000000000001","data":"0x01"}`)},
+		})))
+		prxy.Forward(c, chainID, nil)
+	}
+
+	NotEmpty(p.T(), captureClient.Requests())
+	for _, health := range prxy.ChainManager().GetChain(chainID).Health() {
+		Equal(p.T(), chainmanager.Healthy, health.Status)
+		Zero(p.T(), health.ErrorRate)
+	}
+}
+
+// TestUpstreamErrorsCountAgainstUpstream makes sure json-rpc errors caused by the upstream (e.g. rate limits) count against upstream health.
+func (p *ProxySuite) TestUpstreamErrorsCountAgainstUpstream() {
+	const chainID = 1
+
+	for _, body := range []string{
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"daily request count exceeded"}}`,
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"internal server error"}}`,
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"too many requests, please slow down"}}`,
+	} {
+		body := body
+		upstreams := []string{gofakeit.URL(), gofakeit.URL()}
+
+		prxy := proxy.NewProxy(config.Config{
+			Chains: map[uint32]config.ChainConfig{
+				chainID: {RPCs: upstreams, Checks: 1},
+			},
+		}, p.metrics)
+
+		captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+			bodyRes := new(mocks.Response)
+			bodyRes.On("Body").Return([]byte(body))
+			bodyRes.On("StatusCode").Return(200)
+			return bodyRes, nil
+		})
+		prxy.SetClient(captureClient)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+			ID:      1,
+			JSONRPC: "2.0",
+			Method:  string(client.EstimateGasMethod),
+			Params:  []json.RawMessage{[]byte(`{"to":"0x0000000000000000000000000000000000000001","data":"0x01"}`)},
+		})))
+		prxy.Forward(c, chainID, nil)
+
+		NotEmpty(p.T(), captureClient.Requests())
+		for _, health := range prxy.ChainManager().GetChain(chainID).Health() {
+			Positive(p.T(), health.ErrorRate, body)
+		}
+	}
+}
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
 	"go.opentelemetry.io/otel/attribute"
@@ -11,6 +12,8 @@
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/Soft/iter"
 	"github.com/gin-gonic/gin"
@@ -19,6 +22,7 @@
 	"github.com/synapsecns/sanguine/core/threaditer"
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
 	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/modules"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -30,10 +34,20 @@ type Forwarder struct {
 	c *gin.Context
 	// chain is the chain from the chain manager
 	chain chainmanager.Chain
+	// urls is a snapshot of the chain urls ordered by health. This is taken once per request
+	// since the order and ejected urls can change while the request is in flight
+	urls []string
 	// body is the body of the request
 	body []byte
 	// requiredConfirmations is the number of required confirmations for the request to go through
 	requiredConfirmations uint16
+	// confirmationsOverride is the number of confirmations set in the request path, 0 if not set
+	confirmationsOverride uint16
+	// quorum is the quorum policy for the request
+	quorum quorum
+	// diverged is set if the quorum policy couldn't be met and a response was picked by divergence handling.
+	// Sub-forwarders set it on their parent, so the header is only written once the parent is done.
+	diverged atomic.Bool
 	// requestID is the request id
 	requestID []byte
 	// client is the client used for fasthttp
@@ -45,6 +59,10 @@ type Forwarder struct {
 	failedForwards *xsync.MapOf[error]
 	// rpcRequest is the parsed rpc request
 	rpcRequest rpc.Requests
+	// modules are the modules enabled for the chain
+	modules []modules.Module
+	// moduleRequests are the requests passed to each module, indexed by module
+	moduleRequests []rpc.Requests
 	// mux is used to track the release of the forwarder. This should only be used in async methods
 	// as RLock
 	mux sync.RWMutex
@@ -62,12 +80,18 @@ func (f *Forwarder) Reset() {
 	// client and forwarder can stay the same
 	f.c = nil
 	f.chain = nil
+	f.urls = nil
 	f.body = nil
 	f.requiredConfirmations = 0
+	f.confirmationsOverride = 0
+	f.quorum = quorum{}
+	f.diverged.Store(false)
 	f.requestID = nil
 	f.resMap = nil
 	f.failedForwards = nil
 	f.rpcRequest = nil
+	f.modules = nil
+	f.moduleRequests = nil
 	f.span = nil
 }
 
@@ -113,7 +137,31 @@ func (r *RPCProxy) Forward(c *gin.Context, chainID uint32, requiredConfirmations
 		forwarder.requiredConfirmations = *requiredConfirmationsOverride
 	}
 
-	if ok := forwarder.fillAndValidate(chainID); !ok {
+	if ok := forwarder.fillAndValidate(ctx, chainID); !ok {
+		return
+	}
+
+	if r.fixtures.replaying() {
+		forwarder.replayFixture(ctx)
+		return
+	}
+
+	if forwarder.shouldBroadcast() {
+		forwarder.broadcast(ctx)
+		return
+	}
+
+	if ok := forwarder.checkCache(ctx); ok {
+		return
+	}
+
+	if logsRequest, ok := forwarder.parseLogsRequest(); ok {
+		forwarder.forwardLogs(ctx, logsRequest)
+		return
+	}
+
+	if batchSize, ok := forwarder.shouldSplitBatch(); ok {
+		forwarder.forwardBatch(ctx, batchSize)
 		return
 	}
 
@@ -123,10 +171,19 @@ func (r *RPCProxy) Forward(c *gin.Context, chainID uint32, requiredConfirmations
 // attemptForwardAndValidate attempts to forward the request and
 // makes sure it is valid
 // TODO: maybe the context shouldn't be used from a struct here?
+func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
+	responses, errResponse := f.forwardAndConfirm(ctx)
+	f.writeResponse(ctx, responses, errResponse)
+}
+
+// forwardAndConfirm forwards the request until the responses meet the quorum policy (by default, until requiredConfirmations
+// upstreams return the same response). the confirming responses are returned, if no consistent response could be found an
+// error response is returned instead.
+// both are nil if the context is canceled.
 //
 //nolint:gocognit,cyclop
-func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
-	urlIter := threaditer.ThreadSafe(iter.Slice(f.chain.URLs()))
+func (f *Forwarder) forwardAndConfirm(ctx context.Context) (_ []rawResponse, _ *ErrorResponse) {
+	urlIter := threaditer.ThreadSafe(iter.Slice(f.urls))
 
 	// setup the channels we use for confirmation
 	errChan := make(chan FailedForward)
@@ -135,8 +192,8 @@ func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
 	forwardCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// start requiredConfirmations workers
-	for i := uint16(0); i < f.requiredConfirmations; i++ {
+	// start a worker for each upstream that should be forwarded to at once
+	for i := 0; i < f.quorumWorkers(); i++ {
 		go func() {
 			f.mux.RLock()
 			defer f.mux.RUnlock()
@@ -161,18 +218,15 @@ func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
 	for {
 		select {
 		// request timeout
-		case <-f.c.Done():
-			return
+		case <-ctx.Done():
+			return nil, nil
 		case failedForward := <-errChan:
 			totalResponses++
 
 			f.failedForwards.Store(failedForward.URL, failedForward.Err)
 
-			// if we've checked every url
-			if totalResponses == len(f.chain.URLs()) {
-				if done := f.checkResponses(totalResponses); done {
-					return
-				}
+			if responses, errResponse, done := f.checkResponses(totalResponses); done {
+				return responses, errResponse
 			}
 		case res := <-resChan:
 			totalResponses++
@@ -182,20 +236,45 @@ func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
 			responses = append(responses, res)
 			f.resMap.Store(res.hash, responses)
 
-			// if we've checked every url or the number of non-error responses is greater than or equal to the
-			// number of confirmations
-			if totalResponses == len(f.chain.URLs()) || uint16(f.resMap.Size()) >= f.requiredConfirmations {
-				if done := f.checkResponses(totalResponses); done {
-					return
-				}
+			if responses, errResponse, done := f.checkResponses(totalResponses); done {
+				return responses, errResponse
 			}
 		}
 	}
 }
 
+// writeResponse writes the result of forwardAndConfirm to the client.
+func (f *Forwarder) writeResponse(ctx context.Context, responses []rawResponse, errResponse *ErrorResponse) {
+	if errResponse != nil {
+		f.c.JSON(http.StatusBadGateway, errResponse)
+		return
+	}
+
+	// request was canceled
+	if len(responses) == 0 {
+		return
+	}
+
+	responseURLS := make([]string, len(responses))
+	for i, url := range responses {
+		responseURLS[i] = url.url
+	}
+
+	f.c.Header(urlConfirmationsHeader, strings.Join(responseURLS, ","))
+	f.c.Header(jsonHashHeader, responses[0].hash)
+	f.c.Header(forwardedFrom, responses[0].url)
+	f.setDivergenceHeader()
+
+	f.writeData(ctx, responses[0].body)
+	f.storeCache(responses[0])
+}
+
 // urlConfirmationsHeader is a header specifying which urls were checked.
 const urlConfirmationsHeader = "x-checked-urls"
 
+// divergenceHeader is set to the divergence handling used if the quorum policy couldn't be met.
+const divergenceHeader = "x-quorum-divergence"
+
 // jsonHashHeader is the hash of the returned json.
 const jsonHashHeader = "x-json-hash"
 
@@ -227,37 +306,31 @@ type FailedForward struct {
 	URL string
 }
 
-func (f *Forwarder) checkResponses(responseCount int) (done bool) {
-	var valid bool
-
-	f.resMap.Range(func(key string, responses []rawResponse) bool {
-		if uint16(len(responses)) >= f.requiredConfirmations {
-			responseURLS := make([]string, len(responses))
-
-			for i, url := range responses {
-				responseURLS[i] = url.url
-			}
+// setDivergenceHeader sets the divergence header if divergence handling picked any of the responses. This must
+// only be called by the forwarder that writes the response, since sub-forwarders run in parallel.
+func (f *Forwarder) setDivergenceHeader() {
+	if f.diverged.Load() {
+		f.c.Header(divergenceHeader, f.quorum.onDivergence)
+	}
+}
 
-			f.c.Header(urlConfirmationsHeader, strings.Join(responseURLS, ","))
-			f.c.Header(jsonHashHeader, responses[0].hash)
-			f.c.Header(forwardedFrom, responses[0].url)
+// checkResponses checks if any response meets the quorum policy. If every url has been checked and no response
+// does, divergence handling is applied and if that doesn't pick a response, an error response is returned.
+func (f *Forwarder) checkResponses(responseCount int) (_ []rawResponse, _ *ErrorResponse, done bool) {
+	allChecked := responseCount == len(f.urls)
 
-			f.c.Data(http.StatusOK, gin.MIMEJSON, responses[0].body)
-			valid = true
+	if validResponses, ok := f.confirmedResponses(allChecked); ok {
+		return validResponses, nil, true
+	}
 
-			return false
+	// every urls been checked, we need to resolve the divergence or error
+	if allChecked {
+		if resolvedResponses, ok := f.resolveDivergence(); ok {
+			f.diverged.Store(true)
+			return resolvedResponses, nil, true
 		}
 
-		return true
-	})
-
-	if valid {
-		return true
-	}
-
-	// every urls been checked, we need to error
-	if responseCount == len(f.chain.URLs()) {
-		erroredUrls := sets.NewString(f.chain.URLs()...)
+		erroredUrls := sets.NewString(f.urls...)
 
 		errResponse := ErrorResponse{
 			Error:  "could not get consistent response",
@@ -285,11 +358,9 @@ func (f *Forwarder) checkResponses(responseCount int) (done bool) {
 
 		errResponse.ErroredURLS = erroredUrls.List()
 
-		f.c.JSON(http.StatusBadGateway, errResponse)
-
-		return true
+		return nil, &errResponse, true
 	}
-	return false
+	return nil, nil, false
 }
 
 // attemptForward attempts to forward a request. If it runs out of urls to process
@@ -304,7 +375,19 @@ func (f *Forwarder) attemptForward(ctx context.Context, errChan chan FailedForwa
 
 	url := nextURL.Unwrap()
 
+	startTime := time.Now()
 	res, err := f.forwardRequest(ctx, url)
+	// cancellations are caused by other upstreams responding first, not by this upstream
+	if ctx.Err() == nil {
+		// json-rpc errors are usually the requests fault (e.g. reverts), not the upstreams. errors caused by the
+		// upstream (e.g. rate limits) are wrapped in errUpstreamUnavailable instead.
+		if errors.Is(err, errUpstreamError) {
+			f.chain.RecordResult(url, time.Since(startTime), nil)
+		} else {
+			f.chain.RecordResult(url, time.Since(startTime), err)
+		}
+	}
+
 	if err != nil {
 		// check if we're done, otherwise add to errchan
 		select {
@@ -325,7 +408,7 @@ func (f *Forwarder) attemptForward(ctx context.Context, errChan chan FailedForwa
 }
 
 // fillAndValidate fills request fields and validates fields.
-func (f *Forwarder) fillAndValidate(chainID uint32) (ok bool) {
+func (f *Forwarder) fillAndValidate(ctx context.Context, chainID uint32) (ok bool) {
 	var err error
 
 	f.chain = f.r.chainManager.GetChain(chainID)
@@ -347,6 +430,16 @@ func (f *Forwarder) fillAndValidate(chainID uint32) (ok bool) {
 	f.requestID = []byte(f.c.GetHeader(omniHTTP.XRequestIDString))
 	f.span.SetAttributes(attribute.String("request_id", string(f.requestID)))
 
+	// clients are authorized against the request they sent, before modules rewrite it
+	if ok := f.authorizeClient(ctx); !ok {
+		return false
+	}
+
+	// modules run before confirmability is checked since rewriting can change it
+	if ok := f.rewriteRequests(ctx); !ok {
+		return false
+	}
+
 	if ok := f.checkAndSetConfirmability(); !ok {
 		return false
 	}
@@ -354,13 +447,51 @@ func (f *Forwarder) fillAndValidate(chainID uint32) (ok bool) {
 	return true
 }
 
+// requestPolicy gets the quorum and required confirmations of requests. Non-confirmable requests use 1 confirmation.
+// Method policies apply regardless of confirmability, but confirmations in the request path take precedence.
+func (f *Forwarder) requestPolicy(requests rpc.Requests, confirmable bool) (q quorum, requiredConfirmations uint16) {
+	requiredConfirmations = f.confirmationsOverride
+	if requiredConfirmations == 0 {
+		requiredConfirmations = f.chain.ConfirmationsThreshold()
+	}
+
+	if !confirmable {
+		requiredConfirmations = 1
+	}
+
+	if f.confirmationsOverride == 0 {
+		if methodQuorum, methodConfirmations, ok := f.methodQuorum(requests); ok {
+			return methodQuorum, methodConfirmations
+		}
+	}
+
+	return q, requiredConfirmations
+}
+
+// upstreamURLs gets the urls to forward requests to. Requests for old state are only sent to upstreams that still
+// have it, and quorum policies can limit the number of upstreams.
+func (f *Forwarder) upstreamURLs(requests rpc.Requests, q quorum, requiredConfirmations uint16) []string {
+	urls := f.chain.ConfirmationURLs(requiredConfirmations)
+	if blockNumber, ok := historicalBlock(requests); ok {
+		// fall back to all upstreams rather than failing if too few are known to have the state
+		if historicalURLs := f.chain.HistoricalURLs(blockNumber); len(historicalURLs) >= int(requiredConfirmations) {
+			urls = historicalURLs
+		}
+	}
+
+	if q.upstreams > 0 && len(urls) > int(q.upstreams) {
+		urls = urls[:q.upstreams]
+	}
+
+	return urls
+}
+
 // checkAndSetConfirmability checks the confirmability of the request body and makes sure
 // we have enough urls to validate the request.
 func (f *Forwarder) checkAndSetConfirmability() (ok bool) {
-	// if we overrided required confirmations above, use that
-	if f.requiredConfirmations == 0 {
-		f.requiredConfirmations = f.chain.ConfirmationsThreshold()
-	}
+	// confirmations set before checking are from the request path
+	f.confirmationsOverride = f.requiredConfirmations
+
 	var err error
 	f.rpcRequest, err = rpc.ParseRPCPayload(f.body)
 	if err != nil {
@@ -370,7 +501,8 @@ func (f *Forwarder) checkAndSetConfirmability() (ok bool) {
 		return false
 	}
 
-	// If any request ina  batch is not confirmable, the entire batch is marks as non-confirmable
+	// If any request ina  batch is not confirmable, the entire batch is marks as non-confirmable.
+	// Split batches are confirmed per element, see batchGroups.
 	confirmable, err := areConfirmable(f.rpcRequest)
 	if err != nil {
 		f.c.JSON(http.StatusBadRequest, gin.H{
@@ -378,11 +510,10 @@ func (f *Forwarder) checkAndSetConfirmability() (ok bool) {
 		})
 		return false
 	}
+	f.quorum, f.requiredConfirmations = f.requestPolicy(f.rpcRequest, confirmable)
 
-	// non-confirmable requests must use 1
-	if !confirmable {
-		f.requiredConfirmations = 1
-	}
+	f.c.Header(quorumPolicyHeader, f.quorum.name())
+	f.span.SetAttributes(attribute.String("quorum_policy", f.quorum.name()))
 
 	// set the headers
 	f.c.Header("x-confirmable", strconv.FormatBool(confirmable))
@@ -393,10 +524,16 @@ func (f *Forwarder) checkAndSetConfirmability() (ok bool) {
 	f.span.SetAttributes(attribute.Bool("confirmable", confirmable))
 	f.span.SetAttributes(attribute.String("method", f.rpcRequest.Method()))
 
+	if blockNumber, ok := historicalBlock(f.rpcRequest); ok {
+		f.span.SetAttributes(attribute.Int64("historical_block", int64(blockNumber)))
+	}
+	f.urls = f.upstreamURLs(f.rpcRequest, f.quorum, f.requiredConfirmations)
+
 	// make sure we have enough urls to hit the required confirmation threshold
-	if len(f.chain.URLs()) < int(f.requiredConfirmations) {
+	// upstreams aren't used when replaying
+	if len(f.urls) < int(f.requiredConfirmations) && !f.r.fixtures.replaying() {
 		f.c.JSON(http.StatusBadRequest, gin.H{
-			"error": fmt.Sprintf("not enough endpoints for chain %d: found %d needed %d", f.chain.ID(), len(f.chain.URLs()), f.requiredConfirmations),
+			"error": fmt.Sprintf("not enough endpoints for chain %d: found %d needed %d", f.chain.ID(), len(f.urls), f.requiredConfirmations),
 		})
 		return false
 	}
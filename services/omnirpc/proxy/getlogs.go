@@ -0,0 +1,529 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/puzpuzpuz/xsync"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultMaxGetLogsRange is the default number of blocks requested per eth_getLogs chunk
+	// until an upstream reports a lower limit.
+	defaultMaxGetLogsRange = 2000
+	// maxParallelLogChunks is the maximum number of chunks fetched at once for a single request.
+	maxParallelLogChunks = 8
+	// maxLogsSplitDepth is the maximum number of times a chunk is split after a limit error.
+	maxLogsSplitDepth = 10
+	// maxLogsChunks is the maximum number of chunks (including splits) fetched for a single request.
+	maxLogsChunks = 500
+	// logChunksHeader is the number of chunks a request was split into.
+	logChunksHeader = "x-get-logs-chunks"
+)
+
+var (
+	// resultLimitRegex matches errors caused by too many logs in the response.
+	resultLimitRegex = regexp.MustCompile(`(?i)(more than \d+ results|too many results|response size|results limit|exceeds max results|query timeout)`)
+	// rangeLimitRegex matches errors caused by too many blocks in the request.
+	rangeLimitRegex = regexp.MustCompile(`(?i)(block range|max range|maximum range|range is too large|range too large|range exceeds|ranges over|limited to)`)
+	// numberRegex is used for extracting limits from errors.
+	numberRegex = regexp.MustCompile(`\d+`)
+)
+
+// logsLimitError is a parsed eth_getLogs limit error.
+type logsLimitError struct {
+	// maxRange is the maximum block range reported by the upstream, 0 if unknown
+	maxRange uint64
+	// maxResults is the maximum number of results reported by the upstream, 0 if unknown
+	maxResults uint64
+	// tooManyResults is set if the error was caused by the number of results, even if the limit is unknown
+	tooManyResults bool
+}
+
+// parseLogsLimitError determines whether an error was caused by a provider limit and extracts the limit if possible.
+func parseLogsLimitError(message string) (_ logsLimitError, ok bool) {
+	// remove thousands separators (e.g. 10,000)
+	message = strings.ReplaceAll(message, ",", "")
+
+	var limit uint64
+	if rawNumber := numberRegex.FindString(message); rawNumber != "" {
+		limit, _ = strconv.ParseUint(rawNumber, 10, 64)
+	}
+
+	// result limits are checked first since the message can mention ranges as well
+	if resultLimitRegex.MatchString(message) {
+		return logsLimitError{maxResults: limit, tooManyResults: true}, true
+	}
+
+	if rangeLimitRegex.MatchString(message) {
+		return logsLimitError{maxRange: limit}, true
+	}
+
+	return logsLimitError{}, false
+}
+
+// logsLimits stores limits learned from each upstream.
+type logsLimits struct {
+	// maxRanges is the max block range by url
+	maxRanges *xsync.MapOf[uint64]
+	// maxResults is the max number of results by url
+	maxResults *xsync.MapOf[uint64]
+	// resultRanges is the largest range by url that isn't known to exceed the result limit
+	resultRanges *xsync.MapOf[uint64]
+}
+
+func newLogsLimits() *logsLimits {
+	return &logsLimits{
+		maxRanges:    xsync.NewMapOf[uint64](),
+		maxResults:   xsync.NewMapOf[uint64](),
+		resultRanges: xsync.NewMapOf[uint64](),
+	}
+}
+
+// learn records a limit a url returned for a chunk.
+func (l *logsLimits) learn(url string, limit logsLimitError, chunk blockRange) {
+	if limit.maxRange > 0 {
+		l.maxRanges.Store(url, limit.maxRange)
+	}
+
+	if limit.maxResults > 0 {
+		l.maxResults.Store(url, limit.maxResults)
+	}
+
+	// the number of results depends on the filter, so half the range that exceeded the limit is used as an estimate
+	if limit.tooManyResults {
+		resultRange := (chunk.to - chunk.from + 1) / 2
+		if resultRange == 0 {
+			resultRange = 1
+		}
+		if oldRange, ok := l.resultRanges.Load(url); !ok || resultRange < oldRange {
+			l.resultRanges.Store(url, resultRange)
+		}
+	}
+}
+
+// chunkSize gets the largest range every url can serve, including ranges that exceeded result limits.
+func (l *logsLimits) chunkSize(urls []string, defaultSize uint64) uint64 {
+	size := defaultSize
+	for _, url := range urls {
+		if maxRange, ok := l.maxRanges.Load(url); ok && maxRange < size {
+			size = maxRange
+		}
+		if resultRange, ok := l.resultRanges.Load(url); ok && resultRange < size {
+			size = resultRange
+		}
+	}
+	return size
+}
+
+// GetLogsLimits are the eth_getLogs limits learned for an upstream.
+type GetLogsLimits struct {
+	// URL is the upstream url
+	URL string `json:"url"`
+	// MaxRange is the max block range, 0 if unknown
+	MaxRange uint64 `json:"max_range"`
+	// MaxResults is the max number of results, 0 if unknown
+	MaxResults uint64 `json:"max_results"`
+}
+
+// limits gets the learned limits for each url.
+func (l *logsLimits) limits(urls []string) []GetLogsLimits {
+	res := make([]GetLogsLimits, len(urls))
+	for i, url := range urls {
+		res[i].URL = url
+		res[i].MaxRange, _ = l.maxRanges.Load(url)
+		res[i].MaxResults, _ = l.maxResults.Load(url)
+	}
+	return res
+}
+
+// blockRange is an inclusive range of blocks.
+type blockRange struct {
+	from, to uint64
+}
+
+// splitRange splits a range into chunks of at most size blocks.
+func splitRange(from, to, size uint64) (chunks []blockRange) {
+	if size == 0 {
+		size = 1
+	}
+
+	for start := from; start <= to; start += size {
+		end := start + size - 1
+		if end > to || end < start {
+			end = to
+		}
+		chunks = append(chunks, blockRange{from: start, to: end})
+
+		// prevent overflow
+		if end == to {
+			break
+		}
+	}
+	return chunks
+}
+
+// rotate rotates the urls so chunks are spread across upstreams.
+func rotate(urls []string, offset int) []string {
+	if len(urls) == 0 {
+		return urls
+	}
+
+	offset %= len(urls)
+	res := make([]string, 0, len(urls))
+	res = append(res, urls[offset:]...)
+	return append(res, urls[:offset]...)
+}
+
+// logsChunkError is returned when a chunk can't be fetched. Exactly one of the fields is set.
+type logsChunkError struct {
+	// errResponse is set if upstreams returned inconsistent responses
+	errResponse *ErrorResponse
+	// body is set if upstreams returned a json-rpc error that's not caused by a limit
+	body []byte
+}
+
+func (l *logsChunkError) Error() string {
+	if l.errResponse != nil {
+		return l.errResponse.Error
+	}
+	return string(l.body)
+}
+
+// logsRequest is an eth_getLogs request with a numeric block range.
+type logsRequest struct {
+	// filter is the raw filter, used to preserve fields other than the range
+	filter map[string]json.RawMessage
+	// blocks is the requested range
+	blocks blockRange
+	// chunks is the number of chunks fetched so far, including splits
+	chunks atomic.Int64
+}
+
+// errTooManyLogsChunks is returned when a request needs more than maxLogsChunks chunks.
+var errTooManyLogsChunks = fmt.Errorf("eth_getLogs request needs more than %d requests, use a smaller range", maxLogsChunks)
+
+// parseLogsRequest checks whether the request is an eth_getLogs request that can be split.
+// Requests using a block hash or block tags (e.g. latest) can't be split.
+func (f *Forwarder) parseLogsRequest() (_ *logsRequest, ok bool) {
+	if len(f.rpcRequest) != 1 || client.RPCMethod(f.rpcRequest[0].Method) != client.GetLogsMethod || len(f.rpcRequest[0].Params) != 1 {
+		return nil, false
+	}
+
+	filterArg := f.rpcRequest[0].Params[0]
+
+	filterCriteria := filters.FilterCriteria{}
+	if err := filterCriteria.UnmarshalJSON(filterArg); err != nil {
+		return nil, false
+	}
+
+	if filterCriteria.BlockHash != nil || filterCriteria.FromBlock == nil || filterCriteria.ToBlock == nil {
+		return nil, false
+	}
+
+	if filterCriteria.FromBlock.Sign() < 0 || filterCriteria.ToBlock.Sign() < 0 || filterCriteria.FromBlock.Cmp(filterCriteria.ToBlock) > 0 {
+		return nil, false
+	}
+
+	var filter map[string]json.RawMessage
+	if err := json.Unmarshal(filterArg, &filter); err != nil {
+		return nil, false
+	}
+
+	return &logsRequest{
+		filter: filter,
+		blocks: blockRange{from: filterCriteria.FromBlock.Uint64(), to: filterCriteria.ToBlock.Uint64()},
+	}, true
+}
+
+// forwardLogs splits an eth_getLogs request into chunks that fit within the limits of each upstream,
+// fetches them in parallel (with confirmations) and merges the results into a single ordered response.
+func (f *Forwarder) forwardLogs(ctx context.Context, req *logsRequest) {
+	chunkSize := f.r.logsLimits.chunkSize(f.urls, f.maxGetLogsRange())
+	if (req.blocks.to-req.blocks.from)/chunkSize >= maxLogsChunks {
+		f.c.JSON(http.StatusBadRequest, gin.H{
+			"error": errTooManyLogsChunks.Error(),
+		})
+		return
+	}
+	chunks := splitRange(req.blocks.from, req.blocks.to, chunkSize)
+	req.chunks.Store(int64(len(chunks)))
+
+	f.c.Header(logChunksHeader, strconv.Itoa(len(chunks)))
+
+	results := make([][]json.RawMessage, len(chunks))
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallelLogChunks)
+
+	for i, chunk := range chunks {
+		i := i
+		chunk := chunk
+		g.Go(func() (err error) {
+			results[i], err = f.getLogsChunk(groupCtx, req, chunk, i, 0)
+			return err
+		})
+	}
+
+	err := g.Wait()
+	f.setDivergenceHeader()
+	if err != nil {
+		var chunkErr *logsChunkError
+		switch {
+		case errors.Is(err, errTooManyLogsChunks):
+			f.c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		case errors.As(err, &chunkErr) && chunkErr.errResponse != nil:
+			f.c.JSON(http.StatusBadGateway, chunkErr.errResponse)
+		case errors.As(err, &chunkErr):
+			f.writeData(ctx, chunkErr.body)
+		default:
+			// request was canceled
+			return
+		}
+		return
+	}
+
+	var merged []json.RawMessage
+	for _, logs := range results {
+		merged = append(merged, logs...)
+	}
+
+	// an empty result should be [] rather than null
+	if merged == nil {
+		merged = []json.RawMessage{}
+	}
+
+	result, err := json.Marshal(merged)
+	if err != nil {
+		f.c.JSON(http.StatusInternalServerError, gin.H{
+			"error": fmt.Sprintf("could not marshall logs: %v", err),
+		})
+		return
+	}
+
+	f.writeMessage(ctx, result)
+	f.storeResult(result)
+}
+
+// proxyResponse is a successful response created by the proxy rather than returned by an upstream.
+// Unlike JSONRPCMessage, the id is always set.
+type proxyResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// writeMessage writes a successful json-rpc response with the id of the original request.
+func (f *Forwarder) writeMessage(ctx context.Context, result json.RawMessage) {
+	version := f.rpcRequest[0].JSONRPC
+	if version == "" {
+		version = "2.0"
+	}
+
+	body, err := json.Marshal(proxyResponse{
+		Version: version,
+		ID:      f.rpcRequest[0].ID,
+		Result:  result,
+	})
+	if err != nil {
+		f.c.JSON(http.StatusInternalServerError, gin.H{
+			"error": fmt.Sprintf("could not marshall response: %v", err),
+		})
+		return
+	}
+
+	f.writeData(ctx, body)
+}
+
+// maxGetLogsRange gets the configured range for the chain.
+func (f *Forwarder) maxGetLogsRange() uint64 {
+	if maxRange := f.r.chainConfig(f.chain.ID()).MaxGetLogsRange; maxRange > 0 {
+		return maxRange
+	}
+	return defaultMaxGetLogsRange
+}
+
+// getLogsChunk fetches a single chunk. If upstreams reject the chunk because of a limit, the limit is recorded
+// and the chunk is split further.
+func (f *Forwarder) getLogsChunk(ctx context.Context, req *logsRequest, chunk blockRange, offset, depth int) ([]json.RawMessage, error) {
+	body, rpcRequest, err := req.chunkRequest(f.rpcRequest[0], chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := f.subForwarder(body, rpcRequest, rotate(f.urls, offset))
+	responses, errResponse := sub.forwardAndConfirm(ctx)
+	f.releaseSubForwarder(sub)
+
+	var limit logsLimitError
+	var chunkErr *logsChunkError
+
+	switch {
+	case errResponse != nil:
+		// upstream errors for eth_getLogs can't be standardized, so limit errors end up here
+		chunkErr = &logsChunkError{errResponse: errResponse}
+		limit, err = f.learnLogsLimits(errResponse.FailedForwards, chunk)
+		if err != nil {
+			return nil, chunkErr
+		}
+	case len(responses) == 0:
+		return nil, fmt.Errorf("could not get chunk: %w", ctx.Err())
+	default:
+		var rpcMessage JSONRPCMessage
+		if err := json.Unmarshal(responses[0].body, &rpcMessage); err != nil {
+			return nil, fmt.Errorf("could not parse response: %w", err)
+		}
+
+		if rpcMessage.Error == nil {
+			var logs []json.RawMessage
+			if err := json.Unmarshal(rpcMessage.Result, &logs); err != nil {
+				return nil, fmt.Errorf("could not parse logs: %w", err)
+			}
+			return logs, nil
+		}
+
+		chunkErr = &logsChunkError{body: responses[0].body}
+		failedForwards := make(map[string]string)
+		for _, response := range responses {
+			failedForwards[response.url] = rpcMessage.Error.Message
+		}
+
+		limit, err = f.learnLogsLimits(failedForwards, chunk)
+		if err != nil {
+			return nil, chunkErr
+		}
+	}
+
+	if chunk.from == chunk.to || depth >= maxLogsSplitDepth {
+		return nil, chunkErr
+	}
+
+	// split in half, or into chunks of the reported range if that's smaller
+	size := (chunk.to - chunk.from + 2) / 2
+	if limit.maxRange > 0 && limit.maxRange < size {
+		size = limit.maxRange
+	}
+
+	subChunks := splitRange(chunk.from, chunk.to, size)
+	if req.chunks.Add(int64(len(subChunks))) > maxLogsChunks {
+		return nil, errTooManyLogsChunks
+	}
+
+	var logs []json.RawMessage
+	for i, subChunk := range subChunks {
+		chunkLogs, err := f.getLogsChunk(ctx, req, subChunk, offset+i, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, chunkLogs...)
+	}
+
+	return logs, nil
+}
+
+// errNoLimit is returned when none of the errors were caused by limits.
+var errNoLimit = errors.New("no limit errors")
+
+// learnLogsLimits records limits from upstream errors for a chunk by url. The lowest limit is returned.
+func (f *Forwarder) learnLogsLimits(upstreamErrors map[string]string, chunk blockRange) (limit logsLimitError, err error) {
+	found := false
+	for url, message := range upstreamErrors {
+		urlLimit, ok := parseLogsLimitError(message)
+		if !ok {
+			continue
+		}
+
+		found = true
+		f.r.logsLimits.learn(url, urlLimit, chunk)
+
+		if urlLimit.maxRange > 0 && (limit.maxRange == 0 || urlLimit.maxRange < limit.maxRange) {
+			limit.maxRange = urlLimit.maxRange
+		}
+	}
+
+	if !found {
+		return limit, errNoLimit
+	}
+	return limit, nil
+}
+
+// chunkRequest creates a request for a chunk of the original request.
+func (l *logsRequest) chunkRequest(original rpc.Request, chunk blockRange) ([]byte, rpc.Requests, error) {
+	// copy the filter so chunks can be created concurrently
+	filter := make(map[string]json.RawMessage, len(l.filter))
+	for key, value := range l.filter {
+		filter[key] = value
+	}
+
+	var err error
+	filter["fromBlock"], err = json.Marshal(hexutil.Uint64(chunk.from))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not marshall from block: %w", err)
+	}
+
+	filter["toBlock"], err = json.Marshal(hexutil.Uint64(chunk.to))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not marshall to block: %w", err)
+	}
+
+	rawFilter, err := json.Marshal(filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not marshall filter: %w", err)
+	}
+
+	chunkRequest := rpc.Request{
+		ID:      original.ID,
+		Method:  original.Method,
+		JSONRPC: original.JSONRPC,
+		Params:  []json.RawMessage{rawFilter},
+	}
+
+	body, err := json.Marshal(chunkRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not marshall request: %w", err)
+	}
+
+	return body, rpc.Requests{chunkRequest}, nil
+}
+
+// subForwarder creates a forwarder for part of the parent request (e.g. a chunk of a larger request).
+// it shares the chain, confirmations and request id of the parent. The caller must release it.
+func (f *Forwarder) subForwarder(body []byte, rpcRequest rpc.Requests, urls []string) *Forwarder {
+	sub := f.r.AcquireForwarder()
+	sub.c = f.c
+	sub.span = f.span
+	sub.chain = f.chain
+	sub.urls = urls
+	sub.body = body
+	sub.rpcRequest = rpcRequest
+	sub.requestID = f.requestID
+	sub.requiredConfirmations = f.requiredConfirmations
+	sub.confirmationsOverride = f.confirmationsOverride
+	sub.quorum = f.quorum
+	sub.resMap = xsync.NewMapOf[[]rawResponse]()
+	sub.failedForwards = xsync.NewMapOf[error]()
+	return sub
+}
+
+// releaseSubForwarder releases a sub-forwarder, marking the parent as diverged if the sub-forwarder was.
+func (f *Forwarder) releaseSubForwarder(sub *Forwarder) {
+	if sub.diverged.Load() {
+		f.diverged.Store(true)
+	}
+	f.r.ReleaseForwarder(sub)
+}
@@ -0,0 +1,341 @@
+package proxy_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func (p *ProxySuite) TestParseLogsLimitError() {
+	maxRange, _, ok := proxy.ParseLogsLimitError("exceed maximum block range: 2000")
+	True(p.T(), ok)
+	Equal(p.T(), uint64(2000), maxRange)
+
+	maxRange, _, ok = proxy.ParseLogsLimitError("eth_getLogs is limited to a 10,000 range")
+	True(p.T(), ok)
+	Equal(p.T(), uint64(10000), maxRange)
+
+	maxRange, maxResults, ok := proxy.ParseLogsLimitError("query returned more than 10000 results")
+	True(p.T(), ok)
+	Zero(p.T(), maxRange)
+	Equal(p.T(), uint64(10000), maxResults)
+
+	_, _, ok = proxy.ParseLogsLimitError("execution reverted")
+	False(p.T(), ok)
+}
+
+func (p *ProxySuite) TestSplitRange() {
+	Equal(p.T(), [][2]uint64{{0, 9}, {10, 19}, {20, 25}}, proxy.SplitRange(0, 25, 10))
+	Equal(p.T(), [][2]uint64{{5, 5}}, proxy.SplitRange(5, 5, 10))
+	Equal(p.T(), [][2]uint64{{math.MaxUint64 - 1, math.MaxUint64}}, proxy.SplitRange(math.MaxUint64-1, math.MaxUint64, 10))
+}
+
+// TestForwardLogsSplit makes sure oversized requests are split, limits are learned and logs are merged in order.
+func (p *ProxySuite) TestForwardLogsSplit() {
+	const chainID = 1
+	const providerMaxRange = 100
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{gofakeit.URL(), gofakeit.URL()}, Checks: 1, MaxGetLogsRange: 1000},
+		},
+	}, p.metrics)
+
+	var mux sync.Mutex
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		mux.Lock()
+		defer mux.Unlock()
+
+		req, err := rpc.ParseRPCPayload(c.Body)
+		Nil(p.T(), err)
+
+		filterCriteria := filters.FilterCriteria{}
+		Nil(p.T(), filterCriteria.UnmarshalJSON(req[0].Params[0]))
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+
+		from, to := filterCriteria.FromBlock.Uint64(), filterCriteria.ToBlock.Uint64()
+		if to-from+1 > providerMaxRange {
+			bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"exceed maximum block range: 100"}}`))
+			return bodyRes, nil
+		}
+
+		// one log per block
+		var logs []types.Log
+		for block := from; block <= to; block++ {
+			logs = append(logs, types.Log{
+				Address:     common.BigToAddress(new(big.Int).SetUint64(block)),
+				Topics:      []common.Hash{},
+				Data:        []byte{},
+				BlockNumber: block,
+			})
+		}
+
+		bodyRes.On("Body").Return(p.MustMarshall(proxy.JSONRPCMessage{
+			Version: "2.0",
+			ID:      1,
+			Result:  p.MustMarshall(logs),
+		}))
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  string(client.GetLogsMethod),
+		Params:  []json.RawMessage{p.MustMarshall(map[string]interface{}{"fromBlock": hexutil.Uint64(1), "toBlock": hexutil.Uint64(2500)})},
+	})))
+
+	prxy.Forward(c, chainID, nil)
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), "3", w.Header().Get("x-get-logs-chunks"))
+
+	var res struct {
+		Result []types.Log `json:"result"`
+	}
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res))
+	Len(p.T(), res.Result, 2500)
+	for i, log := range res.Result {
+		Equal(p.T(), uint64(i+1), log.BlockNumber)
+	}
+}
+
+// TestForwardLogsCached makes sure merged eth_getLogs results are cached once they are behind the head.
+func (p *ProxySuite) TestForwardLogsCached() {
+	const chainID = 1
+	const head = 5000
+
+	var logRequests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		Nil(p.T(), err)
+
+		requests, err := rpc.ParseRPCPayload(body)
+		Nil(p.T(), err)
+
+		responses := make([]proxy.JSONRPCMessage, len(requests))
+		for i, req := range requests {
+			responses[i] = proxy.JSONRPCMessage{Version: "2.0", ID: req.ID}
+
+			switch client.RPCMethod(req.Method) {
+			case client.ChainIDMethod:
+				responses[i].Result = p.MustMarshall(hexutil.Uint64(chainID))
+			case client.BlockByNumberMethod:
+				responses[i].Result = p.MustMarshall(&types.Header{Number: big.NewInt(head), Difficulty: big.NewInt(0)})
+			case client.GetLogsMethod:
+				logRequests.Add(1)
+
+				filterCriteria := filters.FilterCriteria{}
+				Nil(p.T(), filterCriteria.UnmarshalJSON(req.Params[0]))
+
+				logs := []types.Log{}
+				for block := filterCriteria.FromBlock.Uint64(); block <= filterCriteria.ToBlock.Uint64(); block++ {
+					logs = append(logs, types.Log{Topics: []common.Hash{}, Data: []byte{}, BlockNumber: block})
+				}
+				responses[i].Result = p.MustMarshall(logs)
+			default:
+				responses[i].Error = &proxy.JSONError{Code: -32601, Message: "method not found"}
+			}
+		}
+
+		if rpc.IsBatch(body) {
+			_, _ = w.Write(p.MustMarshall(responses))
+		} else {
+			_, _ = w.Write(p.MustMarshall(responses[0]))
+		}
+	}))
+	defer server.Close()
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{server.URL}, Checks: 1, MaxGetLogsRange: 1000},
+		},
+		Cache: config.CacheConfig{Size: 10, DiskPath: filet.TmpDir(p.T(), "")},
+	}, p.metrics)
+	prxy.ChainManager().RefreshRPCInfo(p.GetTestContext(), chainID)
+	Equal(p.T(), uint64(head), prxy.ChainManager().GetChain(chainID).LatestBlockNumber())
+
+	forward := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+			ID:      1,
+			JSONRPC: "2.0",
+			Method:  string(client.GetLogsMethod),
+			Params:  []json.RawMessage{p.MustMarshall(map[string]interface{}{"fromBlock": hexutil.Uint64(1), "toBlock": hexutil.Uint64(2500)})},
+		})))
+
+		prxy.Forward(c, chainID, nil)
+		return w
+	}
+
+	first := forward()
+	Equal(p.T(), http.StatusOK, first.Code, first.Body.String())
+	Equal(p.T(), "miss", first.Header().Get("x-omnirpc-cache"))
+	Equal(p.T(), int64(3), logRequests.Load())
+
+	second := forward()
+	Equal(p.T(), http.StatusOK, second.Code, second.Body.String())
+	Equal(p.T(), "hit", second.Header().Get("x-omnirpc-cache"))
+	// the merged result should have been served without hitting the upstream again
+	Equal(p.T(), int64(3), logRequests.Load())
+	JSONEq(p.T(), first.Body.String(), second.Body.String())
+}
+
+// TestForwardLogsResultLimit makes sure ranges that exceed a result limit shrink the chunks of later requests.
+func (p *ProxySuite) TestForwardLogsResultLimit() {
+	const chainID = 1
+	const providerMaxResults = 50
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{gofakeit.URL()}, Checks: 1, MaxGetLogsRange: 1000},
+		},
+	}, p.metrics)
+
+	var limitErrors atomic.Int64
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		req, err := rpc.ParseRPCPayload(c.Body)
+		Nil(p.T(), err)
+
+		filterCriteria := filters.FilterCriteria{}
+		Nil(p.T(), filterCriteria.UnmarshalJSON(req[0].Params[0]))
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+
+		// one log per block
+		from, to := filterCriteria.FromBlock.Uint64(), filterCriteria.ToBlock.Uint64()
+		if to-from+1 > providerMaxResults {
+			limitErrors.Add(1)
+			bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"query returned more than 50 results"}}`))
+			return bodyRes, nil
+		}
+
+		logs := []types.Log{}
+		for block := from; block <= to; block++ {
+			logs = append(logs, types.Log{Topics: []common.Hash{}, Data: []byte{}, BlockNumber: block})
+		}
+
+		bodyRes.On("Body").Return(p.MustMarshall(proxy.JSONRPCMessage{
+			Version: "2.0",
+			ID:      1,
+			Result:  p.MustMarshall(logs),
+		}))
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	forward := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+			ID:      1,
+			JSONRPC: "2.0",
+			Method:  string(client.GetLogsMethod),
+			Params:  []json.RawMessage{p.MustMarshall(map[string]interface{}{"fromBlock": hexutil.Uint64(1), "toBlock": hexutil.Uint64(200)})},
+		})))
+
+		prxy.Forward(c, chainID, nil)
+		return w
+	}
+
+	first := forward()
+	Equal(p.T(), http.StatusOK, first.Code, first.Body.String())
+	Equal(p.T(), "1", first.Header().Get("x-get-logs-chunks"))
+	Positive(p.T(), limitErrors.Load())
+
+	// the range is split up front once the result limit is known
+	limitErrors.Store(0)
+	second := forward()
+	Equal(p.T(), http.StatusOK, second.Code, second.Body.String())
+	Equal(p.T(), "4", second.Header().Get("x-get-logs-chunks"))
+	Zero(p.T(), limitErrors.Load())
+
+	var res struct {
+		Result []types.Log `json:"result"`
+	}
+	Nil(p.T(), json.Unmarshal(second.Body.Bytes(), &res))
+	Len(p.T(), res.Result, 200)
+}
+
+// TestForwardLogsTooManyChunks makes sure requests that need too many chunks are rejected instead of forwarded.
+func (p *ProxySuite) TestForwardLogsTooManyChunks() {
+	const chainID = 1
+
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{gofakeit.URL()}, Checks: 1, MaxGetLogsRange: 1000},
+		},
+	}, p.metrics)
+
+	// every range over a single block is rejected
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		req, err := rpc.ParseRPCPayload(c.Body)
+		Nil(p.T(), err)
+
+		filterCriteria := filters.FilterCriteria{}
+		Nil(p.T(), filterCriteria.UnmarshalJSON(req[0].Params[0]))
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		if filterCriteria.FromBlock.Cmp(filterCriteria.ToBlock) != 0 {
+			bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"exceed maximum block range: 1"}}`))
+			return bodyRes, nil
+		}
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"result":[]}`))
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	forward := func(toBlock uint64) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+			ID:      1,
+			JSONRPC: "2.0",
+			Method:  string(client.GetLogsMethod),
+			Params:  []json.RawMessage{p.MustMarshall(map[string]interface{}{"fromBlock": hexutil.Uint64(1), "toBlock": hexutil.Uint64(toBlock)})},
+		})))
+
+		prxy.Forward(c, chainID, nil)
+		return w
+	}
+
+	// the limit is only found by splitting
+	w := forward(1000)
+	Equal(p.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	Contains(p.T(), w.Body.String(), "use a smaller range")
+	LessOrEqual(p.T(), len(captureClient.Requests()), 501)
+
+	// once the limit is known, the request is rejected up front
+	requests := len(captureClient.Requests())
+	w = forward(1000)
+	Equal(p.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	Equal(p.T(), requests, len(captureClient.Requests()))
+}
@@ -0,0 +1,50 @@
+package proxy
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	ethergoRPC "github.com/synapsecns/sanguine/ethergo/parser/rpc"
+)
+
+// stateBlockParamIndex gets the index of the block param for methods that read state at a block.
+func stateBlockParamIndex(method string) (index int, ok bool) {
+	//nolint: exhaustive
+	switch client.RPCMethod(method) {
+	case client.GetBalanceMethod, client.GetCodeMethod, client.TransactionCountMethod, client.CallMethod:
+		return 1, true
+	case client.StorageAtMethod:
+		return 2, true
+	}
+	return 0, false
+}
+
+// historicalBlock gets the oldest block state is read at in a set of requests. ok is false if no
+// request reads state at a specific block number, e.g. requests using latest or a block hash.
+func historicalBlock(requests ethergoRPC.Requests) (blockNumber uint64, ok bool) {
+	for _, request := range requests {
+		index, isStateMethod := stateBlockParamIndex(request.Method)
+		if !isStateMethod || len(request.Params) <= index {
+			continue
+		}
+
+		var blockNumOrHash rpc.BlockNumberOrHash
+		if err := json.Unmarshal(request.Params[index], &blockNumOrHash); err != nil {
+			continue
+		}
+
+		// tags like latest, pending, safe and finalized are negative
+		number, isNumber := blockNumOrHash.Number()
+		if !isNumber || number < 0 {
+			continue
+		}
+
+		if !ok || uint64(number) < blockNumber {
+			blockNumber = uint64(number)
+			ok = true
+		}
+	}
+
+	return blockNumber, ok
+}
@@ -0,0 +1,96 @@
+package proxy_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	chainManagerMocks "github.com/synapsecns/sanguine/services/omnirpc/chainmanager/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func stateRequest(method client.RPCMethod, params ...string) rpc.Request {
+	req := rpc.Request{ID: 1, JSONRPC: "2.0", Method: string(method)}
+	for _, param := range params {
+		req.Params = append(req.Params, json.RawMessage(param))
+	}
+	return req
+}
+
+func (p *ProxySuite) TestHistoricalBlock() {
+	const account = `"0x0000000000000000000000000000000000000001"`
+
+	tests := []struct {
+		name     string
+		requests rpc.Requests
+		block    uint64
+		ok       bool
+	}{
+		{"latest", rpc.Requests{stateRequest(client.GetBalanceMethod, account, `"latest"`)}, 0, false},
+		{"finalized", rpc.Requests{stateRequest(client.CallMethod, `{}`, `"finalized"`)}, 0, false},
+		{"default block", rpc.Requests{stateRequest(client.CallMethod, `{}`)}, 0, false},
+		{"block hash", rpc.Requests{stateRequest(client.GetCodeMethod, account, `{"blockHash":"0x1111111111111111111111111111111111111111111111111111111111111111"}`)}, 0, false},
+		{"not a state method", rpc.Requests{stateRequest(client.BlockByNumberMethod, `"0x10"`, `false`)}, 0, false},
+		{"block number", rpc.Requests{stateRequest(client.GetBalanceMethod, account, `"0x10"`)}, 16, true},
+		{"earliest", rpc.Requests{stateRequest(client.TransactionCountMethod, account, `"earliest"`)}, 0, true},
+		{"block number object", rpc.Requests{stateRequest(client.CallMethod, `{}`, `{"blockNumber":"0x20"}`)}, 32, true},
+		{"storage", rpc.Requests{stateRequest(client.StorageAtMethod, account, `"0x0"`, `"0x30"`)}, 48, true},
+		{"oldest in batch", rpc.Requests{
+			stateRequest(client.GetBalanceMethod, account, `"0x30"`),
+			stateRequest(client.GetBalanceMethod, account, `"latest"`),
+			stateRequest(client.GetBalanceMethod, account, `"0x20"`),
+		}, 32, true},
+	}
+
+	for _, tt := range tests {
+		block, ok := proxy.HistoricalBlock(tt.requests)
+		Equal(p.T(), tt.ok, ok, tt.name)
+		Equal(p.T(), tt.block, block, tt.name)
+	}
+}
+
+func (p *ProxySuite) TestHistoricalRouting() {
+	allURLs := []string{"full", "archive"}
+
+	newForwarder := func(req rpc.Request, historicalURLs []string) *proxy.Forwarder {
+		prxy := proxy.NewProxy(config.Config{}, p.metrics)
+		forwarder := prxy.AcquireForwarder()
+		_, span := p.metrics.Tracer().Start(p.GetTestContext(), fmt.Sprintf("test-%d", p.GetTestID()))
+		forwarder.SetSpan(span)
+
+		chain := new(chainManagerMocks.Chain)
+		chain.On("ID").Return(uint32(1))
+		chain.On("ConfirmationsThreshold").Return(uint16(1))
+		chain.On("ConfirmationURLs", mock.Anything).Return(allURLs)
+		chain.On("HistoricalURLs", uint64(16)).Return(historicalURLs)
+		forwarder.SetChain(chain)
+
+		forwarder.SetBody(p.MustMarshall(req))
+		testContext, _ := gin.CreateTestContext(httptest.NewRecorder())
+		forwarder.SetC(testContext)
+
+		return forwarder
+	}
+
+	const account = `"0x0000000000000000000000000000000000000001"`
+
+	forwarder := newForwarder(stateRequest(client.GetBalanceMethod, account, `"0x10"`), []string{"archive"})
+	True(p.T(), forwarder.CheckAndSetConfirmability())
+	Equal(p.T(), []string{"archive"}, forwarder.URLs())
+
+	forwarder = newForwarder(stateRequest(client.GetBalanceMethod, account, `"latest"`), []string{"archive"})
+	True(p.T(), forwarder.CheckAndSetConfirmability())
+	Equal(p.T(), allURLs, forwarder.URLs())
+
+	// too few archive nodes for the required confirmations, so every upstream is used
+	forwarder = newForwarder(stateRequest(client.GetBalanceMethod, account, `"0x10"`), []string{"archive"})
+	forwarder.SetRequiredConfirmations(2)
+	True(p.T(), forwarder.CheckAndSetConfirmability())
+	Equal(p.T(), allURLs, forwarder.URLs())
+}
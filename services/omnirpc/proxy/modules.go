@@ -0,0 +1,183 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/modules"
+	"github.com/synapsecns/sanguine/services/omnirpc/modules/confirmedtofinalized"
+)
+
+// builtinModules are modules that can be enabled in a chain config without being registered.
+func builtinModules() []modules.Module {
+	return []modules.Module{
+		confirmedtofinalized.NewModule(),
+	}
+}
+
+// RegisterModule registers a module so it can be enabled by name in a chain config.
+// A module w/ the same name as an existing module replaces it.
+func (r *RPCProxy) RegisterModule(module modules.Module) {
+	r.configMux.Lock()
+	defer r.configMux.Unlock()
+
+	r.modules[module.Name()] = module
+}
+
+// chainModules gets the modules enabled for a chain in order.
+func (r *RPCProxy) chainModules(chainID uint32) (res []modules.Module) {
+	r.configMux.RLock()
+	defer r.configMux.RUnlock()
+
+	for _, name := range r.config.Chains[chainID].Modules {
+		// unknown modules are rejected by validateModules
+		if module, ok := r.modules[name]; ok {
+			res = append(res, module)
+		}
+	}
+	return res
+}
+
+// validateModules makes sure every module in a chain config is registered. Callers must hold the config lock.
+func (r *RPCProxy) validateModules(names []string) error {
+	for _, name := range names {
+		if _, ok := r.modules[name]; !ok {
+			return fmt.Errorf("%w: unknown module %s", errInvalidChainConfig, name)
+		}
+	}
+	return nil
+}
+
+// rewriteRequests passes the request through each module enabled for the chain. The body is replaced
+// with the rewritten request.
+func (f *Forwarder) rewriteRequests(ctx context.Context) (ok bool) {
+	f.modules = f.r.chainModules(f.chain.ID())
+	if len(f.modules) == 0 {
+		return true
+	}
+
+	requests, err := rpc.ParseRPCPayload(f.body)
+	if err != nil {
+		f.c.JSON(http.StatusBadRequest, gin.H{
+			"error": err,
+		})
+		return false
+	}
+
+	f.moduleRequests = make([]rpc.Requests, len(f.modules))
+	for i, module := range f.modules {
+		// keep the input to each module so responses can be passed back through in reverse
+		f.moduleRequests[i] = requests
+
+		rewritten := make(rpc.Requests, len(requests))
+		for j, request := range requests {
+			rewritten[j], err = module.RewriteRequest(ctx, request)
+			if err != nil {
+				f.c.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("module %s could not rewrite request: %v", module.Name(), err),
+				})
+				return false
+			}
+		}
+		requests = rewritten
+	}
+
+	if rpc.IsBatch(f.body) {
+		f.body, err = json.Marshal(requests)
+	} else {
+		f.body, err = json.Marshal(requests[0])
+	}
+	if err != nil {
+		f.c.JSON(http.StatusInternalServerError, gin.H{
+			"error": fmt.Sprintf("could not marshall rewritten request: %v", err),
+		})
+		return false
+	}
+
+	return true
+}
+
+// transformResponse passes the response back through each module enabled for the chain in reverse order.
+func (f *Forwarder) transformResponse(ctx context.Context, body []byte) ([]byte, error) {
+	if len(f.modules) == 0 {
+		return body, nil
+	}
+
+	if !rpc.IsBatch(body) {
+		return f.transformSingleResponse(ctx, 0, body)
+	}
+
+	var responses []json.RawMessage
+	if err := json.Unmarshal(body, &responses); err != nil {
+		return nil, fmt.Errorf("could not parse batch response: %w", err)
+	}
+
+	for i, response := range responses {
+		var message struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(response, &message); err != nil {
+			return nil, fmt.Errorf("could not parse response: %w", err)
+		}
+
+		// batch responses can be returned in any order
+		index := -1
+		for j, request := range f.rpcRequest {
+			if request.ID == message.ID {
+				index = j
+				break
+			}
+		}
+
+		if index == -1 {
+			continue
+		}
+
+		transformed, err := f.transformSingleResponse(ctx, index, response)
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = transformed
+	}
+
+	transformed, err := json.Marshal(responses)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshall batch response: %w", err)
+	}
+	return transformed, nil
+}
+
+// transformSingleResponse transforms the response to the request at index.
+func (f *Forwarder) transformSingleResponse(ctx context.Context, index int, response []byte) (_ []byte, err error) {
+	for i := len(f.modules) - 1; i >= 0; i-- {
+		if index >= len(f.moduleRequests[i]) {
+			return response, nil
+		}
+
+		response, err = f.modules[i].TransformResponse(ctx, f.moduleRequests[i][index], response)
+		if err != nil {
+			return nil, fmt.Errorf("module %s could not transform response: %w", f.modules[i].Name(), err)
+		}
+	}
+	return response, nil
+}
+
+// writeData records a successful response if recording, transforms it and writes it to the client.
+func (f *Forwarder) writeData(ctx context.Context, body []byte) {
+	// responses are recorded before modules run so they're run again on replay
+	f.recordFixture(body)
+
+	body, err := f.transformResponse(ctx, body)
+	if err != nil {
+		f.c.JSON(http.StatusBadGateway, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	f.c.Data(http.StatusOK, gin.MIMEJSON, body)
+}
@@ -0,0 +1,136 @@
+package proxy_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/modules/confirmedtofinalized"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// forwardWithModules forwards a request through a proxy w/ the given modules enabled and returns the
+// request received by the upstream.
+func (p *ProxySuite) forwardWithModules(prxy *proxy.RPCProxy, req rpc.Request, upstreamResult string) (upstreamReq rpc.Request, w *httptest.ResponseRecorder) {
+	captureClient := omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		requests, err := rpc.ParseRPCPayload(c.Body)
+		Nil(p.T(), err)
+		upstreamReq = requests[0]
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"result":` + upstreamResult + `}`))
+		return bodyRes, nil
+	})
+	prxy.SetClient(captureClient)
+
+	w = httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(req)))
+
+	prxy.Forward(c, 1, nil)
+	return upstreamReq, w
+}
+
+// mockBlock creates a block w/ the given number as json.
+func (p *ProxySuite) mockBlock(number int64) string {
+	header := p.MustMarshall(&types.Header{Number: big.NewInt(number), Difficulty: big.NewInt(0)})
+	return strings.TrimSuffix(string(header), "}") + `,"uncles":[]}`
+}
+
+func (p *ProxySuite) TestConfirmedToFinalizedModule() {
+	// forwarders are pooled w/ the client, so each request uses a new proxy
+	newProxy := func() *proxy.RPCProxy {
+		return proxy.NewProxy(config.Config{
+			Chains: map[uint32]config.ChainConfig{
+				1: {RPCs: []string{gofakeit.URL()}, Modules: []string{confirmedtofinalized.ModuleName}},
+			},
+		}, p.metrics)
+	}
+
+	upstreamReq, w := p.forwardWithModules(newProxy(), rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  string(client.BlockNumberMethod),
+	}, p.mockBlock(16))
+
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), string(client.BlockByNumberMethod), upstreamReq.Method)
+	Equal(p.T(), `"finalized"`, string(upstreamReq.Params[0]))
+
+	var res proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res))
+	Equal(p.T(), `"0x10"`, string(res.Result))
+
+	upstreamReq, w = p.forwardWithModules(newProxy(), rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  string(client.BlockByNumberMethod),
+		Params:  []json.RawMessage{json.RawMessage(`"latest"`), json.RawMessage("false")},
+	}, p.mockBlock(16))
+
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), `"finalized"`, string(upstreamReq.Params[0]))
+	// finalized blocks can be confirmed, unlike latest
+	Equal(p.T(), "true", w.Header().Get("x-confirmable"))
+}
+
+// suffixModule appends a suffix to the method of requests and strips it from the result of responses.
+type suffixModule struct {
+	name   string
+	suffix string
+}
+
+func (s suffixModule) Name() string {
+	return s.name
+}
+
+func (s suffixModule) RewriteRequest(_ context.Context, req rpc.Request) (rpc.Request, error) {
+	req.Method += s.suffix
+	return req, nil
+}
+
+func (s suffixModule) TransformResponse(_ context.Context, req rpc.Request, response []byte) ([]byte, error) {
+	// responses are transformed in reverse, so the request passed in shouldn't have our suffix yet
+	if strings.HasSuffix(req.Method, s.suffix) {
+		return nil, errors.New("module received a rewritten request")
+	}
+	return bytes.Replace(response, []byte(s.suffix), nil, 1), nil
+}
+
+func (p *ProxySuite) TestModuleOrder() {
+	prxy := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			1: {RPCs: []string{gofakeit.URL()}, Modules: []string{"a", "b"}},
+		},
+	}, p.metrics)
+	prxy.RegisterModule(suffixModule{name: "a", suffix: "_a"})
+	prxy.RegisterModule(suffixModule{name: "b", suffix: "_b"})
+
+	upstreamReq, w := p.forwardWithModules(prxy, rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  string(client.ChainIDMethod),
+	}, `"0x1_a_b"`)
+
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), string(client.ChainIDMethod)+"_a_b", upstreamReq.Method)
+
+	var res proxy.JSONRPCMessage
+	Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res))
+	Equal(p.T(), `"0x1"`, string(res.Result))
+}
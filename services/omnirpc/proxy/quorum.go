@@ -0,0 +1,289 @@
+package proxy
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+// quorumPolicyHeader is the header specifying which quorum policy was used.
+const quorumPolicyHeader = "x-quorum-policy"
+
+// defaultTrust is the trust weight of upstreams that aren't in the chain's trust config.
+const defaultTrust = 1
+
+// quorum is the quorum policy used for a request. The zero value requires requiredConfirmations
+// upstreams to agree and fails if they don't.
+type quorum struct {
+	// policy is the policy type, agreement is used if this is empty
+	policy string
+	// upstreams is the maximum number of upstreams to ask, 0 if unlimited
+	upstreams uint16
+	// weight is the combined trust needed for weighted policies
+	weight float64
+	// onDivergence is how to respond when the policy can't be met
+	onDivergence string
+	// trust is url -> trust weight
+	trust map[string]float64
+}
+
+// name gets the name of the policy.
+func (q quorum) name() string {
+	if q.policy == "" {
+		return config.QuorumAgreement
+	}
+	return q.policy
+}
+
+// trustOf gets the trust weight of an upstream.
+func (q quorum) trustOf(url string) float64 {
+	if weight, ok := q.trust[url]; ok {
+		return weight
+	}
+	return defaultTrust
+}
+
+// quorumPolicy finds the policy for a method, exact matches are preferred over patterns.
+func quorumPolicy(policies map[string]config.QuorumPolicy, method string) (_ config.QuorumPolicy, ok bool) {
+	if policy, ok := policies[method]; ok {
+		return policy, true
+	}
+
+	patterns := make([]string, 0, len(policies))
+	for pattern := range policies {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, method); matched {
+			return policies[pattern], true
+		}
+	}
+
+	return config.QuorumPolicy{}, false
+}
+
+// methodQuorum gets the quorum for a set of requests. Batches only use a policy if every request in the batch
+// resolves to the same one. requiredConfirmations is updated for agreement policies.
+func (f *Forwarder) methodQuorum(requests rpc.Requests) (q quorum, requiredConfirmations uint16, ok bool) {
+	chainConfig := f.r.chainConfig(f.chain.ID())
+	if len(chainConfig.Quorum) == 0 || len(requests) == 0 {
+		return q, 0, false
+	}
+
+	policy, ok := quorumPolicy(chainConfig.Quorum, requests[0].Method)
+	if !ok {
+		return q, 0, false
+	}
+
+	for _, request := range requests[1:] {
+		if other, ok := quorumPolicy(chainConfig.Quorum, request.Method); !ok || other != policy {
+			return q, 0, false
+		}
+	}
+
+	q = quorum{
+		policy:       policy.Type,
+		upstreams:    policy.Upstreams,
+		weight:       policy.Weight,
+		onDivergence: policy.OnDivergence,
+		trust:        chainConfig.Trust,
+	}
+
+	requiredConfirmations = 1
+	if q.name() == config.QuorumAgreement {
+		requiredConfirmations = policy.Confirmations
+		if requiredConfirmations == 0 {
+			requiredConfirmations = f.chain.ConfirmationsThreshold()
+		}
+	}
+
+	return q, requiredConfirmations, true
+}
+
+// quorumWorkers gets the number of upstreams to forward to at once.
+func (f *Forwarder) quorumWorkers() int {
+	switch f.quorum.policy {
+	case config.QuorumFirstResponse:
+		return 1
+	case config.QuorumWeighted, config.QuorumHighestBlock:
+		// these need responses from as many upstreams as possible
+		return len(f.urls)
+	default:
+		return int(f.requiredConfirmations)
+	}
+}
+
+// responseGroups gets the responses grouped by hash, sorted by hash so results are deterministic.
+func (f *Forwarder) responseGroups() (groups [][]rawResponse) {
+	f.resMap.Range(func(key string, responses []rawResponse) bool {
+		groups = append(groups, responses)
+		return true
+	})
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0].hash < groups[j][0].hash
+	})
+	return groups
+}
+
+// confirmedResponses checks if any response meets the quorum policy. allChecked is true once every url has
+// been checked.
+func (f *Forwarder) confirmedResponses(allChecked bool) (_ []rawResponse, ok bool) {
+	groups := f.responseGroups()
+
+	switch f.quorum.policy {
+	case config.QuorumFirstResponse:
+		if len(groups) > 0 {
+			return groups[0], true
+		}
+	case config.QuorumWeighted:
+		for _, group := range groups {
+			if f.groupTrust(group) >= f.quorum.weight {
+				return group, true
+			}
+		}
+	case config.QuorumHighestBlock:
+		// every upstream has to respond to know which has the highest block
+		if allChecked && len(groups) > 0 {
+			return highestBlockGroup(groups), true
+		}
+	default:
+		for _, group := range groups {
+			if uint16(len(group)) >= f.requiredConfirmations {
+				return group, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// resolveDivergence picks a response when the quorum policy couldn't be met.
+func (f *Forwarder) resolveDivergence() (_ []rawResponse, ok bool) {
+	groups := f.responseGroups()
+	if len(groups) == 0 {
+		return nil, false
+	}
+
+	switch f.quorum.onDivergence {
+	case config.DivergenceMajority:
+		var majority []rawResponse
+		tied := false
+		for _, group := range groups {
+			switch {
+			case len(group) > len(majority):
+				majority = group
+				tied = false
+			case len(group) == len(majority):
+				tied = true
+			}
+		}
+
+		if tied {
+			return nil, false
+		}
+		return majority, true
+	case config.DivergenceTrusted:
+		var trusted []rawResponse
+		var trustedWeight float64
+		for _, group := range groups {
+			for _, response := range group {
+				if weight := f.quorum.trustOf(response.url); trusted == nil || weight > trustedWeight {
+					trusted = group
+					trustedWeight = weight
+				}
+			}
+		}
+		return trusted, true
+	}
+
+	return nil, false
+}
+
+// groupTrust gets the combined trust of the upstreams that returned a response.
+func (f *Forwarder) groupTrust(group []rawResponse) (total float64) {
+	for _, response := range group {
+		total += f.quorum.trustOf(response.url)
+	}
+	return total
+}
+
+// highestBlockGroup gets the responses w/ the highest block. Ties are broken by the number of upstreams.
+func highestBlockGroup(groups [][]rawResponse) (highest []rawResponse) {
+	var highestBlock uint64
+	for _, group := range groups {
+		blockNumber := responseBlockNumber(group[0].body)
+		if highest == nil || blockNumber > highestBlock || (blockNumber == highestBlock && len(group) > len(highest)) {
+			highest = group
+			highestBlock = blockNumber
+		}
+	}
+	return highest
+}
+
+// responseBlockNumber gets the block number from a response that's either a block number (eth_blockNumber) or
+// a block/header (eth_getBlockByNumber). 0 is returned if the response has no block number.
+func responseBlockNumber(body []byte) uint64 {
+	var rpcMessage JSONRPCMessage
+	if err := json.Unmarshal(body, &rpcMessage); err != nil {
+		return 0
+	}
+
+	var blockNumber hexutil.Uint64
+	if err := json.Unmarshal(rpcMessage.Result, &blockNumber); err == nil {
+		return uint64(blockNumber)
+	}
+
+	var block struct {
+		Number hexutil.Uint64 `json:"number"`
+	}
+	if err := json.Unmarshal(rpcMessage.Result, &block); err == nil {
+		return uint64(block.Number)
+	}
+
+	return 0
+}
+
+// validateQuorum makes sure the quorum policies and trust weights in a chain config are valid.
+func validateQuorum(chainConfig config.ChainConfig) error {
+	for method, policy := range chainConfig.Quorum {
+		if _, err := path.Match(method, ""); err != nil {
+			return fmt.Errorf("%w: invalid quorum method pattern %s", errInvalidChainConfig, method)
+		}
+
+		switch policy.Type {
+		case config.QuorumFirstResponse, config.QuorumAgreement, config.QuorumHighestBlock:
+		case config.QuorumWeighted:
+			if policy.Weight <= 0 {
+				return fmt.Errorf("%w: weighted quorum for %s must have a positive weight", errInvalidChainConfig, method)
+			}
+		default:
+			return fmt.Errorf("%w: unknown quorum type %s for %s", errInvalidChainConfig, policy.Type, method)
+		}
+
+		if policy.Upstreams > 0 && policy.Confirmations > policy.Upstreams {
+			return fmt.Errorf("%w: quorum for %s requires %d confirmations from at most %d rpcs", errInvalidChainConfig, method, policy.Confirmations, policy.Upstreams)
+		}
+
+		switch policy.OnDivergence {
+		case "", config.DivergenceFail, config.DivergenceMajority, config.DivergenceTrusted:
+		default:
+			return fmt.Errorf("%w: unknown divergence handling %s for %s", errInvalidChainConfig, policy.OnDivergence, method)
+		}
+	}
+
+	for rpcURL, weight := range chainConfig.Trust {
+		if weight < 0 {
+			return fmt.Errorf("%w: trust for %s can't be negative", errInvalidChainConfig, rpcURL)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package proxy_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/http/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+const (
+	upstreamA = "https://a.omnirpc.test"
+	upstreamB = "https://b.omnirpc.test"
+	upstreamC = "https://c.omnirpc.test"
+)
+
+// forwardWithQuorum forwards a request to upstreams a, b and c, which return the given results, and returns
+// the response and the number of upstreams that were called.
+func (p *ProxySuite) forwardWithQuorum(chainConfig config.ChainConfig, method client.RPCMethod, results map[string]string) (*httptest.ResponseRecorder, JSONRPCResult, int) {
+	chainConfig.RPCs = []string{upstreamA, upstreamB, upstreamC}
+	prxy := proxy.NewProxy(config.Config{Chains: map[uint32]config.ChainConfig{1: chainConfig}}, p.metrics)
+
+	var calls int32
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		atomic.AddInt32(&calls, 1)
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return([]byte(`{"jsonrpc":"2.0","id":1,"result":` + results[c.RequestURI] + `}`))
+		return bodyRes, nil
+	}))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{ID: 1, JSONRPC: "2.0", Method: string(method)})))
+
+	prxy.Forward(c, 1, nil)
+
+	var res JSONRPCResult
+	if w.Code == http.StatusOK {
+		Nil(p.T(), json.Unmarshal(w.Body.Bytes(), &res))
+	}
+	return w, res, int(atomic.LoadInt32(&calls))
+}
+
+// JSONRPCResult is the result of a json-rpc response.
+type JSONRPCResult struct {
+	Result string `json:"result"`
+}
+
+func (p *ProxySuite) TestQuorumFirstResponse() {
+	// every upstream disagrees, so this would fail w/ the chain's confirmations
+	w, res, _ := p.forwardWithQuorum(config.ChainConfig{
+		Checks: 3,
+		Quorum: map[string]config.QuorumPolicy{"eth_gas*": {Type: config.QuorumFirstResponse}},
+	}, client.GasPriceMethod, map[string]string{upstreamA: `"0x1"`, upstreamB: `"0x2"`, upstreamC: `"0x3"`})
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), config.QuorumFirstResponse, w.Header().Get("x-quorum-policy"))
+	NotEmpty(p.T(), res.Result)
+}
+
+func (p *ProxySuite) TestQuorumAgreement() {
+	results := map[string]string{upstreamA: `"0x1"`, upstreamB: `"0x1"`, upstreamC: `"0x2"`}
+
+	// strict agreement fails by default
+	w, _, _ := p.forwardWithQuorum(config.ChainConfig{
+		Quorum: map[string]config.QuorumPolicy{string(client.ChainIDMethod): {Type: config.QuorumAgreement, Confirmations: 3}},
+	}, client.ChainIDMethod, results)
+	Equal(p.T(), http.StatusBadGateway, w.Code)
+	Equal(p.T(), config.QuorumAgreement, w.Header().Get("x-quorum-policy"))
+
+	w, res, _ := p.forwardWithQuorum(config.ChainConfig{
+		Quorum: map[string]config.QuorumPolicy{string(client.ChainIDMethod): {Type: config.QuorumAgreement, Confirmations: 3, OnDivergence: config.DivergenceMajority}},
+	}, client.ChainIDMethod, results)
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), config.DivergenceMajority, w.Header().Get("x-quorum-divergence"))
+	Equal(p.T(), "0x1", res.Result)
+
+	w, res, _ = p.forwardWithQuorum(config.ChainConfig{
+		Quorum: map[string]config.QuorumPolicy{string(client.ChainIDMethod): {Type: config.QuorumAgreement, Confirmations: 3, OnDivergence: config.DivergenceTrusted}},
+		Trust:  map[string]float64{upstreamC: 5},
+	}, client.ChainIDMethod, results)
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), config.DivergenceTrusted, w.Header().Get("x-quorum-divergence"))
+	Equal(p.T(), "0x2", res.Result)
+
+	// N-of-M only asks M upstreams
+	w, _, calls := p.forwardWithQuorum(config.ChainConfig{
+		Quorum: map[string]config.QuorumPolicy{string(client.ChainIDMethod): {Type: config.QuorumAgreement, Confirmations: 2, Upstreams: 2}},
+	}, client.ChainIDMethod, map[string]string{upstreamA: `"0x1"`, upstreamB: `"0x1"`, upstreamC: `"0x1"`})
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), 2, calls)
+}
+
+// TestQuorumDivergenceAcrossChunks makes sure chunks of a split request that resolve a divergence at the same time
+// set the divergence header once.
+func (p *ProxySuite) TestQuorumDivergenceAcrossChunks() {
+	prxy := proxy.NewProxy(config.Config{Chains: map[uint32]config.ChainConfig{1: {
+		RPCs:            []string{upstreamA, upstreamB, upstreamC},
+		MaxGetLogsRange: 10,
+		Quorum:          map[string]config.QuorumPolicy{string(client.GetLogsMethod): {Type: config.QuorumAgreement, Confirmations: 3, OnDivergence: config.DivergenceMajority}},
+	}}}, p.metrics)
+
+	divergentLogs := p.MustMarshall([]types.Log{{Topics: []common.Hash{}, Data: []byte{}, BlockNumber: 1}})
+	prxy.SetClient(omniHTTP.NewCaptureClient(func(c *omniHTTP.CapturedRequest) (omniHTTP.Response, error) {
+		result := []byte(`[]`)
+		if c.RequestURI == upstreamC {
+			result = divergentLogs
+		}
+
+		bodyRes := new(mocks.Response)
+		bodyRes.On("StatusCode").Return(200)
+		bodyRes.On("Body").Return(append(append([]byte(`{"jsonrpc":"2.0","id":1,"result":`), result...), '}'))
+		return bodyRes, nil
+	}))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(p.GetTestContext(), http.MethodPost, "/", bytes.NewReader(p.MustMarshall(rpc.Request{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  string(client.GetLogsMethod),
+		Params:  []json.RawMessage{[]byte(`{"fromBlock":"0x1","toBlock":"0xc8"}`)},
+	})))
+
+	prxy.Forward(c, 1, nil)
+	Equal(p.T(), http.StatusOK, w.Code, w.Body.String())
+	Equal(p.T(), "20", w.Header().Get("x-get-logs-chunks"))
+	Equal(p.T(), []string{config.DivergenceMajority}, w.Header().Values("x-quorum-divergence"))
+}
+
+func (p *ProxySuite) TestQuorumWeighted() {
+	w, res, _ := p.forwardWithQuorum(config.ChainConfig{
+		Quorum: map[string]config.QuorumPolicy{string(client.ChainIDMethod): {Type: config.QuorumWeighted, Weight: 3}},
+		Trust:  map[string]float64{upstreamA: 2},
+	}, client.ChainIDMethod, map[string]string{upstreamA: `"0x1"`, upstreamB: `"0x2"`, upstreamC: `"0x1"`})
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), "0x1", res.Result)
+}
+
+func (p *ProxySuite) TestQuorumHighestBlock() {
+	w, res, calls := p.forwardWithQuorum(config.ChainConfig{
+		Checks: 2,
+		Quorum: map[string]config.QuorumPolicy{string(client.BlockNumberMethod): {Type: config.QuorumHighestBlock}},
+	}, client.BlockNumberMethod, map[string]string{upstreamA: `"0x10"`, upstreamB: `"0x12"`, upstreamC: `"0x11"`})
+
+	Equal(p.T(), http.StatusOK, w.Code)
+	Equal(p.T(), "0x12", res.Result)
+	Equal(p.T(), 3, calls)
+}
+
+func (p *ProxySuite) TestValidateQuorum() {
+	valid := config.ChainConfig{
+		Quorum: map[string]config.QuorumPolicy{
+			"eth_getLogs": {Type: config.QuorumAgreement, Confirmations: 3, OnDivergence: config.DivergenceFail},
+			"eth_gas*":    {Type: config.QuorumFirstResponse},
+		},
+		Trust: map[string]float64{upstreamA: 2},
+	}
+	Nil(p.T(), proxy.ValidateQuorum(valid))
+
+	invalid := []config.ChainConfig{
+		{Quorum: map[string]config.QuorumPolicy{"eth_call": {Type: "fastest"}}},
+		{Quorum: map[string]config.QuorumPolicy{"eth_call": {Type: config.QuorumWeighted}}},
+		{Quorum: map[string]config.QuorumPolicy{"eth_call": {Type: config.QuorumAgreement, OnDivergence: "random"}}},
+		{Quorum: map[string]config.QuorumPolicy{"eth_call": {Type: config.QuorumAgreement, Confirmations: 3, Upstreams: 2}}},
+		{Quorum: map[string]config.QuorumPolicy{"eth_[": {Type: config.QuorumAgreement}}},
+		{Trust: map[string]float64{upstreamA: -1}},
+	}
+	for _, chainConfig := range invalid {
+		NotNil(p.T(), proxy.ValidateQuorum(chainConfig))
+	}
+}
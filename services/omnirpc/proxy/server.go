@@ -9,7 +9,10 @@
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
 	"github.com/synapsecns/sanguine/services/omnirpc/collection"
 	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/fixture"
 	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/modules"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"net/http"
 	"strconv"
@@ -33,8 +36,31 @@ type RPCProxy struct {
 	client omniHTTP.Client
 	// handler is the metrics handler
 	handler metrics.Handler
+	// cache is the response cache. This is nil if caching is disabled
+	cache *responseCache
+	// config is the current config, this is updated by the admin api
+	config config.Config
+	// configMux protects config
+	configMux sync.RWMutex
+	// configPath is the path admin api changes are persisted to. Changes are not persisted if this is empty
+	configPath string
+	// broadcastCounter counts broadcast results by upstream
+	broadcastCounter metric.Int64Counter
+	// logsLimits are eth_getLogs limits learned from upstreams
+	logsLimits *logsLimits
+	// modules are the modules that can be enabled by name, protected by configMux
+	modules map[string]modules.Module
+	// fixtures records or replays responses. This is nil if fixtures are disabled
+	fixtures *fixtures
+	// clients are the api clients. This is nil if api keys aren't required
+	clients *clientRegistry
+	// auditor compares responses across upstreams. This is nil if auditing is disabled
+	auditor *auditor
 }
 
+// proxyMeter is the name of the meter used by the proxy.
+const proxyMeter = "github.com/synapsecns/sanguine/services/omnirpc/proxy"
+
 // defaultInterval is the default refresh interval.
 const defaultInterval = 30
 
@@ -44,57 +70,192 @@ func NewProxy(config config.Config, handler metrics.Handler) *RPCProxy {
 		logger.Warn("no refresh interval set (or interval is 0), using default of %d seconds", defaultInterval)
 	}
 
-	return &RPCProxy{
+	r := &RPCProxy{
 		chainManager:    chainmanager.NewChainManagerFromConfig(config, handler),
 		refreshInterval: time.Second * time.Duration(config.RefreshInterval),
 		port:            config.Port,
 		client:          omniHTTP.NewClient(omniHTTP.ClientTypeFromString(config.ClientType)),
 		handler:         handler,
 		tracer:          handler.Tracer(),
+		config:          config,
+		logsLimits:      newLogsLimits(),
+		modules:         make(map[string]modules.Module),
+	}
+
+	for _, module := range builtinModules() {
+		r.modules[module.Name()] = module
+	}
+
+	for chainID, chainConfig := range config.Chains {
+		if err := r.validateModules(chainConfig.Modules); err != nil {
+			logger.Errorf("chain %d: %v", chainID, err)
+		}
+
+		if err := validateQuorum(chainConfig); err != nil {
+			logger.Errorf("chain %d: %v", chainID, err)
+		}
+	}
+
+	var err error
+	r.broadcastCounter, err = handler.Meter(proxyMeter).Int64Counter(broadcastResultsMetric, metric.WithDescription("eth_sendRawTransaction results by upstream"))
+	if err != nil {
+		logger.Errorf("could not create broadcast counter: %v", err)
+		r.broadcastCounter = &metrics.NullCounter{}
+	}
+
+	if len(config.Clients) > 0 {
+		r.clients, err = newClientRegistry(config.Clients, handler)
+		if err != nil {
+			logger.Errorf("could not setup clients, rejecting all requests: %v", err)
+			// fail closed so a bad client config doesn't open up the proxy
+			r.clients = &clientRegistry{required: true, requests: &metrics.NullCounter{}}
+		}
+	}
+
+	if config.Audit.Enabled() {
+		r.auditor, err = newAuditor(config.Audit, handler)
+		if err != nil {
+			logger.Errorf("could not create auditor, continuing without auditing: %v", err)
+		}
+	}
+
+	if config.Fixture.Mode != "" {
+		r.setupFixtures(config)
+	}
+
+	if config.Cache.Enabled() {
+		r.cache, err = newResponseCache(config.Cache, handler)
+		if err != nil {
+			logger.Errorf("could not create response cache, continuing without cache: %v", err)
+		}
+	}
+
+	return r
+}
+
+// setupFixtures sets up recording or replaying.
+func (r *RPCProxy) setupFixtures(cfg config.Config) {
+	var err error
+	r.fixtures, err = newFixtures(cfg.Fixture)
+	if err != nil {
+		logger.Errorf("could not setup fixtures: %v", err)
+		// replaying must never fall back to upstreams, so every request will fail instead
+		if cfg.Fixture.Mode == config.FixtureModeReplay {
+			r.fixtures = &fixtures{mode: config.FixtureModeReplay, archive: fixture.NewArchive()}
+		}
+		return
+	}
+
+	if !r.fixtures.replaying() {
+		return
+	}
+
+	// recorded chains can be replayed without being configured
+	for _, chainID := range r.fixtures.archive.ChainIDs() {
+		if _, ok := cfg.Chains[chainID]; !ok {
+			r.chainManager.UpdateChain(chainID, config.ChainConfig{})
+		}
 	}
 }
 
 // Run runs the rpc server until context cancellation.
 func (r *RPCProxy) Run(ctx context.Context) {
-	go r.startProxyLoop(ctx)
+	// upstreams aren't used when replaying
+	if !r.fixtures.replaying() {
+		go r.startProxyLoop(ctx)
+
+		if r.auditor != nil {
+			go r.startAuditLoop(ctx)
+		}
+	}
+
+	if r.fixtures.recording() {
+		go r.fixtures.saveLoop(ctx)
+	}
+
+	if r.cache != nil {
+		go r.cache.closeOnDone(ctx)
+	}
 
 	router := ginhelper.New(logger)
 	router.Use(r.handler.Gin())
 
-	router.POST("/rpc/:id", func(c *gin.Context) {
+	r.registerRPCRoutes(router)
+
+	if r.config.Admin.Enabled() {
+		r.registerAdminRoutes(router.Group("/admin", adminAuth(r.config.Admin.Token)))
+	}
+
+	// gets a list of chain-ids
+	// TODO: this needs to be added to the collection.json
+	router.GET("/chain-ids", func(c *gin.Context) {
+		c.JSON(http.StatusOK, r.chainManager.GetChainIDs())
+	})
+
+	// gets the health of each upstream for a chain
+	router.GET("/health/:id", func(c *gin.Context) {
 		chainID, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": fmt.Sprintf("chainid must be a number: %d", chainID),
 			})
+			return
 		}
-		r.Forward(c, uint32(chainID), nil)
+
+		chain := r.chainManager.GetChain(uint32(chainID))
+		if chain == nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": fmt.Sprintf("chain %d not found", chainID),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, chain.Health())
 	})
 
-	router.POST("/confirmations/:confirmations/rpc/:id", func(c *gin.Context) {
+	// gets the eth_getLogs limits learned for each upstream of a chain
+	router.GET("/limits/:id", func(c *gin.Context) {
 		chainID, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": fmt.Sprintf("chainid must be a number: %d", chainID),
 			})
+			return
 		}
-		realConfs, err := strconv.Atoi(c.Param("confirmations"))
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": fmt.Sprintf("confirmations must be a number: %d", chainID),
+
+		chain := r.chainManager.GetChain(uint32(chainID))
+		if chain == nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": fmt.Sprintf("chain %d not found", chainID),
 			})
+			return
 		}
 
-		confirmations := uint16(realConfs)
-
-		r.Forward(c, uint32(chainID), &confirmations)
+		c.JSON(http.StatusOK, r.logsLimits.limits(chain.URLs()))
 	})
 
-	// gets a list of chain-ids
-	// TODO: this needs to be added to the collection.json
-	router.GET("/chain-ids", func(c *gin.Context) {
-		c.JSON(http.StatusOK, r.chainManager.GetChainIDs())
-	})
+	// gets the consistency audit report for a chain
+	if r.auditor != nil {
+		router.GET("/audit/:id", func(c *gin.Context) {
+			chainID, err := strconv.Atoi(c.Param("id"))
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("chainid must be a number: %d", chainID),
+				})
+				return
+			}
+
+			chain := r.chainManager.GetChain(uint32(chainID))
+			if chain == nil {
+				c.JSON(http.StatusNotFound, gin.H{
+					"error": fmt.Sprintf("chain %d not found", chainID),
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, r.auditor.report(uint32(chainID), auditURLs(chain)))
+		})
+	}
 
 	router.GET("/collection.json", func(c *gin.Context) {
 		res, err := collection.CreateCollection()
@@ -113,6 +274,44 @@ func (r *RPCProxy) Run(ctx context.Context) {
 	}
 }
 
+// registerRPCRoutes registers the routes rpc requests are forwarded from.
+func (r *RPCProxy) registerRPCRoutes(router gin.IRoutes) {
+	rpcHandler := func(c *gin.Context) {
+		chainID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("chainid must be a number: %d", chainID),
+			})
+		}
+		r.Forward(c, uint32(chainID), nil)
+	}
+
+	confirmationsHandler := func(c *gin.Context) {
+		chainID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("chainid must be a number: %d", chainID),
+			})
+		}
+		realConfs, err := strconv.Atoi(c.Param("confirmations"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("confirmations must be a number: %d", chainID),
+			})
+		}
+
+		confirmations := uint16(realConfs)
+
+		r.Forward(c, uint32(chainID), &confirmations)
+	}
+
+	router.POST("/rpc/:id", r.authenticateClient, rpcHandler)
+	router.POST("/confirmations/:confirmations/rpc/:id", r.authenticateClient, confirmationsHandler)
+	// api keys can be passed as a path segment for clients that can't set headers
+	router.POST("/key/:key/rpc/:id", r.authenticateClient, rpcHandler)
+	router.POST("/key/:key/confirmations/:confirmations/rpc/:id", r.authenticateClient, confirmationsHandler)
+}
+
 // scanInterval is how long to wait between latency scans.
 const scanInterval = time.Second * 60
 
@@ -155,6 +354,22 @@ func (r *RPCProxy) benchmarkProxies(parentCtx context.Context) {
 	wg.Wait()
 }
 
+// SetConfigPath sets the path changes made through the admin api are persisted to.
+func (r *RPCProxy) SetConfigPath(path string) {
+	r.configMux.Lock()
+	defer r.configMux.Unlock()
+
+	r.configPath = path
+}
+
+// chainConfig gets the current config for a chain.
+func (r *RPCProxy) chainConfig(chainID uint32) config.ChainConfig {
+	r.configMux.RLock()
+	defer r.configMux.RUnlock()
+
+	return r.config.Chains[chainID]
+}
+
 // Port gets the port the proxy is running on.
 func (r *RPCProxy) Port() uint16 {
 	return r.port
@@ -9,3 +9,8 @@
 func GetLatency(ctx context.Context, rpcURL string) (l Result) {
 	return getLatency(ctx, rpcURL, metrics.NewNullHandler())
 }
+
+// GetHistory gets the state history of an rpc.
+func GetHistory(ctx context.Context, rpcURL string, head uint64) HistoryResult {
+	return getStateHistory(ctx, rpcURL, head, metrics.NewNullHandler())
+}
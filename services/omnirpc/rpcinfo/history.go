@@ -0,0 +1,112 @@
+package rpcinfo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	ethClient "github.com/synapsecns/sanguine/ethergo/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+// HistoryDepths are the depths (in blocks behind the head) state is probed at. The smallest depth is
+// kept by every node, so failing to read state there is treated as an error rather than a window of 0.
+var HistoryDepths = []uint64{128, 1024, 10_000, 100_000, 1_000_000}
+
+// archiveProbeBlock is the block state is read at to check if an rpc is an archive node.
+const archiveProbeBlock = 1
+
+// HistoryResult is the result of a state history check on a url.
+type HistoryResult struct {
+	// URL is the url of the rpc being tested
+	URL string
+	// Archive is true if the rpc can serve state for every block
+	Archive bool
+	// Window is the approximate number of blocks behind the head the rpc can serve state for.
+	// This is the deepest successful probe, so the actual window may be larger.
+	Window uint64
+	// HasError is wether or not the result has an error
+	HasError bool
+	// Error is the error received when trying to probe history
+	Error error
+}
+
+// GetStateHistory probes how far back a list of rpcs can serve state, relative to head.
+func GetStateHistory(parentCtx context.Context, timeout time.Duration, rpcList []string, head uint64, handler metrics.Handler) (historySlice []HistoryResult) {
+	var mux sync.Mutex
+
+	timeCtx, cancel := context.WithTimeout(parentCtx, timeout)
+
+	traceCtx, span := handler.Tracer().Start(timeCtx, "rpcinfo.GetStateHistory", trace.WithAttributes(attribute.StringSlice("rpcList", rpcList)))
+	defer func() {
+		metrics.EndSpan(span)
+		cancel()
+	}()
+
+	g, ctx := errgroup.WithContext(traceCtx)
+	for _, rpcURL := range rpcList {
+		// capture func literal
+		rpcURL := rpcURL
+		g.Go(func() error {
+			history := getStateHistory(ctx, rpcURL, head, handler)
+
+			mux.Lock()
+			historySlice = append(historySlice, history)
+			mux.Unlock()
+
+			return nil
+		})
+	}
+
+	// we don't error at all above
+	_ = g.Wait()
+	return historySlice
+}
+
+// getStateHistory reads the balance of the zero address at increasing depths until a read fails.
+// Pruned nodes error with "missing trie node" (or similar) when state is outside their window.
+func getStateHistory(ctx context.Context, rpcURL string, head uint64, handler metrics.Handler) (h HistoryResult) {
+	h = HistoryResult{URL: rpcURL, HasError: true}
+
+	client, err := ethClient.DialBackend(ctx, rpcURL, handler)
+	if err != nil {
+		h.Error = fmt.Errorf("could not create client: %w", err)
+		return h
+	}
+
+	if head > archiveProbeBlock {
+		_, err = client.BalanceAt(ctx, common.Address{}, new(big.Int).SetUint64(archiveProbeBlock))
+		if err == nil {
+			h.Archive = true
+			h.Window = head
+			h.HasError = false
+			return h
+		}
+	}
+
+	for i, depth := range HistoryDepths {
+		if depth >= head {
+			break
+		}
+
+		_, err = client.BalanceAt(ctx, common.Address{}, new(big.Int).SetUint64(head-depth))
+		if err != nil {
+			if i == 0 {
+				h.Error = fmt.Errorf("could not read state %d blocks behind head: %w", depth, err)
+				return h
+			}
+			break
+		}
+
+		h.Window = depth
+	}
+
+	h.HasError = false
+	return h
+}
@@ -0,0 +1,57 @@
+package rpcinfo_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
+)
+
+// newHistoryServer creates an rpc that can serve state for blocks at or after oldestBlock.
+func (r *LatencySuite) newHistoryServer(oldestBlock uint64) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcReq struct {
+			ID     json.RawMessage   `json:"id"`
+			Params []json.RawMessage `json:"params"`
+		}
+		Nil(r.T(), json.NewDecoder(req.Body).Decode(&rpcReq))
+
+		var blockHex string
+		Nil(r.T(), json.Unmarshal(rpcReq.Params[1], &blockHex))
+		block, err := strconv.ParseUint(blockHex, 0, 64)
+		Nil(r.T(), err)
+
+		w.Header().Set("Content-Type", "application/json")
+		if block < oldestBlock {
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"missing trie node"}}`, rpcReq.ID)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x0"}`, rpcReq.ID)
+	}))
+	r.T().Cleanup(server.Close)
+
+	return server
+}
+
+func (r *LatencySuite) TestStateHistory() {
+	const head = 50_000
+
+	archive := rpcinfo.GetHistory(r.GetTestContext(), r.newHistoryServer(0).URL, head)
+	False(r.T(), archive.HasError)
+	True(r.T(), archive.Archive)
+	Equal(r.T(), uint64(head), archive.Window)
+
+	pruned := rpcinfo.GetHistory(r.GetTestContext(), r.newHistoryServer(head-2000).URL, head)
+	False(r.T(), pruned.HasError)
+	False(r.T(), pruned.Archive)
+	Equal(r.T(), uint64(1024), pruned.Window)
+
+	// every node should keep the most recent state
+	broken := rpcinfo.GetHistory(r.GetTestContext(), r.newHistoryServer(head).URL, head)
+	True(r.T(), broken.HasError)
+	NotNil(r.T(), broken.Error)
+}
@@ -0,0 +1,2 @@
+// Package rpckey creates keys that identify json-rpc requests regardless of id and formatting.
+package rpckey
@@ -0,0 +1,27 @@
+package rpckey
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+)
+
+// ForRequest creates a key for a request from the method and params. Ids, whitespace and
+// hex casing are ignored so identical requests from different clients share a key.
+func ForRequest(req rpc.Request) (string, error) {
+	params, err := json.Marshal(req.Params)
+	if err != nil {
+		return "", fmt.Errorf("could not marshall params: %w", err)
+	}
+
+	var compacted bytes.Buffer
+	err = json.Compact(&compacted, params)
+	if err != nil {
+		return "", fmt.Errorf("could not compact params: %w", err)
+	}
+
+	// hex values are case insensitive
+	return fmt.Sprintf("%s:%s", req.Method, bytes.ToLower(compacted.Bytes())), nil
+}
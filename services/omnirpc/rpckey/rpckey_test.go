@@ -0,0 +1,37 @@
+package rpckey_test
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/rpckey"
+)
+
+func TestForRequest(t *testing.T) {
+	keyA, err := rpckey.ForRequest(rpc.Request{
+		ID:     1,
+		Method: string(client.GetBalanceMethod),
+		Params: []json.RawMessage{[]byte(`"0xAbC"`), []byte(` "0x1" `)},
+	})
+	Nil(t, err)
+
+	// ids, whitespace and hex casing should be ignored
+	keyB, err := rpckey.ForRequest(rpc.Request{
+		ID:     2,
+		Method: string(client.GetBalanceMethod),
+		Params: []json.RawMessage{[]byte(`"0xabc"`), []byte(`"0x1"`)},
+	})
+	Nil(t, err)
+	Equal(t, keyA, keyB)
+
+	keyC, err := rpckey.ForRequest(rpc.Request{
+		ID:     1,
+		Method: string(client.GetCodeMethod),
+		Params: []json.RawMessage{[]byte(`"0xabc"`), []byte(`"0x1"`)},
+	})
+	Nil(t, err)
+	NotEqual(t, keyA, keyC)
+}
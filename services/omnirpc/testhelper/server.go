@@ -46,6 +46,31 @@ func makeConfig(backends []backends.SimulatedTestBackend, clientType omniHTTP.Cl
 func NewOmnirpcServer(ctx context.Context, tb testing.TB, backends ...backends.SimulatedTestBackend) string {
 	tb.Helper()
 
+	return startServer(ctx, tb, makeConfig(backends, omniHTTP.FastHTTP))
+}
+
+// NewReplayServer creates a new omnirpc server that serves responses from a fixture archive recorded
+// with `omnirpc server --record`. Requests that weren't recorded fail.
+// a string is returned with the base url for the omnirpc server, use GetChainURL to get the url for a chain.
+//
+// context is respected and the server will be killed when the context is done.
+func NewReplayServer(ctx context.Context, tb testing.TB, archivePath string) string {
+	tb.Helper()
+
+	return startServer(ctx, tb, config.Config{
+		Chains: make(map[uint32]config.ChainConfig),
+		Port:   uint16(freeport.GetPort()),
+		Fixture: config.FixtureConfig{
+			Mode: config.FixtureModeReplay,
+			Path: archivePath,
+		},
+	})
+}
+
+// startServer starts a server from a config and waits for it to start.
+func startServer(ctx context.Context, tb testing.TB, cfg config.Config) string {
+	tb.Helper()
+
 	// don't use metrics on ci for integration tests
 	isCI := core.GetEnvBool("CI", false)
 	useMetrics := !isCI
@@ -60,7 +85,7 @@ func NewOmnirpcServer(ctx context.Context, tb testing.TB, backends ...backends.S
 	handler, err := metrics.NewByType(ctx, metadata.BuildInfo(), metricsHandler)
 	assert.Nil(tb, err)
 
-	server := proxy.NewProxy(makeConfig(backends, omniHTTP.FastHTTP), handler)
+	server := proxy.NewProxy(cfg, handler)
 
 	go func() {
 		server.Run(ctx)
@@ -99,3 +124,8 @@ func NewOmnirpcServer(ctx context.Context, tb testing.TB, backends ...backends.S
 func GetURL(baseHost string, backend backends.SimulatedTestBackend) string {
 	return fmt.Sprintf("%s/rpc/%d", baseHost, backend.GetChainID())
 }
+
+// GetChainURL gets the url for a given chain id given the base host.
+func GetChainURL(baseHost string, chainID uint32) string {
+	return fmt.Sprintf("%s/rpc/%d", baseHost, chainID)
+}
@@ -3,8 +3,11 @@
 import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/fixture"
 	"github.com/synapsecns/sanguine/services/omnirpc/testhelper"
 	"math/big"
+	"path/filepath"
 )
 
 func (s *TestHelperSuite) TestOminrpcServer() {
@@ -24,3 +27,25 @@ func (s *TestHelperSuite) TestOminrpcServer() {
 		Equal(s.T(), ogBlock.Hash(), omniBlock.Hash())
 	}
 }
+
+func (s *TestHelperSuite) TestReplayServer() {
+	const chainID = 42
+
+	archive := fixture.NewArchive()
+	Nil(s.T(), archive.Record(chainID, rpc.Request{Method: "eth_chainId"}, []byte(`{"jsonrpc":"2.0","id":1,"result":"0x2a"}`)))
+
+	archivePath := filepath.Join(s.T().TempDir(), "fixtures.json.gz")
+	Nil(s.T(), archive.Save(archivePath))
+
+	replayServer := testhelper.NewReplayServer(s.GetTestContext(), s.T(), archivePath)
+
+	omnirpcEthClient, err := ethclient.DialContext(s.GetTestContext(), testhelper.GetChainURL(replayServer, chainID))
+	Nil(s.T(), err)
+
+	replayedChainID, err := omnirpcEthClient.ChainID(s.GetTestContext())
+	Nil(s.T(), err)
+	Equal(s.T(), uint64(chainID), replayedChainID.Uint64())
+
+	_, err = omnirpcEthClient.BlockNumber(s.GetTestContext())
+	NotNil(s.T(), err)
+}
@@ -12,8 +12,11 @@
 	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	"github.com/synapsecns/sanguine/services/rfq/api/config"
 	"github.com/synapsecns/sanguine/services/rfq/api/db/sql"
+	"github.com/synapsecns/sanguine/services/rfq/api/db/sql/base"
 	"github.com/synapsecns/sanguine/services/rfq/api/rest"
 	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 var configFlag = &cli.StringFlag{
@@ -58,3 +61,24 @@
 		return nil
 	},
 }
+
+// migrateCommand runs versioned migrations on the api database.
+var migrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{configFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		cfg, err := config.LoadConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("could not read config file: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(cfg.Database.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, cfg.Database.DSN, "api.db", schema.NamingStrategy{})
+	},
+})
@@ -0,0 +1,17 @@
+package base
+
+import (
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/rfq/api/db"
+)
+
+// MigrationService is the name the rfq api db's migrations are stored under.
+const MigrationService = "rfq-api"
+
+// GetMigrations gets the versioned schema migrations for the rfq api db. New migrations are appended here
+// and released migrations must never be changed, so their models are listed out rather than taken from GetAllModels.
+func GetMigrations() []dbcommon.Migration {
+	return []dbcommon.Migration{
+		dbcommon.AutoMigration(1, "initial_schema", &db.Quote{}),
+	}
+}
@@ -52,7 +52,7 @@ func NewSqliteStore(parentCtx context.Context, dbPath string, handler metrics.Ha
 	handler.AddGormCallbacks(gdb)
 
 	if !skipMigrations {
-		err = gdb.WithContext(ctx).AutoMigrate(base.GetAllModels()...)
+		err = common_base.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
 		if err != nil {
 			return nil, fmt.Errorf("could not migrate models: %w", err)
 		}
@@ -5,10 +5,14 @@
 	"fmt"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/commandline"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/base"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/service"
 	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 var configFlag = &cli.StringFlag{
@@ -43,3 +47,24 @@
 		return nil
 	},
 }
+
+// migrateCommand runs versioned migrations on the relayer database.
+var migrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{configFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		cfg, err := relconfig.LoadConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("could not read config file: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(cfg.Database.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, cfg.Database.DSN, dbcommon.SqliteFileName, schema.NamingStrategy{})
+	},
+})
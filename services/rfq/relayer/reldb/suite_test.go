@@ -6,6 +6,7 @@
 	"github.com/synapsecns/sanguine/services/rfq/api/metadata"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/mysql"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/postgres"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/sqlite"
 	"os"
 	"sync"
@@ -16,6 +17,7 @@
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dbcommon/postgrestest"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
@@ -26,6 +28,8 @@ type DBSuite struct {
 	*testsuite.TestSuite
 	dbs     []reldb.Service
 	metrics metrics.Handler
+	// postgresConnString is the postgres database used when postgres tests are enabled
+	postgresConnString string
 }
 
 // NewDBSuite creates a new DBSuite.
@@ -52,6 +56,10 @@ func (d *DBSuite) SetupSuite() {
 	var err error
 	d.metrics, err = metrics.NewByType(d.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
 	Nil(d.T(), err)
+
+	if os.Getenv(dbcommon.EnablePostgresTestVar) == "true" {
+		d.postgresConnString = postgrestest.GetConnString(d.T())
+	}
 }
 
 func (d *DBSuite) SetupTest() {
@@ -62,6 +70,7 @@ func (d *DBSuite) SetupTest() {
 
 	d.dbs = []reldb.Service{sqliteStore}
 	d.setupMysqlDB()
+	d.setupPostgresDB()
 }
 
 func (d *DBSuite) setupMysqlDB() {
@@ -89,6 +98,21 @@ func (d *DBSuite) setupMysqlDB() {
 	d.dbs = append(d.dbs, mysqlStore)
 }
 
+func (d *DBSuite) setupPostgresDB() {
+	if d.postgresConnString == "" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("rfq_%d", d.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(d.GetTestContext(), d.postgresConnString, d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs = append(d.dbs, postgresStore)
+}
+
 func (d *DBSuite) RunOnAllDBs(testFunc func(testDB reldb.Service)) {
 	d.T().Helper()
 
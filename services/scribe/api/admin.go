@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/core/ginhelper"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/grpc/server"
+)
+
+// AdminConfig contains the config for the admin api.
+type AdminConfig struct {
+	// Port is the port for the admin api.
+	Port uint16
+	// Token is the bearer token admin requests are authorized with.
+	Token string
+}
+
+// StartAdmin starts the admin api, which changes the chains and contracts indexed by a running scribe.
+func StartAdmin(ctx context.Context, cfg AdminConfig, admin server.Admin, handler metrics.Handler) error {
+	logger.Warnf("starting admin server")
+	router := ginhelper.New(logger)
+	router.GET(ginhelper.MetricsEndpoint, gin.WrapH(handler.Handler()))
+	router.Use(handler.Gin())
+
+	grpcServer, err := server.SetupAdminServer(ctx, router, admin, cfg.Token, handler)
+	if err != nil {
+		return fmt.Errorf("could not create admin server: %w", err)
+	}
+
+	return serve(ctx, cfg.Port, router, grpcServer)
+}
@@ -12,6 +12,7 @@
 	"github.com/synapsecns/sanguine/services/scribe/grpc/client/rest"
 	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
 	"math/big"
+	"time"
 )
 
 func (g APISuite) TestRetrieveData() {
@@ -302,6 +303,68 @@ func (g APISuite) TestLastContractIndexed() {
 	Equal(g.T(), int(blockNumber), *retrievedBlockTime.Response)
 }
 
+func (g APISuite) TestIndexingStatus() {
+	chainID := gofakeit.Uint32()
+	contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	eta := 90 * time.Second
+
+	err := g.db.StoreIndexerProgress(g.GetTestContext(), db.IndexerProgress{
+		ChainID:         chainID,
+		ContractAddress: contractAddress,
+		Mode:            db.BackfillMode,
+		CurrentBlock:    100,
+		TargetBlock:     1000,
+		HeadBlock:       1000,
+		Rates:           []db.IndexingRate{{Window: time.Minute, BlocksPerSecond: 2.5}},
+		ETA:             &eta,
+		LastProgressAt:  time.Now(),
+		UpdatedAt:       time.Now(),
+	})
+	Nil(g.T(), err)
+
+	chain := int(chainID)
+	statuses, err := g.gqlClient.GetIndexingStatus(g.GetTestContext(), &chain)
+	Nil(g.T(), err)
+	Equal(g.T(), 1, len(statuses.Response))
+
+	status := statuses.Response[0]
+	Equal(g.T(), contractAddress.String(), status.ContractAddress)
+	Equal(g.T(), "backfill", status.Mode)
+	Equal(g.T(), 900, status.Lag)
+	Equal(g.T(), 60, status.Rates[0].WindowSeconds)
+	Equal(g.T(), 90, *status.EtaSeconds)
+	Nil(g.T(), status.LastError)
+}
+
+func (g APISuite) TestCallFrames() {
+	chainID := gofakeit.Uint32()
+	txHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+	router := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	recipient := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+	err := g.db.StoreCallFrames(g.GetTestContext(), chainID,
+		db.CallFrame{TxHash: txHash, BlockNumber: 5, Index: 0, TraceAddress: []uint64{}, Type: "CALL", From: recipient, To: router, Value: big.NewInt(10), Input: []byte{1}},
+		db.CallFrame{TxHash: txHash, BlockNumber: 5, Index: 1, TraceAddress: []uint64{0}, Type: "CALL", From: router, To: recipient, Value: big.NewInt(10), Error: "execution reverted"},
+	)
+	Nil(g.T(), err)
+
+	txHashString := txHash.String()
+	frames, err := g.gqlClient.GetCallFrames(g.GetTestContext(), int(chainID), &txHashString, nil, nil, 1)
+	Nil(g.T(), err)
+	Equal(g.T(), 2, len(frames.Response))
+	Equal(g.T(), "01", frames.Response[0].Input)
+	Nil(g.T(), frames.Response[0].Error)
+
+	fromAddress := router.String()
+	frames, err = g.gqlClient.GetCallFrames(g.GetTestContext(), int(chainID), nil, &fromAddress, nil, 1)
+	Nil(g.T(), err)
+	Equal(g.T(), 1, len(frames.Response))
+	Equal(g.T(), []int{0}, frames.Response[0].TraceAddress)
+	Equal(g.T(), 1, frames.Response[0].Depth)
+	Equal(g.T(), "10", *frames.Response[0].Value)
+	Equal(g.T(), "execution reverted", *frames.Response[0].Error)
+}
+
 // nolint:dupl
 func (g APISuite) TestLogCount() {
 	// create data for storing a block time
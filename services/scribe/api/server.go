@@ -12,10 +12,12 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/scribe/db"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
 	gqlServer "github.com/synapsecns/sanguine/services/scribe/graphql/server"
 	"github.com/synapsecns/sanguine/services/scribe/grpc/server"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"net"
 	"net/http"
 	"os"
@@ -63,12 +65,18 @@ func Start(ctx context.Context, cfg Config, handler metrics.Handler) error {
 
 	router.GET("static", gin.WrapH(http.FileServer(http.FS(static))))
 	fmt.Printf("started graphiql gqlServer on port: http://localhost:%d/graphiql\n", cfg.Port)
+
+	return serve(ctx, cfg.Port, router, grpcServer)
+}
+
+// serve serves http and grpc requests on the same port until the context is canceled.
+func serve(ctx context.Context, port uint16, router *gin.Engine, grpcServer *grpc.Server) error {
 	g, ctx := errgroup.WithContext(ctx)
 
 	var lc net.ListenConfig
-	listener, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", cfg.Port))
+	listener, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
-		return fmt.Errorf("could not listen on port %d", cfg.Port)
+		return fmt.Errorf("could not listen on port %d", port)
 	}
 
 	m := cmux.New(listener)
@@ -88,7 +96,7 @@ func Start(ctx context.Context, cfg Config, handler metrics.Handler) error {
 	})
 
 	g.Go(func() error {
-		err = grpcServer.Serve(grpcListener)
+		err := grpcServer.Serve(grpcListener)
 		if err != nil {
 			return fmt.Errorf("could not start grpc server: %w", err)
 		}
@@ -156,6 +164,13 @@ func InitDB(ctx context.Context, databaseType string, path string, metrics metri
 		}
 
 		return mysqlStore, nil
+	case databaseType == "postgres":
+		postgresStore, err := postgres.NewPostgresStore(ctx, path, metrics, skipMigrations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres store: %w", err)
+		}
+
+		return postgresStore, nil
 	default:
 		return nil, fmt.Errorf("invalid databaseType type: %s", databaseType)
 	}
@@ -23,7 +23,7 @@ func Start(args []string, buildInfo config.BuildInfo) {
 	}
 
 	// commands
-	app.Commands = cli.Commands{infoCommand, scribeCommand, serverCommand, generateCommand}
+	app.Commands = cli.Commands{infoCommand, scribeCommand, serverCommand, generateCommand, migrateCommand, verifyCommand, exportCommand}
 	shellCommand := commandline.GenerateShellCommand(app.Commands)
 	app.Commands = append(app.Commands, shellCommand)
 	app.Action = shellCommand.Action
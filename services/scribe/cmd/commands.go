@@ -2,21 +2,33 @@
 
 // TODO update this to match new commands + migrate flags to config.
 import (
+	"context"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/scribe/backend"
 	"github.com/synapsecns/sanguine/services/scribe/service"
+	"github.com/synapsecns/sanguine/services/scribe/service/exporter"
+	"github.com/synapsecns/sanguine/services/scribe/service/verifier"
 	// used to embed markdown.
 	_ "embed"
 	"fmt"
 
 	markdown "github.com/MichaelMure/go-term-markdown"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/jftuga/termsize"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/services/scribe/api"
 	"github.com/synapsecns/sanguine/services/scribe/config"
 	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/base"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 //go:embed cmd.md
@@ -50,7 +62,7 @@
 
 var dbFlag = &cli.StringFlag{
 	Name:     "db",
-	Usage:    "--db <sqlite> or <mysql>",
+	Usage:    "--db <sqlite>, <mysql> or <postgres>",
 	Value:    "sqlite",
 	Required: true,
 }
@@ -75,23 +87,45 @@ func createScribeParameters(c *cli.Context) (eventDB db.EventDB, clients map[uin
 
 	clients = make(map[uint32][]backend.ScribeBackend)
 	for _, client := range scribeConfig.Chains {
-		for confNum := 1; confNum <= MaxConfirmations; confNum++ {
-			backendClient, err := backend.DialBackend(c.Context, fmt.Sprintf("%s/%d/rpc/%d", scribeConfig.RPCURL, confNum, client.ChainID), metrics.Get())
-			if err != nil {
-				return nil, nil, scribeConfig, fmt.Errorf("could not start client for %s", fmt.Sprintf("%s/1/rpc/%d", scribeConfig.RPCURL, client.ChainID))
-			}
-			clients[client.ChainID] = append(clients[client.ChainID], backendClient)
+		clients[client.ChainID], err = dialClients(c.Context, scribeConfig.RPCURL, client.ChainID)
+		if err != nil {
+			return nil, nil, scribeConfig, err
 		}
 	}
 
 	return eventDB, clients, scribeConfig, nil
 }
 
+// dialClients dials a client for each number of confirmations on a chain.
+func dialClients(ctx context.Context, rpcURL string, chainID uint32) ([]backend.ScribeBackend, error) {
+	var clients []backend.ScribeBackend
+	for confNum := 1; confNum <= MaxConfirmations; confNum++ {
+		backendClient, err := backend.DialBackend(ctx, fmt.Sprintf("%s/%d/rpc/%d", rpcURL, confNum, chainID), metrics.Get())
+		if err != nil {
+			return nil, fmt.Errorf("could not start client for %s", fmt.Sprintf("%s/1/rpc/%d", rpcURL, chainID))
+		}
+		clients = append(clients, backendClient)
+	}
+	return clients, nil
+}
+
+var adminPortFlag = &cli.UintFlag{
+	Name:  "admin-port",
+	Usage: "--admin-port 5122, serves the admin api to add and remove chains and contracts when set",
+	Value: 0,
+}
+
+var adminTokenFlag = &cli.StringFlag{
+	Name:    "admin-token",
+	Usage:   "--admin-token <token>, the bearer token admin api requests are authorized with",
+	EnvVars: []string{"SCRIBE_ADMIN_TOKEN"},
+}
+
 var scribeCommand = &cli.Command{
 	// TODO: rename this command to indexer
 	Name:        "scribe",
 	Description: "scribe runs the scribe, livefilling across all specified chains",
-	Flags:       []cli.Flag{configFlag, dbFlag, pathFlag},
+	Flags:       []cli.Flag{configFlag, dbFlag, pathFlag, adminPortFlag, adminTokenFlag, skipMigrationFlag},
 	Action: func(c *cli.Context) error {
 		db, clients, decodeConfig, err := createScribeParameters(c)
 		if err != nil {
@@ -101,11 +135,36 @@ func createScribeParameters(c *cli.Context) (eventDB db.EventDB, clients map[uin
 		if err != nil {
 			return fmt.Errorf("could not create scribe: %w", err)
 		}
-		err = scribe.Start(c.Context)
-		if err != nil {
-			return fmt.Errorf("could not start scribe: %w", err)
+		// Changes made through the admin api are saved to the config.
+		scribe.SetConfigPath(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		scribe.SetClientDialer(func(ctx context.Context, chainID uint32) ([]backend.ScribeBackend, error) {
+			return dialClients(ctx, decodeConfig.RPCURL, chainID)
+		})
+
+		g, ctx := errgroup.WithContext(c.Context)
+		g.Go(func() error {
+			err := scribe.Start(ctx)
+			if err != nil {
+				return fmt.Errorf("could not start scribe: %w", err)
+			}
+			return nil
+		})
+
+		if c.Uint(adminPortFlag.Name) != 0 {
+			g.Go(func() error {
+				err := api.StartAdmin(ctx, api.AdminConfig{
+					Port:  uint16(c.Uint(adminPortFlag.Name)),
+					Token: c.String(adminTokenFlag.Name),
+				}, scribe, metrics.Get())
+				if err != nil {
+					return fmt.Errorf("could not start admin server: %w", err)
+				}
+				return nil
+			})
 		}
-		return nil
+
+		//nolint: wrapcheck
+		return g.Wait()
 	},
 }
 
@@ -188,6 +247,257 @@ func createScribeParameters(c *cli.Context) (eventDB db.EventDB, clients map[uin
 	},
 }
 
+var chainIDFlag = &cli.UintFlag{
+	Name:     "chain-id",
+	Usage:    "--chain-id 1",
+	Required: true,
+}
+
+var addressFlag = &cli.StringFlag{
+	Name:     "address",
+	Usage:    "--address 0x...",
+	Required: true,
+}
+
+var startBlockFlag = &cli.Uint64Flag{
+	Name:     "start-block",
+	Usage:    "--start-block 1000",
+	Required: true,
+}
+
+var endBlockFlag = &cli.Uint64Flag{
+	Name:     "end-block",
+	Usage:    "--end-block 2000",
+	Required: true,
+}
+
+var repairFlag = &cli.BoolFlag{
+	Name:  "repair",
+	Usage: "--repair, removes extra and mismatched data and indexes missing data again",
+}
+
+// verifyCommand compares the data indexed for a contract against the chain.
+var verifyCommand = &cli.Command{
+	Name:        "verify",
+	Description: "verifies the logs, receipts and transactions indexed for a contract in a block range against the chain",
+	Flags:       []cli.Flag{configFlag, dbFlag, pathFlag, chainIDFlag, addressFlag, startBlockFlag, endBlockFlag, confirmationsFlag, repairFlag, skipMigrationServerFlag},
+	Action: func(c *cli.Context) error {
+		scribeConfig, err := config.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return fmt.Errorf("could not decode config: %w", err)
+		}
+		chainID := uint32(c.Uint(chainIDFlag.Name))
+		var chainConfig *config.ChainConfig
+		for i := range scribeConfig.Chains {
+			if scribeConfig.Chains[i].ChainID == chainID {
+				chainConfig = &scribeConfig.Chains[i]
+			}
+		}
+		if chainConfig == nil {
+			return fmt.Errorf("chain %d is not in the config", chainID)
+		}
+
+		eventDB, err := api.InitDB(c.Context, c.String(dbFlag.Name), c.String(pathFlag.Name), metrics.Get(), c.Bool(skipMigrationServerFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not initialize database: %w", err)
+		}
+
+		// Data is verified against blocks with enough confirmations to be final.
+		rpcURL := fmt.Sprintf("%s/%d/rpc/%d", scribeConfig.RPCURL, c.Uint(confirmationsFlag.Name), chainID)
+		client, err := backend.DialBackend(c.Context, rpcURL, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not start client for %s: %w", rpcURL, err)
+		}
+
+		logVerifier, err := verifier.NewVerifier(eventDB, []backend.ScribeBackend{client}, *chainConfig, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not create verifier: %w", err)
+		}
+
+		address := common.HexToAddress(c.String(addressFlag.Name))
+		report, err := logVerifier.Verify(c.Context, address, c.Uint64(startBlockFlag.Name), c.Uint64(endBlockFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not verify: %w", err)
+		}
+		printReport(report)
+
+		if report.Discrepancies() == 0 {
+			return nil
+		}
+		if !c.Bool(repairFlag.Name) {
+			return fmt.Errorf("found %d discrepancies", report.Discrepancies())
+		}
+
+		err = logVerifier.Repair(c.Context, report)
+		if err != nil {
+			return fmt.Errorf("could not repair: %w", err)
+		}
+		report, err = logVerifier.Verify(c.Context, address, report.StartBlock, report.EndBlock)
+		if err != nil {
+			return fmt.Errorf("could not verify repair: %w", err)
+		}
+		fmt.Println("after repair:")
+		printReport(report)
+		if report.Discrepancies() > 0 {
+			return fmt.Errorf("%d discrepancies remain after repair", report.Discrepancies())
+		}
+		return nil
+	},
+}
+
+// printReport prints the discrepancies found by a verification.
+func printReport(report *verifier.Report) {
+	fmt.Printf("verified %s on chain %d from block %d to %d: %d discrepancies\n", report.ContractAddress, report.ChainID, report.StartBlock, report.EndBlock, report.Discrepancies())
+
+	logNames := []string{"missing log", "extra log", "mismatched log"}
+	for i, logs := range [][]types.Log{report.MissingLogs, report.ExtraLogs, report.MismatchedLogs} {
+		for _, log := range logs {
+			fmt.Printf("%s: block %d, tx %s, index %d\n", logNames[i], log.BlockNumber, log.TxHash, log.Index)
+		}
+	}
+
+	txNames := []string{"missing receipt", "extra receipt", "mismatched receipt", "missing tx", "extra tx", "mismatched tx"}
+	for i, txs := range [][]verifier.Tx{report.MissingReceipts, report.ExtraReceipts, report.MismatchedReceipts, report.MissingTxs, report.ExtraTxs, report.MismatchedTxs} {
+		for _, tx := range txs {
+			fmt.Printf("%s: block %d, tx %s\n", txNames[i], tx.BlockNumber, tx.Hash)
+		}
+	}
+}
+
+var exportAddressFlag = &cli.StringFlag{
+	Name:  "address",
+	Usage: "--address 0x..., only exports the logs of a contract along with their receipts, transactions and block times",
+}
+
+var exportConfigFlag = &cli.StringFlag{
+	Name:      "config",
+	Usage:     "--config /Users/synapsecns/config.yaml, the config the chain is indexed with, needed to export a chain without --address",
+	TakesFile: true,
+}
+
+var formatFlag = &cli.StringFlag{
+	Name:  "format",
+	Usage: "--format <parquet> or <csv>",
+	Value: string(exporter.Parquet),
+}
+
+var partitionSizeFlag = &cli.Uint64Flag{
+	Name:  "partition-size",
+	Usage: "--partition-size 10000, the number of blocks exported to each file",
+	Value: exporter.DefaultPartitionSize,
+}
+
+var skipHexFieldsFlag = &cli.BoolFlag{
+	Name:  "skip-hex-fields",
+	Usage: "--skip-hex-fields, leaves the log data, logs bloom and transaction input columns empty",
+}
+
+// exportCommand exports the data indexed for a chain into partitioned files.
+var exportCommand = &cli.Command{
+	Name:        "export",
+	Description: "exports the logs, receipts, transactions and block times indexed for a chain or contract in a block range to parquet or csv files",
+	Flags: []cli.Flag{exportConfigFlag, dbFlag, pathFlag, chainIDFlag, exportAddressFlag, startBlockFlag, endBlockFlag, formatFlag,
+		partitionSizeFlag, skipHexFieldsFlag, outputPathFlag, skipMigrationServerFlag},
+	Action: func(c *cli.Context) error {
+		eventDB, err := api.InitDB(c.Context, c.String(dbFlag.Name), c.String(pathFlag.Name), metrics.Get(), c.Bool(skipMigrationServerFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not initialize database: %w", err)
+		}
+
+		exportConfig := exporter.Config{
+			ChainID:       uint32(c.Uint(chainIDFlag.Name)),
+			StartBlock:    c.Uint64(startBlockFlag.Name),
+			EndBlock:      c.Uint64(endBlockFlag.Name),
+			PartitionSize: c.Uint64(partitionSizeFlag.Name),
+			Format:        exporter.Format(c.String(formatFlag.Name)),
+			SkipHexFields: c.Bool(skipHexFieldsFlag.Name),
+			OutputDir:     core.ExpandOrReturnPath(c.String(outputPathFlag.Name)),
+		}
+		if c.String(exportAddressFlag.Name) != "" {
+			address := common.HexToAddress(c.String(exportAddressFlag.Name))
+			exportConfig.ContractAddress = &address
+		} else {
+			exportConfig.Contracts, err = chainContracts(c.Context, eventDB, c.String(exportConfigFlag.Name), exportConfig.ChainID)
+			if err != nil {
+				return err
+			}
+		}
+
+		dataExporter, err := exporter.NewExporter(eventDB, exportConfig)
+		if err != nil {
+			return fmt.Errorf("could not create exporter: %w", err)
+		}
+		report, err := dataExporter.Export(c.Context)
+		if err != nil {
+			return fmt.Errorf("could not export: %w", err)
+		}
+		fmt.Printf("exported %d partitions (%d already exported, %d not indexed yet): %d logs, %d receipts, %d transactions, %d block times\n",
+			report.Partitions, report.Skipped, report.Pending, report.Logs, report.Receipts, report.Transactions, report.BlockTimes)
+		return nil
+	},
+}
+
+// chainContracts gets the contracts indexed on a chain: those in its config, its factories and their discovered children.
+func chainContracts(ctx context.Context, eventDB db.EventDB, configPath string, chainID uint32) ([]common.Address, error) {
+	if configPath == "" {
+		return nil, fmt.Errorf("a config is needed to export a chain")
+	}
+	scribeConfig, err := config.DecodeConfig(core.ExpandOrReturnPath(configPath))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode config: %w", err)
+	}
+
+	var contracts []common.Address
+	for _, chainConfig := range scribeConfig.Chains {
+		if chainConfig.ChainID != chainID {
+			continue
+		}
+		for _, contract := range chainConfig.Contracts {
+			contracts = append(contracts, common.HexToAddress(contract.Address))
+		}
+		for _, factory := range chainConfig.Factories {
+			contracts = append(contracts, common.HexToAddress(factory.Address))
+		}
+	}
+	if len(contracts) == 0 {
+		return nil, fmt.Errorf("chain %d has no contracts in the config", chainID)
+	}
+
+	children, err := eventDB.RetrieveFactoryChildren(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve factory children: %w", err)
+	}
+	for _, child := range children {
+		contracts = append(contracts, child.ContractAddress)
+	}
+	return contracts, nil
+}
+
+// migrateCommand runs versioned migrations on the scribe database.
+var migrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{dbFlag, pathFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		dbType, err := dbcommon.DBTypeFromString(c.String(dbFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		// only mysql and postgres tables are prefixed
+		var namingStrategy schema.Namer = schema.NamingStrategy{}
+		switch dbType {
+		case dbcommon.Mysql:
+			namingStrategy = mysql.NamingStrategy
+		case dbcommon.Postgres:
+			namingStrategy = postgres.NamingStrategy
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, core.ExpandOrReturnPath(c.String(pathFlag.Name)), dbcommon.SqliteFileName, namingStrategy)
+	},
+})
+
 func init() {
 	ports, err := freeport.Take(1)
 	if len(ports) > 0 && err != nil {
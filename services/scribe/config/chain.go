@@ -14,6 +14,11 @@ type ChainConfig struct {
 	ChainID uint32 `yaml:"chain_id"`
 	// Contracts stores all the contract information for the chain.
 	Contracts ContractConfigs `yaml:"contracts"`
+	// Factories stores the factory contracts whose children are discovered and indexed automatically.
+	Factories FactoryConfigs `yaml:"factories"`
+	// Deployments is an optional hardhat deployments directory. The ABIs of contracts deployed on the chain are
+	// used to decode their logs.
+	Deployments string `yaml:"deployments"`
 	// GetLogsRange is the max number of blocks to request in a single getLogs request.
 	GetLogsRange uint64 `yaml:"get_logs_range"`
 	// GetLogsBatchAmount is the number of getLogs requests to include in a single batch request.
@@ -32,6 +37,11 @@ type ChainConfig struct {
 	LivefillRange uint64 `yaml:"livefill_range"`
 	// LivefillFlushInterval is how long to wait before flushing the livefill indexer db (in seconds)
 	LivefillFlushInterval uint64 `yaml:"livefill_flush_interval"`
+	// ReorgCheckDepth is the number of blocks behind the last indexed block to verify against the chain for reorgs.
+	// Reorg checking is disabled when this is 0.
+	ReorgCheckDepth uint64 `yaml:"reorg_check_depth"`
+	// ReorgCheckInterval is how long to wait between reorg checks (in seconds).
+	ReorgCheckInterval uint64 `yaml:"reorg_check_interval"`
 }
 
 // ChainConfigs contains an array of ChainConfigs.
@@ -66,6 +76,9 @@ func (c ChainConfig) IsValid() (ok bool, err error) {
 	if ok, err = c.Contracts.IsValid(); !ok {
 		return false, err
 	}
+	if ok, err = c.Factories.IsValid(); !ok {
+		return false, err
+	}
 
 	return true, nil
 }
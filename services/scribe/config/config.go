@@ -18,6 +18,8 @@ type Config struct {
 	RPCURL string `yaml:"rpc_url"`
 	// Verbose is used to enable verbose logging.
 	Verbose bool `yaml:"verbose"`
+	// Sinks are the outputs newly confirmed logs are delivered to.
+	Sinks SinkConfigs `yaml:"sinks"`
 }
 
 // IsValid makes sure the config is valid. This is done by calling IsValid() on each
@@ -30,6 +32,9 @@ func (c *Config) IsValid() (ok bool, err error) {
 	if c.RPCURL == "" {
 		return false, fmt.Errorf("%w: rpc url cannot be empty", ErrRequiredField)
 	}
+	if ok, err = c.Sinks.IsValid(); !ok {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -54,3 +59,35 @@ func DecodeConfig(filePath string) (cfg Config, err error) {
 	}
 	return cfg, nil
 }
+
+// Save writes the config to a file. The file is replaced atomically so a failed write doesn't corrupt it.
+func (c Config) Save(filePath string) error {
+	output, err := c.Encode()
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*")
+	if err != nil {
+		return fmt.Errorf("could not create temp file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	_, err = tmpFile.Write(output)
+	if err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("could not write config: %w", err)
+	}
+	err = tmpFile.Close()
+	if err != nil {
+		return fmt.Errorf("could not close config: %w", err)
+	}
+
+	err = os.Rename(tmpFile.Name(), filePath)
+	if err != nil {
+		return fmt.Errorf("could not replace config: %w", err)
+	}
+	return nil
+}
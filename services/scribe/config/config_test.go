@@ -50,3 +50,30 @@ func (c ConfigSuite) TestConfigEncodeDecode() {
 	True(c.T(), ok)
 	Nil(c.T(), err)
 }
+
+func (c ConfigSuite) TestConfigSave() {
+	testConfig := config.Config{
+		Chains: config.ChainConfigs{
+			config.ChainConfig{
+				ChainID: gofakeit.Uint32(),
+				Contracts: config.ContractConfigs{
+					config.ContractConfig{
+						Address:    etherMocks.MockAddress().String(),
+						StartBlock: gofakeit.Uint64(),
+					},
+				},
+			},
+		},
+		RPCURL: gofakeit.URL(),
+	}
+
+	// Saving replaces the existing config.
+	file := filet.TmpFile(c.T(), "", "chains: []")
+	Nil(c.T(), testConfig.Save(file.Name()))
+
+	decodedConfig, err := config.DecodeConfig(file.Name())
+	Nil(c.T(), err)
+	Equal(c.T(), testConfig.RPCURL, decodedConfig.RPCURL)
+	Equal(c.T(), testConfig.Chains[0].ChainID, decodedConfig.Chains[0].ChainID)
+	Equal(c.T(), testConfig.Chains[0].Contracts[0].Address, decodedConfig.Chains[0].Contracts[0].Address)
+}
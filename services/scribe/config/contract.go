@@ -5,6 +5,7 @@
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/richardwilkes/toolbox/collection"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
 )
 
 // ContractConfig defines the config for a specific contract.
@@ -17,6 +18,15 @@ type ContractConfig struct {
 	EndBlock uint64 `yaml:"end_block"`
 	// RefreshRate is the rate at which the contract is refreshed.
 	RefreshRate uint64 `yaml:"refresh_rate"`
+	// Topics optionally restricts the events indexed for the contract. Each position lists the accepted values for
+	// that topic: event signatures or topic0 hashes first, followed by indexed argument values. Empty positions match any value.
+	Topics [][]string `yaml:"topics"`
+	// ABI is an optional path to the contract's ABI, either a JSON ABI or a build artifact with an `abi` field.
+	// Logs are decoded with it when stored. It takes precedence over the chain's deployments.
+	ABI string `yaml:"abi"`
+	// Traces enables indexing of the internal calls made by transactions that touch the contract.
+	// It requires the chain's RPC to support debug_traceTransaction with the callTracer.
+	Traces bool `yaml:"traces"`
 }
 
 // ContractConfigs contains a list of ContractConfigs.
@@ -53,5 +63,8 @@ func (c ContractConfig) IsValid() (ok bool, err error) {
 	if len(c.Address) != (common.AddressLength*2)+2 {
 		return false, fmt.Errorf("address not correct length: %w", ErrAddressLength)
 	}
+	if _, err := scribeTypes.ParseTopics(c.Topics); err != nil {
+		return false, fmt.Errorf("%w for %s: %v", ErrInvalidTopics, c.Address, err)
+	}
 	return true, nil
 }
@@ -49,3 +49,26 @@ func (c ConfigSuite) TestContractConfigDuplicateAddress() {
 	False(c.T(), ok)
 	ErrorIs(c.T(), err, config.ErrDuplicateAddress)
 }
+
+func (c ConfigSuite) TestTopics() {
+	contractConfig := contractConfigFixture()
+	contractConfig.Topics = [][]string{
+		{"Transfer(address,address,uint256)", "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		{},
+		{mocks.MockAddress().String()},
+	}
+
+	ok, err := contractConfig.IsValid()
+	True(c.T(), ok)
+	Nil(c.T(), err)
+
+	contractConfig.Topics = [][]string{{"0x1234"}}
+	ok, err = contractConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrInvalidTopics)
+
+	contractConfig.Topics = [][]string{{}, {}, {}, {}, {}}
+	ok, err = contractConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrInvalidTopics)
+}
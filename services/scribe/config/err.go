@@ -16,3 +16,15 @@
 
 // ErrAddressLength indicates that an invalid address length is found.
 var ErrAddressLength = errors.New("invalid address length")
+
+// ErrInvalidTopics indicates that a contract's topic filter is invalid.
+var ErrInvalidTopics = errors.New("invalid topics")
+
+// ErrInvalidFactoryEvent indicates that a factory's creation event is invalid.
+var ErrInvalidFactoryEvent = errors.New("invalid factory event")
+
+// ErrDuplicateSinkName indicates that two sinks share a name.
+var ErrDuplicateSinkName = errors.New("duplicate sink name")
+
+// ErrInvalidSinkType indicates that a sink's type is not supported.
+var ErrInvalidSinkType = errors.New("invalid sink type")
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/richardwilkes/toolbox/collection"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+// FactoryConfig defines a factory contract whose children are discovered and indexed automatically.
+type FactoryConfig struct {
+	// Address is the address of the factory contract.
+	Address string `yaml:"address"`
+	// StartBlock is the block number to start indexing the factory from.
+	StartBlock uint64 `yaml:"start_block"`
+	// Event is the signature of the event emitted when a child is created, with indexed parameters marked,
+	// e.g. "PairCreated(address indexed token0, address indexed token1, address pair, uint256)".
+	Event string `yaml:"event"`
+	// ChildArgument is the (zero based) index of the event parameter holding the child contract address.
+	ChildArgument int `yaml:"child_argument"`
+}
+
+// FactoryConfigs contains a list of FactoryConfigs.
+type FactoryConfigs []FactoryConfig
+
+// IsValid validates the factory configs by asserting no two factories appear twice.
+// It also calls IsValid on each individual FactoryConfig.
+func (f FactoryConfigs) IsValid() (ok bool, err error) {
+	addressSet := collection.Set[string]{}
+
+	for _, cfg := range f {
+		address := common.HexToAddress(cfg.Address).String()
+		if addressSet.Contains(address) {
+			return false, fmt.Errorf("duplicate factory address %s was found: %w", address, ErrDuplicateAddress)
+		}
+
+		ok, err = cfg.IsValid()
+		if !ok {
+			return false, err
+		}
+
+		addressSet.Add(address)
+	}
+
+	return true, nil
+}
+
+// IsValid validates the factory config.
+func (f FactoryConfig) IsValid() (ok bool, err error) {
+	if f.Address == "" {
+		return false, fmt.Errorf("field Address: %w", ErrRequiredField)
+	}
+	// the `+2` is for the 0x prefix
+	if len(f.Address) != (common.AddressLength*2)+2 {
+		return false, fmt.Errorf("address not correct length: %w", ErrAddressLength)
+	}
+	if _, err = f.CreationEvent(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreationEvent parses the factory's creation event, checking that the child argument is an address.
+func (f FactoryConfig) CreationEvent() (abi.Event, error) {
+	event, err := scribeTypes.ParseEvent(f.Event)
+	if err != nil {
+		return abi.Event{}, fmt.Errorf("%w for factory %s: %v", ErrInvalidFactoryEvent, f.Address, err)
+	}
+	if f.ChildArgument < 0 || f.ChildArgument >= len(event.Inputs) {
+		return abi.Event{}, fmt.Errorf("%w for factory %s: child argument %d out of range", ErrInvalidFactoryEvent, f.Address, f.ChildArgument)
+	}
+	if event.Inputs[f.ChildArgument].Type.T != abi.AddressTy {
+		return abi.Event{}, fmt.Errorf("%w for factory %s: child argument %d is not an address", ErrInvalidFactoryEvent, f.Address, f.ChildArgument)
+	}
+	return event, nil
+}
@@ -0,0 +1,68 @@
+package config_test
+
+import (
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+)
+
+func factoryConfigFixture() config.FactoryConfig {
+	return config.FactoryConfig{
+		Address:       mocks.MockAddress().String(),
+		StartBlock:    gofakeit.Uint64(),
+		Event:         "PairCreated(address indexed token0, address indexed token1, address pair, uint256)",
+		ChildArgument: 2,
+	}
+}
+
+func (c ConfigSuite) TestFactoryConfig() {
+	factoryConfig := factoryConfigFixture()
+
+	ok, err := factoryConfig.IsValid()
+	True(c.T(), ok)
+	Nil(c.T(), err)
+
+	event, err := factoryConfig.CreationEvent()
+	Nil(c.T(), err)
+	Equal(c.T(), "PairCreated(address,address,address,uint256)", event.Sig)
+	Equal(c.T(), "pair", event.Inputs[2].Name)
+
+	// The child argument must be an address.
+	factoryConfig.ChildArgument = 3
+	ok, err = factoryConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrInvalidFactoryEvent)
+
+	factoryConfig.ChildArgument = 4
+	ok, err = factoryConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrInvalidFactoryEvent)
+
+	factoryConfig = factoryConfigFixture()
+	factoryConfig.Event = "PairCreated(address indexed, address indexed, address indexed, address indexed)"
+	ok, err = factoryConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrInvalidFactoryEvent)
+
+	factoryConfig.Event = "PairCreated"
+	ok, err = factoryConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrInvalidFactoryEvent)
+
+	factoryConfig = factoryConfigFixture()
+	factoryConfig.Address = ""
+	ok, err = factoryConfig.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrRequiredField)
+}
+
+func (c ConfigSuite) TestFactoryConfigDuplicateAddress() {
+	factoryConfigA := factoryConfigFixture()
+	factoryConfigB := factoryConfigFixture()
+	factoryConfigB.Address = factoryConfigA.Address
+
+	ok, err := config.FactoryConfigs{factoryConfigA, factoryConfigB}.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrDuplicateAddress)
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/richardwilkes/toolbox/collection"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+// SinkType is the type of output a sink delivers logs to.
+type SinkType string
+
+const (
+	// WebhookSink posts batches of logs to an HTTP endpoint.
+	WebhookSink SinkType = "webhook"
+	// FileSink appends logs to rotating JSONL files.
+	FileSink SinkType = "file"
+)
+
+// SinkConfig defines an output that newly confirmed logs and reorg removals are delivered to.
+type SinkConfig struct {
+	// Name identifies the sink. Its delivery cursors and dead letters are stored under it, so it must not change.
+	Name string `yaml:"name"`
+	// Type is the type of the sink, either webhook or file.
+	Type SinkType `yaml:"type"`
+	// ChainIDs optionally restricts the sink to logs of the given chains.
+	ChainIDs []uint32 `yaml:"chain_ids"`
+	// ContractAddresses optionally restricts the sink to logs of the given contracts.
+	ContractAddresses []string `yaml:"contract_addresses"`
+	// Topics optionally restricts the sink to logs matching a topic filter, in the same format as a contract's topics.
+	Topics [][]string `yaml:"topics"`
+	// StartBlock is the first block delivered the first time the sink is started. If it is not set, only logs indexed
+	// after the sink is first started are delivered.
+	StartBlock uint64 `yaml:"start_block"`
+	// BatchSize is the max number of logs delivered at once. Defaults to 100.
+	BatchSize int `yaml:"batch_size"`
+	// MaxAttempts is the number of times a failed batch is retried before it is dead lettered. Defaults to 5.
+	MaxAttempts int `yaml:"max_attempts"`
+	// URL is the endpoint batches are posted to by webhook sinks.
+	URL string `yaml:"url"`
+	// Headers are extra headers set on webhook requests, e.g. for authorization.
+	Headers map[string]string `yaml:"headers"`
+	// Timeout is the timeout of a webhook request in seconds. Defaults to 10.
+	Timeout uint64 `yaml:"timeout"`
+	// Path is the directory file sinks write to.
+	Path string `yaml:"path"`
+	// MaxFileSize is the size in bytes after which a file sink starts a new file. Defaults to 100MB.
+	MaxFileSize int64 `yaml:"max_file_size"`
+}
+
+// SinkConfigs contains a list of SinkConfigs.
+type SinkConfigs []SinkConfig
+
+// IsValid validates the sink configs by asserting no two sinks share a name.
+// It also calls IsValid on each individual SinkConfig.
+func (s SinkConfigs) IsValid() (ok bool, err error) {
+	nameSet := collection.Set[string]{}
+
+	for _, cfg := range s {
+		if nameSet.Contains(cfg.Name) {
+			return false, fmt.Errorf("duplicate sink name %s was found: %w", cfg.Name, ErrDuplicateSinkName)
+		}
+
+		ok, err = cfg.IsValid()
+		if !ok {
+			return false, err
+		}
+
+		nameSet.Add(cfg.Name)
+	}
+
+	return true, nil
+}
+
+// IsValid validates the sink config.
+func (s SinkConfig) IsValid() (ok bool, err error) {
+	if s.Name == "" {
+		return false, fmt.Errorf("field Name: %w", ErrRequiredField)
+	}
+	switch s.Type {
+	case WebhookSink:
+		if s.URL == "" {
+			return false, fmt.Errorf("field URL of sink %s: %w", s.Name, ErrRequiredField)
+		}
+	case FileSink:
+		if s.Path == "" {
+			return false, fmt.Errorf("field Path of sink %s: %w", s.Name, ErrRequiredField)
+		}
+	default:
+		return false, fmt.Errorf("%w %q for sink %s", ErrInvalidSinkType, s.Type, s.Name)
+	}
+	for _, address := range s.ContractAddresses {
+		// the `+2` is for the 0x prefix
+		if len(address) != (common.AddressLength*2)+2 {
+			return false, fmt.Errorf("address %s of sink %s not correct length: %w", address, s.Name, ErrAddressLength)
+		}
+	}
+	if _, err := scribeTypes.ParseTopics(s.Topics); err != nil {
+		return false, fmt.Errorf("%w for sink %s: %v", ErrInvalidTopics, s.Name, err)
+	}
+	return true, nil
+}
+
+// MatchesChain checks if the sink delivers the logs of a chain.
+func (s SinkConfig) MatchesChain(chainID uint32) bool {
+	if len(s.ChainIDs) == 0 {
+		return true
+	}
+	for _, sinkChainID := range s.ChainIDs {
+		if sinkChainID == chainID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package config_test
+
+import (
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+)
+
+func (c ConfigSuite) TestSinkConfig() {
+	webhookSink := config.SinkConfig{Name: "hook", Type: config.WebhookSink, URL: "http://localhost:8080", ChainIDs: []uint32{1}}
+	fileSink := config.SinkConfig{
+		Name:              "file",
+		Type:              config.FileSink,
+		Path:              "./sinks",
+		ContractAddresses: []string{mocks.MockAddress().String()},
+		Topics:            [][]string{{"Transfer(address,address,uint256)"}},
+	}
+
+	ok, err := config.SinkConfigs{webhookSink, fileSink}.IsValid()
+	True(c.T(), ok)
+	Nil(c.T(), err)
+	True(c.T(), webhookSink.MatchesChain(1))
+	False(c.T(), webhookSink.MatchesChain(2))
+	True(c.T(), fileSink.MatchesChain(2))
+
+	ok, err = config.SinkConfigs{webhookSink, webhookSink}.IsValid()
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrDuplicateSinkName)
+
+	invalidSink := webhookSink
+	invalidSink.URL = ""
+	_, err = invalidSink.IsValid()
+	ErrorIs(c.T(), err, config.ErrRequiredField)
+
+	invalidSink = fileSink
+	invalidSink.Type = "kafka"
+	_, err = invalidSink.IsValid()
+	ErrorIs(c.T(), err, config.ErrInvalidSinkType)
+
+	invalidSink = fileSink
+	invalidSink.ContractAddresses = []string{"0x1234"}
+	_, err = invalidSink.IsValid()
+	ErrorIs(c.T(), err, config.ErrAddressLength)
+
+	invalidSink = fileSink
+	invalidSink.Topics = [][]string{{"Transfer"}}
+	_, err = invalidSink.IsValid()
+	ErrorIs(c.T(), err, config.ErrInvalidTopics)
+}
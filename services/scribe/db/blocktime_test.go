@@ -29,6 +29,10 @@ func (t *DBSuite) TestStoreRetrieveBlockTime() {
 			Equal(t.T(), retrievedBlockTimeB, blockTime+(i*2))
 		}
 
+		blockTimesA, err := testDB.RetrieveBlockTimesInRange(t.GetTestContext(), chainIDA, 3, 5)
+		Nil(t.T(), err)
+		Equal(t.T(), map[uint64]uint64{3: blockTime + 3, 4: blockTime + 4, 5: blockTime + 5}, blockTimesA)
+
 		lastBlockA, err := testDB.RetrieveLastBlockStored(t.GetTestContext(), chainIDA)
 		Nil(t.T(), err)
 		Equal(t.T(), lastBlockA, uint64(9))
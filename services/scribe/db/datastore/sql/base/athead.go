@@ -62,17 +62,17 @@ func (s Store) StoreLogsAtHead(ctx context.Context, chainID uint32, logs ...type
 	}
 
 	dbTx := s.DB().WithContext(ctx)
-	if s.db.Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.db.Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		}).Create(&storeLogs)
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns: []clause.Column{
 				{Name: ContractAddressFieldName}, {Name: ChainIDFieldName}, {Name: TxHashFieldName}, {Name: BlockIndexFieldName},
 			},
 			DoNothing: true,
 		}).CreateInBatches(&storeLogs, 10)
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		}).Create(&storeLogs)
 	}
 
 	if dbTx.Error != nil {
@@ -85,15 +85,15 @@ func (s Store) StoreLogsAtHead(ctx context.Context, chainID uint32, logs ...type
 // StoreReceiptAtHead stores a receipt.
 func (s Store) StoreReceiptAtHead(ctx context.Context, chainID uint32, receipt types.Receipt) error {
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
 		})
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		})
 	}
 	dbTx = dbTx.Create(&ReceiptAtHead{
 		ChainID:           chainID,
@@ -126,15 +126,15 @@ func (s Store) StoreEthTxAtHead(ctx context.Context, tx *types.Transaction, chai
 		return fmt.Errorf("could not marshall tx to binary: %w", err)
 	}
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
 		})
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		})
 	}
 
 	dbTx = dbTx.Create(&EthTxAtHead{
@@ -171,12 +171,19 @@ func (s Store) RetrieveLogsFromHeadRangeQuery(ctx context.Context, logFilter db.
 	}
 	queryFilter := logFilterToQuery(logFilter)
 
+	// Filters are validated up front since query errors can't be returned from ToSQL.
+	if _, err = withMultiValueFilters(s.DB(), logFilter); err != nil {
+		return nil, err
+	}
+
 	var dbLogs []Log
 	subQuery1 := s.DB().WithContext(ctx).ToSQL(func(tx *gorm.DB) *gorm.DB {
-		return tx.Model(Log{}).Select("*").Where("block_number BETWEEN ? AND ?", startBlock, lastIndexed).Where(queryFilter).Find(&[]Log{})
+		tx, _ = withMultiValueFilters(tx.Model(Log{}), logFilter)
+		return tx.Select("*").Where("block_number BETWEEN ? AND ?", startBlock, lastIndexed).Where(queryFilter).Find(&[]Log{})
 	})
 	subQuery2 := s.DB().WithContext(ctx).ToSQL(func(tx *gorm.DB) *gorm.DB {
-		return tx.Model(LogAtHead{}).Select(LogColumns).Where("block_number BETWEEN ? AND ?", lastIndexed+1, endBlock).Where(queryFilter).Find(&[]Log{})
+		tx, _ = withMultiValueFilters(tx.Model(LogAtHead{}), logFilter)
+		return tx.Select(LogColumns).Where("block_number BETWEEN ? AND ?", lastIndexed+1, endBlock).Where(queryFilter).Find(&[]Log{})
 	})
 	query := fmt.Sprintf("SELECT * FROM (%s UNION %s) AS unionedTable ORDER BY %s DESC, %s DESC LIMIT %d OFFSET %d", subQuery1, subQuery2, BlockNumberFieldName, BlockIndexFieldName, PageSize, (page-1)*PageSize)
 	dbTx := s.DB().WithContext(ctx).Raw(query).Scan(&dbLogs)
@@ -3,21 +3,22 @@
 import (
 	"context"
 	"fmt"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"gorm.io/gorm/clause"
 )
 
 // StoreBlockTime stores a block time for a chain.
 func (s Store) StoreBlockTime(ctx context.Context, chainID uint32, blockNumber, timestamp uint64) error {
 	dbTx := s.DB().WithContext(ctx)
-	if s.db.Dialector.Name() == "sqlite" {
+	if s.db.Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: BlockNumberFieldName}},
 			DoNothing: true,
 		})
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		})
 	}
 	dbTx = dbTx.Create(&BlockTime{
 		ChainID:     chainID,
@@ -48,6 +49,26 @@ func (s Store) RetrieveBlockTime(ctx context.Context, chainID uint32, blockNumbe
 	return blockTime.Timestamp, nil
 }
 
+// RetrieveBlockTimesInRange retrieves the stored block times of a chain within a range, keyed by block number.
+func (s Store) RetrieveBlockTimesInRange(ctx context.Context, chainID uint32, startBlock, endBlock uint64) (map[uint64]uint64, error) {
+	var dbBlockTimes []BlockTime
+	dbTx := s.DB().WithContext(ctx).
+		Model(&BlockTime{}).
+		Where(&BlockTime{ChainID: chainID}).
+		Where(fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName), startBlock, endBlock).
+		Find(&dbBlockTimes)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve block times: %w", dbTx.Error)
+	}
+
+	blockTimes := make(map[uint64]uint64, len(dbBlockTimes))
+	for _, blockTime := range dbBlockTimes {
+		blockTimes[blockTime.BlockNumber] = blockTime.Timestamp
+	}
+
+	return blockTimes, nil
+}
+
 // RetrieveLastBlockStored retrieves the last block number that has a stored block time.
 func (s Store) RetrieveLastBlockStored(ctx context.Context, chainID uint32) (uint64, error) {
 	var blockTime uint64
@@ -0,0 +1,202 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxArgValueLength is the max length of an argument value that can be filtered on. Longer values (e.g. dynamic
+// strings or arrays) are only kept in the decoded log's args.
+const maxArgValueLength = 191
+
+// decodedLogKey gets the key of a decoded log.
+func decodedLogKey(chainID uint32, txHash common.Hash, index uint) string {
+	return fmt.Sprintf("%d-%s-%d", chainID, txHash.String(), index)
+}
+
+// StoreDecodedLogs stores logs decoded with their contract's ABI. Previously stored decoded logs are left unchanged.
+func (s Store) StoreDecodedLogs(ctx context.Context, chainID uint32, logs ...db.DecodedLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	var decodedLogs []DecodedLog
+	var decodedArgs []DecodedArg
+	for _, log := range logs {
+		args, err := json.Marshal(log.Args)
+		if err != nil {
+			return fmt.Errorf("could not encode decoded args: %w", err)
+		}
+
+		logKey := decodedLogKey(chainID, log.Log.TxHash, log.Log.Index)
+		decodedLogs = append(decodedLogs, DecodedLog{
+			LogKey:          logKey,
+			ChainID:         chainID,
+			EventName:       log.EventName,
+			ContractAddress: log.Log.Address.String(),
+			TxHash:          log.Log.TxHash.String(),
+			BlockNumber:     log.Log.BlockNumber,
+			BlockHash:       log.Log.BlockHash.String(),
+			BlockIndex:      uint64(log.Log.Index),
+			Args:            string(args),
+		})
+
+		for name, value := range log.Args {
+			if len(value) > maxArgValueLength {
+				continue
+			}
+			decodedArgs = append(decodedArgs, DecodedArg{
+				LogKey:    logKey,
+				ArgName:   name,
+				ArgValue:  value,
+				ChainID:   chainID,
+				BlockHash: log.Log.BlockHash.String(),
+			})
+		}
+	}
+
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.insertIgnore(tx, LogKeyFieldName).CreateInBatches(&decodedLogs, PageSize).Error; err != nil {
+			return fmt.Errorf("could not store decoded logs: %w", err)
+		}
+		if len(decodedArgs) == 0 {
+			return nil
+		}
+		if err := s.insertIgnore(tx, LogKeyFieldName, ArgNameFieldName).CreateInBatches(&decodedArgs, PageSize).Error; err != nil {
+			return fmt.Errorf("could not store decoded args: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not store decoded logs: %w", err)
+	}
+
+	return nil
+}
+
+// insertIgnore ignores inserts that conflict with existing rows on the given columns.
+func (s Store) insertIgnore(tx *gorm.DB, columns ...string) *gorm.DB {
+	if s.db.Dialector.Name() == dbcommon.Mysql.String() {
+		return tx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	}
+
+	conflictColumns := make([]clause.Column, len(columns))
+	for i, column := range columns {
+		conflictColumns[i] = clause.Column{Name: column}
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   conflictColumns,
+		DoNothing: true,
+	})
+}
+
+// RetrieveDecodedLogsWithFilter retrieves decoded logs that match a filter given a page, latest first.
+func (s Store) RetrieveDecodedLogsWithFilter(ctx context.Context, decodedLogFilter db.DecodedLogFilter, page int) ([]db.DecodedLog, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&DecodedLog{}).
+		Where(&DecodedLog{
+			ChainID:         decodedLogFilter.ChainID,
+			ContractAddress: decodedLogFilter.ContractAddress,
+			EventName:       decodedLogFilter.EventName,
+		})
+	if decodedLogFilter.StartBlock > 0 {
+		dbTx = dbTx.Where(fmt.Sprintf("%s >= ?", BlockNumberFieldName), decodedLogFilter.StartBlock)
+	}
+	if decodedLogFilter.EndBlock > 0 {
+		dbTx = dbTx.Where(fmt.Sprintf("%s <= ?", BlockNumberFieldName), decodedLogFilter.EndBlock)
+	}
+
+	// Sort the argument names so the query is deterministic.
+	argNames := make([]string, 0, len(decodedLogFilter.Args))
+	for name := range decodedLogFilter.Args {
+		argNames = append(argNames, name)
+	}
+	sort.Strings(argNames)
+	for _, name := range argNames {
+		argQuery := s.DB().
+			Model(&DecodedArg{}).
+			Select(LogKeyFieldName).
+			Where(fmt.Sprintf("%s = ? AND %s = ?", ArgNameFieldName, ArgValueFieldName), name, scribeTypes.NormalizeArgValue(decodedLogFilter.Args[name]))
+		dbTx = dbTx.Where(fmt.Sprintf("%s IN (?)", LogKeyFieldName), argQuery)
+	}
+
+	var dbDecodedLogs []DecodedLog
+	dbTx = dbTx.
+		Order(fmt.Sprintf("%s desc, %s desc", BlockNumberFieldName, BlockIndexFieldName)).
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Find(&dbDecodedLogs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve decoded logs: %w", dbTx.Error)
+	}
+	if len(dbDecodedLogs) == 0 {
+		return []db.DecodedLog{}, nil
+	}
+
+	logs, err := s.retrieveDecodedLogsLogs(ctx, decodedLogFilter.ChainID, dbDecodedLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedLogs := make([]db.DecodedLog, 0, len(dbDecodedLogs))
+	for _, dbDecodedLog := range dbDecodedLogs {
+		log, ok := logs[dbDecodedLog.LogKey]
+		if !ok {
+			continue
+		}
+
+		args := make(map[string]string)
+		err = json.Unmarshal([]byte(dbDecodedLog.Args), &args)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode args of %s: %w", dbDecodedLog.LogKey, err)
+		}
+
+		decodedLogs = append(decodedLogs, db.DecodedLog{
+			Log:       *log,
+			EventName: dbDecodedLog.EventName,
+			Args:      args,
+		})
+	}
+
+	return decodedLogs, nil
+}
+
+// retrieveDecodedLogsLogs retrieves the raw logs of decoded logs, keyed by decoded log key.
+func (s Store) retrieveDecodedLogsLogs(ctx context.Context, chainID uint32, dbDecodedLogs []DecodedLog) (map[string]*types.Log, error) {
+	txHashes := make([]string, len(dbDecodedLogs))
+	for i, dbDecodedLog := range dbDecodedLogs {
+		txHashes[i] = dbDecodedLog.TxHash
+	}
+
+	var dbLogs []Log
+	dbTx := s.DB().WithContext(ctx).
+		Model(&Log{}).
+		Where(&Log{ChainID: chainID}).
+		Where(fmt.Sprintf("%s IN ?", TxHashFieldName), txHashes).
+		Find(&dbLogs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve logs of decoded logs: %w", dbTx.Error)
+	}
+
+	logs := make(map[string]*types.Log, len(dbLogs))
+	for _, log := range buildLogsFromDBLogs(dbLogs) {
+		logs[decodedLogKey(chainID, log.TxHash, log.Index)] = log
+	}
+	return logs, nil
+}
@@ -0,0 +1,61 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm/clause"
+)
+
+// StoreFactoryChild stores a contract discovered from a factory. Previously stored children are left unchanged.
+func (s Store) StoreFactoryChild(ctx context.Context, chainID uint32, child db.FactoryChild) error {
+	dbTx := s.DB().WithContext(ctx)
+	if s.db.Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	} else {
+		dbTx = dbTx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: ContractAddressFieldName}},
+			DoNothing: true,
+		})
+	}
+	dbTx = dbTx.Create(&FactoryChild{
+		ChainID:         chainID,
+		ContractAddress: child.ContractAddress.String(),
+		FactoryAddress:  child.FactoryAddress.String(),
+		BlockNumber:     child.BlockNumber,
+	})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store factory child: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveFactoryChildren retrieves all contracts discovered from factories on a chain, in creation order.
+func (s Store) RetrieveFactoryChildren(ctx context.Context, chainID uint32) ([]db.FactoryChild, error) {
+	var dbChildren []FactoryChild
+	dbTx := s.DB().WithContext(ctx).
+		Model(&FactoryChild{}).
+		Where(&FactoryChild{ChainID: chainID}).
+		Order(fmt.Sprintf("%s asc", BlockNumberFieldName)).
+		Find(&dbChildren)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve factory children: %w", dbTx.Error)
+	}
+
+	children := make([]db.FactoryChild, len(dbChildren))
+	for i, dbChild := range dbChildren {
+		children[i] = db.FactoryChild{
+			FactoryAddress:  common.HexToAddress(dbChild.FactoryAddress),
+			ContractAddress: common.HexToAddress(dbChild.ContractAddress),
+			BlockNumber:     dbChild.BlockNumber,
+		}
+	}
+
+	return children, nil
+}
@@ -7,6 +7,7 @@
 	"fmt"
 	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/services/scribe/db"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -60,17 +61,17 @@ func (s Store) StoreLogs(ctx context.Context, chainID uint32, logs ...types.Log)
 	}
 
 	dbTx := s.DB().WithContext(ctx)
-	if s.db.Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.db.Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		}).Create(&storeLogs)
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns: []clause.Column{
 				{Name: ContractAddressFieldName}, {Name: ChainIDFieldName}, {Name: TxHashFieldName}, {Name: BlockIndexFieldName},
 			},
 			DoNothing: true,
 		}).CreateInBatches(&storeLogs, 10)
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		}).Create(&storeLogs)
 	}
 
 	if dbTx.Error != nil {
@@ -122,6 +123,36 @@ func logFilterToQuery(logFilter db.LogFilter) Log {
 	}
 }
 
+// withMultiValueFilters restricts a log query to the logs matching any of the filter's contract addresses and
+// the filter's topics.
+func withMultiValueFilters(tx *gorm.DB, logFilter db.LogFilter) (*gorm.DB, error) {
+	if len(logFilter.ContractAddresses) > 0 {
+		addresses := make([]string, len(logFilter.ContractAddresses))
+		for i, address := range logFilter.ContractAddresses {
+			addresses[i] = common.HexToAddress(address).String()
+		}
+		tx = tx.Where(fmt.Sprintf("%s IN ?", ContractAddressFieldName), addresses)
+	}
+
+	topics, err := scribeTypes.ParseTopics(logFilter.Topics)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse topic filter: %w", err)
+	}
+
+	for i, position := range topics {
+		if len(position) == 0 {
+			continue
+		}
+		values := make([]string, len(position))
+		for j, topic := range position {
+			values[j] = topic.String()
+		}
+		tx = tx.Where(fmt.Sprintf("%s IN ?", TopicFieldNames[i]), values)
+	}
+
+	return tx, nil
+}
+
 // RetrieveLogsWithFilter retrieves all logs that match a filter given a page.
 func (s Store) RetrieveLogsWithFilter(ctx context.Context, logFilter db.LogFilter, page int) (logs []*types.Log, err error) {
 	if page < 1 {
@@ -130,8 +161,11 @@ func (s Store) RetrieveLogsWithFilter(ctx context.Context, logFilter db.LogFilte
 	dbLogs := []Log{}
 	queryFilter := logFilterToQuery(logFilter)
 
-	dbTx := s.DB().WithContext(ctx).
-		Model(&Log{}).
+	dbTx, err := withMultiValueFilters(s.DB().WithContext(ctx).Model(&Log{}), logFilter)
+	if err != nil {
+		return nil, err
+	}
+	dbTx = dbTx.
 		Where(&queryFilter).
 		Order(fmt.Sprintf("%s desc, %s desc", BlockNumberFieldName, BlockIndexFieldName)).
 		Offset((page - 1) * PageSize).
@@ -234,8 +268,11 @@ func (s Store) retrieveLogsInRangeQuery(ctx context.Context, logFilter db.LogFil
 	dbLogs := []Log{}
 	queryFilter := logFilterToQuery(logFilter)
 	rangeQuery := fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName)
-	dbTx := s.DB().WithContext(ctx).
-		Model(&Log{}).
+	dbTx, err := withMultiValueFilters(s.DB().WithContext(ctx).Model(&Log{}), logFilter)
+	if err != nil {
+		return nil, err
+	}
+	dbTx = dbTx.
 		Where(&queryFilter).
 		Where(rangeQuery, startBlock, endBlock).
 		Order(fmt.Sprintf("%s %s, %s %s", BlockNumberFieldName, order, BlockIndexFieldName, order)).
@@ -0,0 +1,20 @@
+package base
+
+import "github.com/synapsecns/sanguine/core/dbcommon"
+
+// MigrationService is the name the scribe db's migrations are stored under.
+const MigrationService = "scribe"
+
+// GetMigrations gets the versioned schema migrations for the scribe db. New migrations are appended here
+// and released migrations must never be changed, so their models are listed out rather than taken from GetAllModels.
+func GetMigrations() []dbcommon.Migration {
+	return []dbcommon.Migration{
+		dbcommon.AutoMigration(1, "initial_schema", &Log{}, &Receipt{}, &EthTx{}, &LastIndexedInfo{}, &LastConfirmedBlockInfo{}, &BlockTime{}, &LastBlockTime{}, &LogAtHead{}, &ReceiptAtHead{}, &EthTxAtHead{}),
+		dbcommon.AutoMigration(2, "reorg_tracking", &IndexedBlock{}, &RemovedLog{}),
+		dbcommon.AutoMigration(3, "factory_children", &FactoryChild{}),
+		dbcommon.AutoMigration(4, "decoded_events", &DecodedLog{}, &DecodedArg{}),
+		dbcommon.AutoMigration(5, "indexer_progress", &IndexerProgress{}),
+		dbcommon.AutoMigration(6, "call_frames", &CallFrame{}),
+		dbcommon.AutoMigration(7, "sinks", &SinkCursor{}, &DeadLetter{}),
+	}
+}
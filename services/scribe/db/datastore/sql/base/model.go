@@ -20,6 +20,20 @@ func init() {
 	BlockHashFieldName = namer.GetConsistentName("BlockHash")
 	ConfirmedFieldName = namer.GetConsistentName("Confirmed")
 	TransactionIndexFieldName = namer.GetConsistentName("TransactionIndex")
+	TopicFieldNames = []string{
+		namer.GetConsistentName("PrimaryTopic"),
+		namer.GetConsistentName("TopicA"),
+		namer.GetConsistentName("TopicB"),
+		namer.GetConsistentName("TopicC"),
+	}
+	LogKeyFieldName = namer.GetConsistentName("LogKey")
+	EventNameFieldName = namer.GetConsistentName("EventName")
+	ArgNameFieldName = namer.GetConsistentName("ArgName")
+	ArgValueFieldName = namer.GetConsistentName("ArgValue")
+	FrameIndexFieldName = namer.GetConsistentName("FrameIndex")
+	FromAddressFieldName = namer.GetConsistentName("FromAddress")
+	ToAddressFieldName = namer.GetConsistentName("ToAddress")
+	SinkNameFieldName = namer.GetConsistentName("SinkName")
 }
 
 var (
@@ -39,6 +53,24 @@ func init() {
 	ConfirmedFieldName string
 	// TransactionIndexFieldName is the name of the transaction block  field.
 	TransactionIndexFieldName string
+	// TopicFieldNames are the names of the topic fields, in topic order.
+	TopicFieldNames []string
+	// LogKeyFieldName is the name of the decoded log key field.
+	LogKeyFieldName string
+	// EventNameFieldName is the name of the decoded event name field.
+	EventNameFieldName string
+	// ArgNameFieldName is the name of the decoded argument name field.
+	ArgNameFieldName string
+	// ArgValueFieldName is the name of the decoded argument value field.
+	ArgValueFieldName string
+	// FrameIndexFieldName is the name of the call frame index field.
+	FrameIndexFieldName string
+	// FromAddressFieldName is the name of the call frame caller field.
+	FromAddressFieldName string
+	// ToAddressFieldName is the name of the call frame callee field.
+	ToAddressFieldName string
+	// SinkNameFieldName is the name of the sink name field.
+	SinkNameFieldName string
 )
 
 // PageSize is the amount of entries per page of logs.
@@ -266,3 +298,199 @@ type EthTxAtHead struct {
 	// InsertTime is the time at which this tx was inserted
 	InsertTime uint64 `gorm:"column:insert_time"`
 }
+
+// IndexedBlock contains the hash of a block that had data indexed from it. It is used to detect reorgs.
+type IndexedBlock struct {
+	// ChainID is the chain id of the block
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// BlockNumber is the block number
+	BlockNumber uint64 `gorm:"column:block_number;primaryKey"`
+	// BlockHash is the hash of the block when it was indexed
+	BlockHash string `gorm:"column:block_hash;index:idx_indexed_block_hash,priority:1"`
+}
+
+// RemovedLog stores a log that was deleted because its block was orphaned by a reorg.
+type RemovedLog struct {
+	// ID is the auto incrementing id of the removed log, used as a cursor by stream consumers
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// ContractAddress is the address of the contract that generated the event
+	ContractAddress string `gorm:"column:contract_address;index:idx_removed_address,priority:1"`
+	// ChainID is the chain id of the contract that generated the event
+	ChainID uint32 `gorm:"column:chain_id;index:idx_removed_address,priority:2"`
+	// PrimaryTopic is the primary topic of the event. Topics[0]
+	PrimaryTopic sql.NullString `gorm:"primary_topic"`
+	// TopicA is the first topic. Topics[1]
+	TopicA sql.NullString `gorm:"topic_a"`
+	// TopicB is the second topic. Topics[2]
+	TopicB sql.NullString `gorm:"topic_b"`
+	// TopicC is the third topic. Topics[3]
+	TopicC sql.NullString `gorm:"topic_c"`
+	// Data is the data provided by the contract
+	Data []byte `gorm:"data"`
+	// BlockNumber is the block in which the transaction was included
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TxHash is the hash of the transaction
+	TxHash string `gorm:"column:tx_hash"`
+	// TxIndex is the index of the transaction in the block
+	TxIndex uint64 `gorm:"tx_index"`
+	// BlockHash is the hash of the orphaned block in which the transaction was included
+	BlockHash string `gorm:"column:block_hash"`
+	// Index is the index of the log in the block
+	BlockIndex uint64 `gorm:"column:block_index"`
+	// RemovedAt is the time at which this log was removed
+	RemovedAt uint64 `gorm:"column:removed_at"`
+}
+
+// FactoryChild is a contract discovered from a factory's creation event.
+type FactoryChild struct {
+	// ChainID is the chain id of the contract
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// ContractAddress is the address of the discovered contract
+	ContractAddress string `gorm:"column:contract_address;primaryKey"`
+	// FactoryAddress is the address of the factory that created the contract
+	FactoryAddress string `gorm:"column:factory_address"`
+	// BlockNumber is the block in which the contract was created
+	BlockNumber uint64 `gorm:"column:block_number"`
+}
+
+// DecodedLog stores a log decoded with its contract's ABI.
+type DecodedLog struct {
+	// LogKey identifies the log by chain id, tx hash and index
+	LogKey string `gorm:"column:log_key;primaryKey"`
+	// ChainID is the chain id of the log
+	ChainID uint32 `gorm:"column:chain_id;index:idx_decoded_event,priority:1"`
+	// EventName is the name of the decoded event
+	EventName string `gorm:"column:event_name;index:idx_decoded_event,priority:2"`
+	// ContractAddress is the address of the contract that generated the event
+	ContractAddress string `gorm:"column:contract_address"`
+	// TxHash is the hash of the transaction
+	TxHash string `gorm:"column:tx_hash"`
+	// BlockNumber is the block in which the transaction was included
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// BlockHash is the hash of the block in which the transaction was included
+	BlockHash string `gorm:"column:block_hash"`
+	// BlockIndex is the index of the log in the block
+	BlockIndex uint64 `gorm:"column:block_index"`
+	// Args is the JSON encoded map of argument names to values
+	Args string `gorm:"column:args"`
+}
+
+// DecodedArg stores an argument of a decoded log so decoded logs can be filtered by argument value.
+type DecodedArg struct {
+	// LogKey is the key of the decoded log
+	LogKey string `gorm:"column:log_key;primaryKey"`
+	// ArgName is the name of the argument
+	ArgName string `gorm:"column:arg_name;primaryKey;index:idx_decoded_arg,priority:1"`
+	// ArgValue is the value of the argument, formatted with types.FormatArg
+	ArgValue string `gorm:"column:arg_value;index:idx_decoded_arg,priority:2"`
+	// ChainID is the chain id of the log
+	ChainID uint32 `gorm:"column:chain_id"`
+	// BlockHash is the hash of the block in which the log was included
+	BlockHash string `gorm:"column:block_hash"`
+}
+
+// IndexerProgress stores the indexing progress of a contract.
+type IndexerProgress struct {
+	// ChainID is the chain id of the contract
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// ContractAddress is the address of the contract
+	ContractAddress string `gorm:"column:contract_address;primaryKey"`
+	// Mode is how the contract is being indexed
+	Mode string `gorm:"column:mode"`
+	// CurrentBlock is the last block indexed
+	CurrentBlock uint64 `gorm:"column:current_block"`
+	// TargetBlock is the block the contract is being indexed to
+	TargetBlock uint64 `gorm:"column:target_block"`
+	// HeadBlock is the latest confirmed block of the chain
+	HeadBlock uint64 `gorm:"column:head_block"`
+	// Rates is the JSON encoded list of indexing rates
+	Rates string `gorm:"column:rates"`
+	// ETASeconds is the estimated number of seconds to reach the target block
+	ETASeconds *int64 `gorm:"column:eta_seconds"`
+	// LastError is the last error encountered while indexing
+	LastError string `gorm:"column:last_error"`
+	// LastErrorTime is the unix time the last error was encountered, 0 if there was none
+	LastErrorTime int64 `gorm:"column:last_error_time"`
+	// LastProgressTime is the unix time the current block last advanced
+	LastProgressTime int64 `gorm:"column:last_progress_time"`
+	// UpdateTime is the unix time the progress was recorded
+	UpdateTime int64 `gorm:"column:update_time"`
+}
+
+// CallFrame stores a call made while executing a transaction, flattened from the transaction's call trace.
+type CallFrame struct {
+	// ChainID is the chain id of the transaction
+	ChainID uint32 `gorm:"column:chain_id;primaryKey;index:idx_call_frame_from,priority:1;index:idx_call_frame_to,priority:1"`
+	// TxHash is the hash of the transaction
+	TxHash string `gorm:"column:tx_hash;primaryKey"`
+	// FrameIndex is the position of the frame in a depth first walk of the trace
+	FrameIndex uint64 `gorm:"column:frame_index;primaryKey"`
+	// TraceAddress is the comma separated path to the frame from the top level call
+	TraceAddress string `gorm:"column:trace_address"`
+	// Depth is the depth of the frame in the trace
+	Depth uint64 `gorm:"column:depth"`
+	// CallType is the type of the call
+	CallType string `gorm:"column:call_type"`
+	// FromAddress is the address of the caller
+	FromAddress string `gorm:"column:from_address;index:idx_call_frame_from,priority:2"`
+	// ToAddress is the address of the callee
+	ToAddress string `gorm:"column:to_address;index:idx_call_frame_to,priority:2"`
+	// Value is the decimal value sent with the call, empty if the call can't send value
+	Value string `gorm:"column:value"`
+	// Gas is the gas provided to the call
+	Gas uint64 `gorm:"column:gas"`
+	// GasUsed is the gas used by the call
+	GasUsed uint64 `gorm:"column:gas_used"`
+	// Input is the call data
+	Input []byte `gorm:"column:input"`
+	// Output is the data returned by the call
+	Output []byte `gorm:"column:output"`
+	// Error is the error the call reverted with
+	Error string `gorm:"column:error"`
+	// BlockNumber is the block in which the transaction was included
+	BlockNumber uint64 `gorm:"column:block_number;index:idx_call_frame_block_number,priority:1,sort:desc"`
+	// BlockHash is the hash of the block in which the transaction was included
+	BlockHash string `gorm:"column:block_hash"`
+	// TransactionIndex is the index of the transaction in the block
+	TransactionIndex uint64 `gorm:"column:transaction_index;index:idx_call_frame_block_number,priority:2,sort:desc"`
+}
+
+// SinkCursor stores the position of the last log delivered to a sink on a chain.
+type SinkCursor struct {
+	// SinkName is the name of the sink
+	SinkName string `gorm:"column:sink_name;primaryKey"`
+	// ChainID is the chain id of the delivered logs
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// BlockNumber is the block number of the last delivered log
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TxIndex is the index of the last delivered log's transaction in the block
+	TxIndex uint64 `gorm:"column:tx_index"`
+	// BlockIndex is the index of the last delivered log in the block
+	BlockIndex uint64 `gorm:"column:block_index"`
+	// RemovedID is the id of the last removed log handled by the sink
+	RemovedID uint64 `gorm:"column:removed_id"`
+}
+
+// DeadLetter stores a log a sink failed to deliver.
+type DeadLetter struct {
+	// ID is the auto incrementing id of the dead letter
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// SinkName is the name of the sink
+	SinkName string `gorm:"column:sink_name;index:idx_dead_letter_sink,priority:1"`
+	// ChainID is the chain id of the log
+	ChainID uint32 `gorm:"column:chain_id"`
+	// BlockNumber is the block in which the log's transaction was included
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TxHash is the hash of the log's transaction
+	TxHash string `gorm:"column:tx_hash"`
+	// BlockIndex is the index of the log in the block
+	BlockIndex uint64 `gorm:"column:block_index"`
+	// Removed is true if the log was a reorg removal
+	Removed bool `gorm:"column:removed"`
+	// Log is the JSON encoded log
+	Log string `gorm:"column:log"`
+	// Error is the error of the last delivery attempt
+	Error string `gorm:"column:error"`
+	// FailedAt is the unix time the delivery failed
+	FailedAt int64 `gorm:"column:failed_at"`
+}
@@ -0,0 +1,133 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm/clause"
+)
+
+// maxErrorLength is the max length of a stored indexing error.
+const maxErrorLength = 1000
+
+// StoreIndexerProgress stores the indexing progress of contracts, replacing their previous progress.
+func (s Store) StoreIndexerProgress(ctx context.Context, progress ...db.IndexerProgress) error {
+	if len(progress) == 0 {
+		return nil
+	}
+
+	dbProgress := make([]IndexerProgress, len(progress))
+	for i, contractProgress := range progress {
+		rates, err := json.Marshal(contractProgress.Rates)
+		if err != nil {
+			return fmt.Errorf("could not encode indexing rates: %w", err)
+		}
+
+		lastError := contractProgress.LastError
+		if len(lastError) > maxErrorLength {
+			lastError = lastError[:maxErrorLength]
+		}
+
+		dbProgress[i] = IndexerProgress{
+			ChainID:          contractProgress.ChainID,
+			ContractAddress:  contractProgress.ContractAddress.String(),
+			Mode:             string(contractProgress.Mode),
+			CurrentBlock:     contractProgress.CurrentBlock,
+			TargetBlock:      contractProgress.TargetBlock,
+			HeadBlock:        contractProgress.HeadBlock,
+			Rates:            string(rates),
+			LastError:        lastError,
+			LastProgressTime: contractProgress.LastProgressAt.Unix(),
+			UpdateTime:       contractProgress.UpdatedAt.Unix(),
+		}
+		if contractProgress.ETA != nil {
+			etaSeconds := int64(contractProgress.ETA.Seconds())
+			dbProgress[i].ETASeconds = &etaSeconds
+		}
+		if contractProgress.LastErrorAt != nil {
+			dbProgress[i].LastErrorTime = contractProgress.LastErrorAt.Unix()
+		}
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: ContractAddressFieldName}},
+			UpdateAll: true,
+		}).
+		CreateInBatches(&dbProgress, PageSize)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store indexer progress: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RemoveIndexerProgress deletes the indexing progress of contracts on a chain, or of every contract on the chain if
+// no addresses are given.
+func (s Store) RemoveIndexerProgress(ctx context.Context, chainID uint32, contractAddresses ...common.Address) error {
+	dbTx := s.DB().WithContext(ctx).
+		Where(fmt.Sprintf("%s = ?", ChainIDFieldName), chainID)
+	if len(contractAddresses) > 0 {
+		addresses := make([]string, len(contractAddresses))
+		for i, address := range contractAddresses {
+			addresses[i] = address.String()
+		}
+		dbTx = dbTx.Where(fmt.Sprintf("%s IN ?", ContractAddressFieldName), addresses)
+	}
+
+	dbTx = dbTx.Delete(&IndexerProgress{})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not remove indexer progress: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveIndexerProgress retrieves the indexing progress of the contracts on a chain, or on every chain if the chain id is 0.
+func (s Store) RetrieveIndexerProgress(ctx context.Context, chainID uint32) ([]db.IndexerProgress, error) {
+	var dbProgress []IndexerProgress
+	dbTx := s.DB().WithContext(ctx).
+		Model(&IndexerProgress{}).
+		Where(&IndexerProgress{ChainID: chainID}).
+		Order(fmt.Sprintf("%s asc, %s asc", ChainIDFieldName, ContractAddressFieldName)).
+		Find(&dbProgress)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve indexer progress: %w", dbTx.Error)
+	}
+
+	progress := make([]db.IndexerProgress, len(dbProgress))
+	for i, contractProgress := range dbProgress {
+		var rates []db.IndexingRate
+		err := json.Unmarshal([]byte(contractProgress.Rates), &rates)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode indexing rates: %w", err)
+		}
+
+		progress[i] = db.IndexerProgress{
+			ChainID:         contractProgress.ChainID,
+			ContractAddress: common.HexToAddress(contractProgress.ContractAddress),
+			Mode:            db.IndexingMode(contractProgress.Mode),
+			CurrentBlock:    contractProgress.CurrentBlock,
+			TargetBlock:     contractProgress.TargetBlock,
+			HeadBlock:       contractProgress.HeadBlock,
+			Rates:           rates,
+			LastError:       contractProgress.LastError,
+			LastProgressAt:  time.Unix(contractProgress.LastProgressTime, 0),
+			UpdatedAt:       time.Unix(contractProgress.UpdateTime, 0),
+		}
+		if contractProgress.ETASeconds != nil {
+			eta := time.Duration(*contractProgress.ETASeconds) * time.Second
+			progress[i].ETA = &eta
+		}
+		if contractProgress.LastErrorTime != 0 {
+			lastErrorAt := time.Unix(contractProgress.LastErrorTime, 0)
+			progress[i].LastErrorAt = &lastErrorAt
+		}
+	}
+
+	return progress, nil
+}
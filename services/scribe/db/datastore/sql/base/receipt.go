@@ -17,15 +17,15 @@
 // StoreReceipt stores a receipt.
 func (s Store) StoreReceipt(ctx context.Context, chainID uint32, receipt types.Receipt) error {
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
 		})
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		})
 	}
 	dbTx = dbTx.Create(&Receipt{
 		ChainID:           chainID,
@@ -83,6 +83,24 @@ func (s Store) DeleteReceiptsForBlockHash(ctx context.Context, chainID uint32, b
 	return nil
 }
 
+// DeleteReceiptsForTxHashes deletes the receipts of the given transactions.
+func (s Store) DeleteReceiptsForTxHashes(ctx context.Context, chainID uint32, txHashes ...common.Hash) error {
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Where(&Receipt{ChainID: chainID}).
+		Where(fmt.Sprintf("%s IN ?", TxHashFieldName), hashesToStrings(txHashes)).
+		Delete(&Receipt{})
+
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not delete receipts: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
 // receiptFilterToQuery takes in a ReceiptFilter and converts it to a database-type Receipt.
 // This is used to query with `WHERE` based on the filter.
 func receiptFilterToQuery(receiptFilter db.ReceiptFilter) Receipt {
@@ -164,7 +182,7 @@ func (s Store) buildReceiptsFromDBReceipts(ctx context.Context, dbReceipts []Rec
 	for i := range dbReceipts {
 		dbReceipt := dbReceipts[i]
 		// Retrieve Logs that match the receipt's tx hash in order to add them to the Receipt.
-		logFilter := db.BuildLogFilter(nil, nil, &dbReceipt.TxHash, nil, nil, nil, nil)
+		logFilter := db.BuildLogFilter(nil, nil, &dbReceipt.TxHash, nil, nil, nil, nil, nil)
 		logFilter.ChainID = chainID
 
 		var logs []*types.Log
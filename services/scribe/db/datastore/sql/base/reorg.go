@@ -0,0 +1,249 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StoreBlockHash stores the hash of an indexed block, replacing any previously stored hash for the block number.
+func (s Store) StoreBlockHash(ctx context.Context, chainID uint32, blockNumber uint64, blockHash common.Hash) error {
+	dbTx := s.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: BlockNumberFieldName}},
+			DoUpdates: clause.AssignmentColumns([]string{BlockHashFieldName}),
+		}).
+		Create(&IndexedBlock{
+			ChainID:     chainID,
+			BlockNumber: blockNumber,
+			BlockHash:   blockHash.String(),
+		})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store block hash: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveBlockHashesInRange retrieves the stored hashes of indexed blocks within a range, keyed by block number.
+func (s Store) RetrieveBlockHashesInRange(ctx context.Context, chainID uint32, startBlock, endBlock uint64) (map[uint64]common.Hash, error) {
+	var indexedBlocks []IndexedBlock
+	dbTx := s.DB().WithContext(ctx).
+		Model(&IndexedBlock{}).
+		Where(&IndexedBlock{ChainID: chainID}).
+		Where(fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName), startBlock, endBlock).
+		Find(&indexedBlocks)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve block hashes: %w", dbTx.Error)
+	}
+
+	blockHashes := make(map[uint64]common.Hash, len(indexedBlocks))
+	for _, indexedBlock := range indexedBlocks {
+		blockHashes[indexedBlock.BlockNumber] = common.HexToHash(indexedBlock.BlockHash)
+	}
+
+	return blockHashes, nil
+}
+
+// RemoveOrphanedBlocks deletes all logs, decoded logs, receipts, transactions and call frames indexed from the given orphaned blocks.
+// Deleted logs are kept as removed logs so stream consumers can be notified, and the last indexed block of every
+// contract past fromBlock is rewound so the range is re-indexed from the canonical chain.
+func (s Store) RemoveOrphanedBlocks(ctx context.Context, chainID uint32, fromBlock uint64, blockHashes []common.Hash) error {
+	if len(blockHashes) == 0 {
+		return nil
+	}
+
+	hashes := make([]string, len(blockHashes))
+	for i, blockHash := range blockHashes {
+		hashes[i] = blockHash.String()
+	}
+	blockHashQuery := fmt.Sprintf("%s = ? AND %s IN ?", ChainIDFieldName, BlockHashFieldName)
+
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbLogs []Log
+		if err := tx.Model(&Log{}).Where(blockHashQuery, chainID, hashes).
+			Order(fmt.Sprintf("%s asc, %s asc", BlockNumberFieldName, BlockIndexFieldName)).
+			Find(&dbLogs).Error; err != nil {
+			return fmt.Errorf("could not retrieve orphaned logs: %w", err)
+		}
+
+		if err := storeRemovedLogs(tx, dbLogs); err != nil {
+			return err
+		}
+
+		for _, model := range []interface{}{&Log{}, &Receipt{}, &EthTx{}, &IndexedBlock{}, &DecodedLog{}, &DecodedArg{}, &CallFrame{}} {
+			if err := tx.Where(blockHashQuery, chainID, hashes).Delete(model).Error; err != nil {
+				return fmt.Errorf("could not delete orphaned data: %w", err)
+			}
+		}
+
+		if fromBlock > 0 {
+			if err := tx.Model(&LastIndexedInfo{}).
+				Where(fmt.Sprintf("%s = ? AND %s >= ?", ChainIDFieldName, BlockNumberFieldName), chainID, fromBlock).
+				Update(BlockNumberFieldName, fromBlock-1).Error; err != nil {
+				return fmt.Errorf("could not rewind last indexed: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not remove orphaned blocks: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveLogs deletes logs and their decoded logs. Deleted logs are kept as removed logs so stream consumers can be
+// notified.
+func (s Store) RemoveLogs(ctx context.Context, chainID uint32, logs ...types.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbLogs []Log
+		for _, log := range logs {
+			var dbLog []Log
+			if err := tx.Model(&Log{}).Where(&Log{
+				ContractAddress: log.Address.String(),
+				ChainID:         chainID,
+				TxHash:          log.TxHash.String(),
+			}).Where(fmt.Sprintf("%s = ?", BlockIndexFieldName), log.Index).Find(&dbLog).Error; err != nil {
+				return fmt.Errorf("could not retrieve log: %w", err)
+			}
+			dbLogs = append(dbLogs, dbLog...)
+		}
+		if err := storeRemovedLogs(tx, dbLogs); err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err := tx.Where(&Log{
+				ContractAddress: log.Address.String(),
+				ChainID:         chainID,
+				TxHash:          log.TxHash.String(),
+			}).Where(fmt.Sprintf("%s = ?", BlockIndexFieldName), log.Index).Delete(&Log{}).Error; err != nil {
+				return fmt.Errorf("could not delete log: %w", err)
+			}
+
+			logKey := decodedLogKey(chainID, log.TxHash, log.Index)
+			for _, model := range []interface{}{&DecodedLog{}, &DecodedArg{}} {
+				if err := tx.Where(fmt.Sprintf("%s = ?", LogKeyFieldName), logKey).Delete(model).Error; err != nil {
+					return fmt.Errorf("could not delete decoded log: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not remove logs: %w", err)
+	}
+
+	return nil
+}
+
+// storeRemovedLogs keeps deleted logs as removed logs.
+func storeRemovedLogs(tx *gorm.DB, dbLogs []Log) error {
+	if len(dbLogs) == 0 {
+		return nil
+	}
+
+	removedAt := uint64(time.Now().Unix())
+	removedLogs := make([]RemovedLog, len(dbLogs))
+	for i, dbLog := range dbLogs {
+		removedLogs[i] = RemovedLog{
+			ContractAddress: dbLog.ContractAddress,
+			ChainID:         dbLog.ChainID,
+			PrimaryTopic:    dbLog.PrimaryTopic,
+			TopicA:          dbLog.TopicA,
+			TopicB:          dbLog.TopicB,
+			TopicC:          dbLog.TopicC,
+			Data:            dbLog.Data,
+			BlockNumber:     dbLog.BlockNumber,
+			TxHash:          dbLog.TxHash,
+			TxIndex:         dbLog.TxIndex,
+			BlockHash:       dbLog.BlockHash,
+			BlockIndex:      dbLog.BlockIndex,
+			RemovedAt:       removedAt,
+		}
+	}
+	if err := tx.CreateInBatches(&removedLogs, PageSize).Error; err != nil {
+		return fmt.Errorf("could not store removed logs: %w", err)
+	}
+	return nil
+}
+
+// RetrieveRemovedLogs retrieves logs removed by reorgs that match a filter and were removed after the given id.
+func (s Store) RetrieveRemovedLogs(ctx context.Context, logFilter db.LogFilter, afterID uint64, page int) ([]db.RemovedLog, error) {
+	if page < 1 {
+		page = 1
+	}
+	queryFilter := logFilterToQuery(logFilter)
+	var dbLogs []RemovedLog
+	dbTx, err := withMultiValueFilters(s.DB().WithContext(ctx).Model(&RemovedLog{}), logFilter)
+	if err != nil {
+		return nil, err
+	}
+	dbTx = dbTx.
+		Where(&RemovedLog{
+			ContractAddress: queryFilter.ContractAddress,
+			ChainID:         queryFilter.ChainID,
+			BlockNumber:     queryFilter.BlockNumber,
+			TxHash:          queryFilter.TxHash,
+			TxIndex:         queryFilter.TxIndex,
+			BlockHash:       queryFilter.BlockHash,
+			BlockIndex:      queryFilter.BlockIndex,
+		}).
+		Where("id > ?", afterID).
+		Order("id asc").
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Find(&dbLogs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve removed logs: %w", dbTx.Error)
+	}
+
+	removedLogs := make([]db.RemovedLog, len(dbLogs))
+	for i, dbLog := range dbLogs {
+		log := buildLogsFromDBLogs([]Log{{
+			ContractAddress: dbLog.ContractAddress,
+			ChainID:         dbLog.ChainID,
+			PrimaryTopic:    dbLog.PrimaryTopic,
+			TopicA:          dbLog.TopicA,
+			TopicB:          dbLog.TopicB,
+			TopicC:          dbLog.TopicC,
+			Data:            dbLog.Data,
+			BlockNumber:     dbLog.BlockNumber,
+			TxHash:          dbLog.TxHash,
+			TxIndex:         dbLog.TxIndex,
+			BlockHash:       dbLog.BlockHash,
+			BlockIndex:      dbLog.BlockIndex,
+			Removed:         true,
+		}})[0]
+		removedLogs[i] = db.RemovedLog{ID: dbLog.ID, Log: log}
+	}
+
+	return removedLogs, nil
+}
+
+// RetrieveLastRemovedLogID retrieves the id of the most recently removed log on a chain, or 0 if there is none.
+func (s Store) RetrieveLastRemovedLogID(ctx context.Context, chainID uint32) (uint64, error) {
+	var lastID uint64
+	dbTx := s.DB().WithContext(ctx).
+		Model(&RemovedLog{}).
+		Where(&RemovedLog{ChainID: chainID}).
+		Select("COALESCE(MAX(id), 0)").Scan(&lastID)
+	if dbTx.Error != nil {
+		return 0, fmt.Errorf("could not retrieve last removed log id: %w", dbTx.Error)
+	}
+
+	return lastID, nil
+}
@@ -0,0 +1,136 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StoreSinkCursor stores the delivery cursor of a sink on a chain, replacing the previous cursor.
+func (s Store) StoreSinkCursor(ctx context.Context, sinkName string, chainID uint32, cursor db.SinkCursor) error {
+	dbTx := s.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: SinkNameFieldName}, {Name: ChainIDFieldName}},
+			UpdateAll: true,
+		}).
+		Create(&SinkCursor{
+			SinkName:    sinkName,
+			ChainID:     chainID,
+			BlockNumber: cursor.BlockNumber,
+			TxIndex:     cursor.TxIndex,
+			BlockIndex:  cursor.Index,
+			RemovedID:   cursor.RemovedID,
+		})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store sink cursor: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveSinkCursor retrieves the delivery cursor of a sink on a chain, nil if the sink has no cursor.
+func (s Store) RetrieveSinkCursor(ctx context.Context, sinkName string, chainID uint32) (*db.SinkCursor, error) {
+	var sinkCursor SinkCursor
+	dbTx := s.DB().WithContext(ctx).
+		Model(&SinkCursor{}).
+		Where(&SinkCursor{SinkName: sinkName, ChainID: chainID}).
+		First(&sinkCursor)
+	if dbTx.Error != nil {
+		if errors.Is(dbTx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not retrieve sink cursor: %w", dbTx.Error)
+	}
+
+	return &db.SinkCursor{
+		BlockNumber: sinkCursor.BlockNumber,
+		TxIndex:     sinkCursor.TxIndex,
+		Index:       sinkCursor.BlockIndex,
+		RemovedID:   sinkCursor.RemovedID,
+	}, nil
+}
+
+// StoreDeadLetters stores logs a sink failed to deliver.
+func (s Store) StoreDeadLetters(ctx context.Context, deadLetters ...db.DeadLetter) error {
+	if len(deadLetters) == 0 {
+		return nil
+	}
+
+	dbDeadLetters := make([]DeadLetter, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		log, err := json.Marshal(deadLetter.Log)
+		if err != nil {
+			return fmt.Errorf("could not encode dead letter log: %w", err)
+		}
+
+		deliveryError := deadLetter.Error
+		if len(deliveryError) > maxErrorLength {
+			deliveryError = deliveryError[:maxErrorLength]
+		}
+
+		dbDeadLetters[i] = DeadLetter{
+			SinkName:    deadLetter.SinkName,
+			ChainID:     deadLetter.ChainID,
+			BlockNumber: deadLetter.Log.BlockNumber,
+			TxHash:      deadLetter.Log.TxHash.String(),
+			BlockIndex:  uint64(deadLetter.Log.Index),
+			Removed:     deadLetter.Log.Removed,
+			Log:         string(log),
+			Error:       deliveryError,
+			FailedAt:    deadLetter.FailedAt.Unix(),
+		}
+	}
+
+	dbTx := s.DB().WithContext(ctx).CreateInBatches(&dbDeadLetters, PageSize)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store dead letters: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveDeadLetters retrieves the logs a sink failed to deliver given a page, oldest first.
+func (s Store) RetrieveDeadLetters(ctx context.Context, sinkName string, page int) ([]db.DeadLetter, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	var dbDeadLetters []DeadLetter
+	dbTx := s.DB().WithContext(ctx).
+		Model(&DeadLetter{}).
+		Where(&DeadLetter{SinkName: sinkName}).
+		Order("id asc").
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Find(&dbDeadLetters)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve dead letters: %w", dbTx.Error)
+	}
+
+	deadLetters := make([]db.DeadLetter, len(dbDeadLetters))
+	for i, dbDeadLetter := range dbDeadLetters {
+		var log types.Log
+		err := json.Unmarshal([]byte(dbDeadLetter.Log), &log)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode dead letter %d: %w", dbDeadLetter.ID, err)
+		}
+
+		deadLetters[i] = db.DeadLetter{
+			ID:       dbDeadLetter.ID,
+			SinkName: dbDeadLetter.SinkName,
+			ChainID:  dbDeadLetter.ChainID,
+			Log:      log,
+			Error:    dbDeadLetter.Error,
+			FailedAt: time.Unix(dbDeadLetter.FailedAt, 0),
+		}
+	}
+
+	return deadLetters, nil
+}
@@ -0,0 +1,146 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+// StoreCallFrames stores the flattened call frames of a transaction's trace. Previously stored frames are left unchanged.
+func (s Store) StoreCallFrames(ctx context.Context, chainID uint32, frames ...db.CallFrame) error {
+	if len(frames) == 0 {
+		return nil
+	}
+
+	dbFrames := make([]CallFrame, len(frames))
+	for i, frame := range frames {
+		dbFrames[i] = CallFrame{
+			ChainID:          chainID,
+			TxHash:           frame.TxHash.String(),
+			FrameIndex:       frame.Index,
+			TraceAddress:     formatTraceAddress(frame.TraceAddress),
+			Depth:            uint64(frame.Depth()),
+			CallType:         frame.Type,
+			FromAddress:      frame.From.String(),
+			ToAddress:        frame.To.String(),
+			Gas:              frame.Gas,
+			GasUsed:          frame.GasUsed,
+			Input:            frame.Input,
+			Output:           frame.Output,
+			Error:            frame.Error,
+			BlockNumber:      frame.BlockNumber,
+			BlockHash:        frame.BlockHash.String(),
+			TransactionIndex: frame.TransactionIndex,
+		}
+		if frame.Value != nil {
+			dbFrames[i].Value = frame.Value.String()
+		}
+	}
+
+	dbTx := s.insertIgnore(s.DB().WithContext(ctx), ChainIDFieldName, TxHashFieldName, FrameIndexFieldName).
+		CreateInBatches(&dbFrames, PageSize)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store call frames: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveCallFramesWithFilter retrieves call frames that match a filter given a page, latest transaction first.
+// The frames of a transaction are returned in trace order.
+func (s Store) RetrieveCallFramesWithFilter(ctx context.Context, callFrameFilter db.CallFrameFilter, page int) ([]db.CallFrame, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&CallFrame{}).
+		Where(&CallFrame{
+			ChainID:     callFrameFilter.ChainID,
+			TxHash:      callFrameFilter.TxHash,
+			FromAddress: callFrameFilter.FromAddress,
+			ToAddress:   callFrameFilter.ToAddress,
+		})
+	if callFrameFilter.StartBlock > 0 {
+		dbTx = dbTx.Where(fmt.Sprintf("%s >= ?", BlockNumberFieldName), callFrameFilter.StartBlock)
+	}
+	if callFrameFilter.EndBlock > 0 {
+		dbTx = dbTx.Where(fmt.Sprintf("%s <= ?", BlockNumberFieldName), callFrameFilter.EndBlock)
+	}
+
+	var dbFrames []CallFrame
+	dbTx = dbTx.
+		Order(fmt.Sprintf("%s desc, %s desc, %s asc", BlockNumberFieldName, TransactionIndexFieldName, FrameIndexFieldName)).
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Find(&dbFrames)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve call frames: %w", dbTx.Error)
+	}
+
+	frames := make([]db.CallFrame, len(dbFrames))
+	for i, dbFrame := range dbFrames {
+		traceAddress, err := parseTraceAddress(dbFrame.TraceAddress)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse trace address of frame %d of %s: %w", dbFrame.FrameIndex, dbFrame.TxHash, err)
+		}
+
+		frames[i] = db.CallFrame{
+			TxHash:           common.HexToHash(dbFrame.TxHash),
+			BlockNumber:      dbFrame.BlockNumber,
+			BlockHash:        common.HexToHash(dbFrame.BlockHash),
+			TransactionIndex: dbFrame.TransactionIndex,
+			Index:            dbFrame.FrameIndex,
+			TraceAddress:     traceAddress,
+			Type:             dbFrame.CallType,
+			From:             common.HexToAddress(dbFrame.FromAddress),
+			To:               common.HexToAddress(dbFrame.ToAddress),
+			Gas:              dbFrame.Gas,
+			GasUsed:          dbFrame.GasUsed,
+			Input:            dbFrame.Input,
+			Output:           dbFrame.Output,
+			Error:            dbFrame.Error,
+		}
+		if dbFrame.Value != "" {
+			value, ok := new(big.Int).SetString(dbFrame.Value, 10)
+			if !ok {
+				return nil, fmt.Errorf("could not parse value of frame %d of %s: %s", dbFrame.FrameIndex, dbFrame.TxHash, dbFrame.Value)
+			}
+			frames[i].Value = value
+		}
+	}
+
+	return frames, nil
+}
+
+// formatTraceAddress formats a trace address as a comma separated list.
+func formatTraceAddress(traceAddress []uint64) string {
+	positions := make([]string, len(traceAddress))
+	for i, position := range traceAddress {
+		positions[i] = strconv.FormatUint(position, 10)
+	}
+	return strings.Join(positions, ",")
+}
+
+// parseTraceAddress parses a trace address formatted with formatTraceAddress.
+func parseTraceAddress(traceAddress string) ([]uint64, error) {
+	if traceAddress == "" {
+		return []uint64{}, nil
+	}
+
+	positions := strings.Split(traceAddress, ",")
+	parsed := make([]uint64, len(positions))
+	for i, position := range positions {
+		value, err := strconv.ParseUint(position, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid position %s: %w", position, err)
+		}
+		parsed[i] = value
+	}
+	return parsed, nil
+}
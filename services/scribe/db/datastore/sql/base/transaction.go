@@ -19,15 +19,15 @@ func (s Store) StoreEthTx(ctx context.Context, tx *types.Transaction, chainID ui
 		return fmt.Errorf("could not marshall tx to binary: %w", err)
 	}
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() == dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	} else {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
 		})
-	} else {
-		dbTx = dbTx.Clauses(clause.Insert{
-			Modifier: "IGNORE",
-		})
 	}
 
 	dbTx = dbTx.Create(&EthTx{
@@ -82,6 +82,33 @@ func (s Store) DeleteEthTxsForBlockHash(ctx context.Context, blockHash common.Ha
 	return nil
 }
 
+// DeleteEthTxsForTxHashes deletes the eth txs with the given hashes.
+func (s Store) DeleteEthTxsForTxHashes(ctx context.Context, chainID uint32, txHashes ...common.Hash) error {
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Where(&EthTx{ChainID: chainID}).
+		Where(fmt.Sprintf("%s IN ?", TxHashFieldName), hashesToStrings(txHashes)).
+		Delete(&EthTx{})
+
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not delete eth txs: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// hashesToStrings converts hashes to their hex strings.
+func hashesToStrings(hashes []common.Hash) []string {
+	strs := make([]string, len(hashes))
+	for i, hash := range hashes {
+		strs[i] = hash.String()
+	}
+	return strs
+}
+
 // ethTxFilterToQuery converts an ethTxFilter to a database-type EthTx.
 // This is used to query with `WHERE` based on the filter.
 func ethTxFilterToQuery(ethTxFilter db.EthTxFilter) EthTx {
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
 	scribeLogger "github.com/synapsecns/sanguine/services/scribe/logger"
 	gormLogger "gorm.io/gorm/logger"
@@ -65,15 +66,11 @@ func NewMysqlStore(parentCtx context.Context, dbURL string, handler metrics.Hand
 	handler.AddGormCallbacks(gdb)
 
 	if !skipMigrations {
-		// migrate in a transaction since we skip this by default
-		err = gdb.Transaction(func(tx *gorm.DB) error {
-			//nolint: wrapcheck
-			return gdb.WithContext(ctx).AutoMigrate(base.GetAllModels()...)
-		})
-	}
-
-	if err != nil {
-		return nil, fmt.Errorf("could not migrate on mysql: %w", err)
+		// each migration is applied in its own transaction
+		err = dbcommon.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
+		if err != nil {
+			return nil, fmt.Errorf("could not migrate on mysql: %w", err)
+		}
 	}
 	return &Store{base.NewStore(gdb, handler)}, nil
 }
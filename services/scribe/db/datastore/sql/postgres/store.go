@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	scribeLogger "github.com/synapsecns/sanguine/services/scribe/logger"
+	gormLogger "gorm.io/gorm/logger"
+
+	"time"
+
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/base"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Store is the postgres store. It extends the base store for postgres specific queries.
+type Store struct {
+	*base.Store
+}
+
+// MaxIdleConns is exported here for testing. Tests execute too slowly with a reconnect each time.
+var MaxIdleConns = 10
+
+// MaxOpenConns is exported here for testing. This is kept under postgres' default max_connections of 100.
+var MaxOpenConns = 90
+
+// NamingStrategy is exported here for testing.
+var NamingStrategy = schema.NamingStrategy{
+	TablePrefix: "v3_",
+}
+
+// NewPostgresStore creates a new postgres store for a given data store.
+func NewPostgresStore(parentCtx context.Context, dbURL string, handler metrics.Handler, skipMigrations bool) (_ *Store, err error) {
+	logger.Debug("creating postgres store")
+	scribeLogger.ReportScribeState(0, 0, nil, scribeLogger.CreatingSQLStore)
+	ctx, span := handler.Tracer().Start(parentCtx, "start-postgres")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:                 gormLogger.Default.LogMode(gormLogger.Silent),
+		FullSaveAssociations:   true,
+		NamingStrategy:         NamingStrategy,
+		NowFunc:                time.Now,
+		SkipDefaultTransaction: true,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sql db: %w", err)
+	}
+
+	sqlDB.SetMaxIdleConns(MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(30 * time.Minute)
+	sqlDB.SetMaxOpenConns(MaxOpenConns)
+
+	handler.AddGormCallbacks(gdb)
+
+	if !skipMigrations {
+		// each migration is applied in its own transaction
+		err = dbcommon.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
+		if err != nil {
+			return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+		}
+	}
+	return &Store{base.NewStore(gdb, handler)}, nil
+}
+
+// var _ db.Service = &Store{}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	scribeLogger "github.com/synapsecns/sanguine/services/scribe/logger"
 	gormLogger "gorm.io/gorm/logger"
 
@@ -52,7 +53,7 @@ func NewSqliteStore(parentCtx context.Context, dbPath string, handler metrics.Ha
 	handler.AddGormCallbacks(gdb)
 
 	if !skipMigrations {
-		err = gdb.WithContext(ctx).AutoMigrate(base.GetAllModels()...)
+		err = dbcommon.RunMigrations(ctx, gdb, base.MigrationService, base.GetMigrations())
 		if err != nil {
 			return nil, fmt.Errorf("could not migrate models: %w", err)
 		}
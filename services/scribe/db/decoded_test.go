@@ -0,0 +1,66 @@
+package db_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveDecodedLogs() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		recipient := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		var decodedLogs []db.DecodedLog
+		for i := uint64(1); i <= 3; i++ {
+			log := t.buildLog(contractAddress, i)
+			log.Removed = false
+			Nil(t.T(), testDB.StoreLogs(t.GetTestContext(), chainID, log))
+
+			to := strings.ToLower(common.BigToAddress(big.NewInt(gofakeit.Int64())).Hex())
+			if i != 2 {
+				to = strings.ToLower(recipient.Hex())
+			}
+			decodedLogs = append(decodedLogs, db.DecodedLog{
+				Log:       log,
+				EventName: "Transfer",
+				Args:      map[string]string{"to": to, "value": "1"},
+			})
+		}
+		Nil(t.T(), testDB.StoreDecodedLogs(t.GetTestContext(), chainID, decodedLogs...))
+		// Storing decoded logs again is a no-op.
+		Nil(t.T(), testDB.StoreDecodedLogs(t.GetTestContext(), chainID, decodedLogs...))
+
+		decodedLogFilter := db.DecodedLogFilter{ChainID: chainID, EventName: "Transfer"}
+		retrievedLogs, err := testDB.RetrieveDecodedLogsWithFilter(t.GetTestContext(), decodedLogFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.DecodedLog{decodedLogs[2], decodedLogs[1], decodedLogs[0]}, retrievedLogs)
+
+		// Argument values are matched regardless of hex case.
+		decodedLogFilter.Args = map[string]string{"to": recipient.String(), "value": "1"}
+		retrievedLogs, err = testDB.RetrieveDecodedLogsWithFilter(t.GetTestContext(), decodedLogFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.DecodedLog{decodedLogs[2], decodedLogs[0]}, retrievedLogs)
+
+		decodedLogFilter.StartBlock = 2
+		retrievedLogs, err = testDB.RetrieveDecodedLogsWithFilter(t.GetTestContext(), decodedLogFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.DecodedLog{decodedLogs[2]}, retrievedLogs)
+
+		decodedLogFilter.Args = map[string]string{"value": "2"}
+		retrievedLogs, err = testDB.RetrieveDecodedLogsWithFilter(t.GetTestContext(), decodedLogFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 0, len(retrievedLogs))
+
+		// Decoded logs from orphaned blocks are removed.
+		Nil(t.T(), testDB.RemoveOrphanedBlocks(t.GetTestContext(), chainID, 3, []common.Hash{decodedLogs[2].Log.BlockHash}))
+		retrievedLogs, err = testDB.RetrieveDecodedLogsWithFilter(t.GetTestContext(), db.DecodedLogFilter{ChainID: chainID, EventName: "Transfer"}, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.DecodedLog{decodedLogs[1], decodedLogs[0]}, retrievedLogs)
+	})
+}
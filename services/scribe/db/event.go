@@ -4,6 +4,8 @@
 	"context"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"time"
 )
 
 // EventDBWriter is an interface for writing events to a database.
@@ -18,6 +20,8 @@ type EventDBWriter interface {
 	ConfirmLogsForBlockHash(ctx context.Context, chainID uint32, blockHash common.Hash) error
 	// DeleteLogsForBlockHash deletes logs with a given block hash.
 	DeleteLogsForBlockHash(ctx context.Context, blockHash common.Hash, chainID uint32) error
+	// RemoveLogs deletes logs and their decoded logs, keeping them as removed logs.
+	RemoveLogs(ctx context.Context, chainID uint32, logs ...types.Log) error
 
 	// StoreReceipt stores a receipt
 	StoreReceipt(ctx context.Context, chainID uint32, receipt types.Receipt) error
@@ -25,6 +29,8 @@ type EventDBWriter interface {
 	StoreReceiptAtHead(ctx context.Context, chainID uint32, receipt types.Receipt) error
 	// DeleteReceiptsForBlockHash deletes receipts with a given block hash.
 	DeleteReceiptsForBlockHash(ctx context.Context, chainID uint32, blockHash common.Hash) error
+	// DeleteReceiptsForTxHashes deletes the receipts of the given transactions.
+	DeleteReceiptsForTxHashes(ctx context.Context, chainID uint32, txHashes ...common.Hash) error
 
 	// StoreEthTx stores a processed transaction
 	StoreEthTx(ctx context.Context, tx *types.Transaction, chainID uint32, blockHash common.Hash, blockNumber uint64, transactionIndex uint64) error
@@ -34,6 +40,8 @@ type EventDBWriter interface {
 	ConfirmEthTxsForBlockHash(ctx context.Context, blockHash common.Hash, chainID uint32) error
 	// DeleteEthTxsForBlockHash deletes eth txs with a given block hash.
 	DeleteEthTxsForBlockHash(ctx context.Context, blockHash common.Hash, chainID uint32) error
+	// DeleteEthTxsForTxHashes deletes the eth txs with the given hashes.
+	DeleteEthTxsForTxHashes(ctx context.Context, chainID uint32, txHashes ...common.Hash) error
 
 	// StoreLastIndexed stores the last indexed for a contract address
 	StoreLastIndexed(ctx context.Context, contractAddress common.Address, chainID uint32, blockNumber uint64, livefillAtHead bool) error
@@ -47,6 +55,31 @@ type EventDBWriter interface {
 
 	// StoreBlockTime stores a block time for a chain.
 	StoreBlockTime(ctx context.Context, chainID uint32, blockNumber, timestamp uint64) error
+
+	// StoreBlockHash stores the hash of an indexed block.
+	StoreBlockHash(ctx context.Context, chainID uint32, blockNumber uint64, blockHash common.Hash) error
+	// RemoveOrphanedBlocks deletes data indexed from orphaned blocks and rewinds last indexed to before fromBlock.
+	RemoveOrphanedBlocks(ctx context.Context, chainID uint32, fromBlock uint64, blockHashes []common.Hash) error
+
+	// StoreFactoryChild stores a contract discovered from a factory. Previously stored children are left unchanged.
+	StoreFactoryChild(ctx context.Context, chainID uint32, child FactoryChild) error
+
+	// StoreDecodedLogs stores logs decoded with their contract's ABI.
+	StoreDecodedLogs(ctx context.Context, chainID uint32, logs ...DecodedLog) error
+
+	// StoreIndexerProgress stores the indexing progress of contracts, replacing their previous progress.
+	StoreIndexerProgress(ctx context.Context, progress ...IndexerProgress) error
+	// RemoveIndexerProgress deletes the indexing progress of contracts on a chain, or of every contract on the chain if
+	// no addresses are given.
+	RemoveIndexerProgress(ctx context.Context, chainID uint32, contractAddresses ...common.Address) error
+
+	// StoreCallFrames stores the flattened call frames of a transaction's trace. Previously stored frames are left unchanged.
+	StoreCallFrames(ctx context.Context, chainID uint32, frames ...CallFrame) error
+
+	// StoreSinkCursor stores the delivery cursor of a sink on a chain, replacing the previous cursor.
+	StoreSinkCursor(ctx context.Context, sinkName string, chainID uint32, cursor SinkCursor) error
+	// StoreDeadLetters stores logs a sink failed to deliver.
+	StoreDeadLetters(ctx context.Context, deadLetters ...DeadLetter) error
 }
 
 // EventDBReader is an interface for reading events from a database.
@@ -80,6 +113,8 @@ type EventDBReader interface {
 
 	// RetrieveBlockTime retrieves a block time for a chain and block number.
 	RetrieveBlockTime(ctx context.Context, chainID uint32, blockNumber uint64) (uint64, error)
+	// RetrieveBlockTimesInRange retrieves the stored block times of a chain within a range, keyed by block number.
+	RetrieveBlockTimesInRange(ctx context.Context, chainID uint32, startBlock, endBlock uint64) (map[uint64]uint64, error)
 	// RetrieveLastBlockStored retrieves the last block number that has a stored block time.
 	RetrieveLastBlockStored(ctx context.Context, chainID uint32) (uint64, error)
 	// RetrieveFirstBlockStored retrieves the first block number that has a stored block time.
@@ -100,6 +135,30 @@ type EventDBReader interface {
 	// RetrieveUnconfirmedEthTxsFromHeadRangeQuery retrieves all unconfirmed ethTx for a given chain ID and range.
 	RetrieveUnconfirmedEthTxsFromHeadRangeQuery(ctx context.Context, receiptFilter EthTxFilter, startBlock uint64, endBlock uint64, lastIndexed uint64, page int) ([]TxWithBlockNumber, error)
 
+	// RetrieveBlockHashesInRange retrieves the stored hashes of indexed blocks within a range.
+	RetrieveBlockHashesInRange(ctx context.Context, chainID uint32, startBlock, endBlock uint64) (map[uint64]common.Hash, error)
+	// RetrieveRemovedLogs retrieves logs removed by reorgs that match a filter and were removed after the given id.
+	RetrieveRemovedLogs(ctx context.Context, logFilter LogFilter, afterID uint64, page int) ([]RemovedLog, error)
+	// RetrieveLastRemovedLogID retrieves the id of the most recently removed log on a chain.
+	RetrieveLastRemovedLogID(ctx context.Context, chainID uint32) (uint64, error)
+
+	// RetrieveFactoryChildren retrieves all contracts discovered from factories on a chain.
+	RetrieveFactoryChildren(ctx context.Context, chainID uint32) ([]FactoryChild, error)
+
+	// RetrieveDecodedLogsWithFilter retrieves decoded logs that match a filter given a page, latest first.
+	RetrieveDecodedLogsWithFilter(ctx context.Context, decodedLogFilter DecodedLogFilter, page int) ([]DecodedLog, error)
+
+	// RetrieveIndexerProgress retrieves the indexing progress of the contracts on a chain, or on every chain if the chain id is 0.
+	RetrieveIndexerProgress(ctx context.Context, chainID uint32) ([]IndexerProgress, error)
+
+	// RetrieveCallFramesWithFilter retrieves call frames that match a filter given a page, latest transaction first.
+	RetrieveCallFramesWithFilter(ctx context.Context, callFrameFilter CallFrameFilter, page int) ([]CallFrame, error)
+
+	// RetrieveSinkCursor retrieves the delivery cursor of a sink on a chain, nil if the sink has no cursor.
+	RetrieveSinkCursor(ctx context.Context, sinkName string, chainID uint32) (*SinkCursor, error)
+	// RetrieveDeadLetters retrieves the logs a sink failed to deliver given a page, oldest first.
+	RetrieveDeadLetters(ctx context.Context, sinkName string, page int) ([]DeadLetter, error)
+
 	// FlushFromHeadTables flushes unconfirmed logs, receipts, and txs from the head.
 	FlushFromHeadTables(ctx context.Context, time int64) error
 }
@@ -117,3 +176,127 @@ type TxWithBlockNumber struct {
 	Tx          types.Transaction
 	BlockNumber uint64
 }
+
+// RemovedLog is a log that was removed by a reorg, along with its removal id.
+type RemovedLog struct {
+	ID  uint64
+	Log *types.Log
+}
+
+// FactoryChild is a contract discovered from a factory's creation event.
+type FactoryChild struct {
+	FactoryAddress  common.Address
+	ContractAddress common.Address
+	// BlockNumber is the block the contract was created in.
+	BlockNumber uint64
+}
+
+// DecodedLog is a log decoded with its contract's ABI.
+type DecodedLog struct {
+	Log types.Log
+	// EventName is the name of the event.
+	EventName string
+	// Args are the event's arguments keyed by name, formatted with types.FormatArg.
+	Args map[string]string
+}
+
+// IndexingMode is how a contract is being indexed.
+type IndexingMode string
+
+const (
+	// BackfillMode is used while a contract is indexed to the livefill threshold on its own.
+	BackfillMode IndexingMode = "backfill"
+	// LivefillMode is used once a contract is indexed with the other contracts near the head.
+	LivefillMode IndexingMode = "livefill"
+)
+
+// IndexingRate is the number of blocks indexed per second over a recent window.
+type IndexingRate struct {
+	Window          time.Duration
+	BlocksPerSecond float64
+}
+
+// IndexerProgress is the indexing progress of a contract.
+type IndexerProgress struct {
+	ChainID         uint32
+	ContractAddress common.Address
+	Mode            IndexingMode
+	// CurrentBlock is the last block indexed.
+	CurrentBlock uint64
+	// TargetBlock is the block the contract is being indexed to.
+	TargetBlock uint64
+	// HeadBlock is the latest confirmed block of the chain.
+	HeadBlock uint64
+	// Rates are the indexing rates over recent windows, shortest first.
+	Rates []IndexingRate
+	// ETA is the estimated time to reach the target block, nil if it can't be estimated.
+	ETA *time.Duration
+	// LastError is the last error encountered while indexing the contract.
+	LastError string
+	// LastErrorAt is when the last error was encountered, nil if there was none.
+	LastErrorAt *time.Time
+	// LastProgressAt is when the current block last advanced.
+	LastProgressAt time.Time
+	// UpdatedAt is when the progress was recorded.
+	UpdatedAt time.Time
+}
+
+// Lag is the number of blocks the contract is behind the head.
+func (p IndexerProgress) Lag() uint64 {
+	if p.HeadBlock <= p.CurrentBlock {
+		return 0
+	}
+	return p.HeadBlock - p.CurrentBlock
+}
+
+// CallFrame is a call made while executing a transaction, flattened from the transaction's call trace.
+type CallFrame struct {
+	TxHash           common.Hash
+	BlockNumber      uint64
+	BlockHash        common.Hash
+	TransactionIndex uint64
+	// Index is the position of the frame in a depth first walk of the trace, 0 for the top level call.
+	Index uint64
+	// TraceAddress is the path to the frame from the top level call, e.g. [1, 0] is the first call made by the top
+	// level call's second call. It is empty for the top level call.
+	TraceAddress []uint64
+	// Type is the call type, e.g. CALL, DELEGATECALL, STATICCALL or CREATE.
+	Type  string
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Gas   uint64
+	// GasUsed is the gas used by the frame, including the frames it called.
+	GasUsed uint64
+	Input   []byte
+	Output  []byte
+	// Error is the error the frame reverted with, empty if it succeeded.
+	Error string
+}
+
+// Depth is the depth of the frame in the trace, 0 for the top level call.
+func (c CallFrame) Depth() int {
+	return len(c.TraceAddress)
+}
+
+// SinkCursor is the position of the last log delivered to a sink on a chain. Logs are delivered in
+// (block number, tx index, log index) order.
+type SinkCursor struct {
+	BlockNumber uint64
+	TxIndex     uint64
+	Index       uint64
+	// RemovedID is the id of the last log removed by a reorg that the sink has handled.
+	RemovedID uint64
+}
+
+// DeadLetter is a log a sink failed to deliver.
+type DeadLetter struct {
+	ID       uint64
+	SinkName string
+	ChainID  uint32
+	// Log is the log that failed to be delivered. Removed is set if it was a reorg removal.
+	Log types.Log
+	// Error is the error of the last delivery attempt.
+	Error    string
+	FailedAt time.Time
+}
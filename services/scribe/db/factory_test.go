@@ -0,0 +1,48 @@
+package db_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveFactoryChildren() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		factoryAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		children, err := testDB.RetrieveFactoryChildren(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), 0, len(children))
+
+		childA := db.FactoryChild{
+			FactoryAddress:  factoryAddress,
+			ContractAddress: common.BigToAddress(big.NewInt(gofakeit.Int64())),
+			BlockNumber:     20,
+		}
+		childB := db.FactoryChild{
+			FactoryAddress:  factoryAddress,
+			ContractAddress: common.BigToAddress(big.NewInt(gofakeit.Int64())),
+			BlockNumber:     10,
+		}
+		for _, child := range []db.FactoryChild{childA, childB} {
+			err = testDB.StoreFactoryChild(t.GetTestContext(), chainID, child)
+			Nil(t.T(), err)
+		}
+
+		// Storing a child again is a no-op.
+		err = testDB.StoreFactoryChild(t.GetTestContext(), chainID, childA)
+		Nil(t.T(), err)
+
+		// A child on another chain is not retrieved.
+		err = testDB.StoreFactoryChild(t.GetTestContext(), chainID+1, childA)
+		Nil(t.T(), err)
+
+		children, err = testDB.RetrieveFactoryChildren(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.FactoryChild{childB, childA}, children)
+	})
+}
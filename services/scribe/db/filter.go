@@ -1,7 +1,7 @@
 package db
 
 // BuildLogFilter builds a log filter from nullable parameters.
-func BuildLogFilter(contractAddress *string, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool) LogFilter {
+func BuildLogFilter(contractAddress *string, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, topics [][]string) LogFilter {
 	logFilter := LogFilter{}
 	if contractAddress != nil {
 		logFilter.ContractAddress = *contractAddress
@@ -24,6 +24,7 @@ func BuildLogFilter(contractAddress *string, blockNumber *int, txHash *string, t
 	if confirmed != nil {
 		logFilter.Confirmed = *confirmed
 	}
+	logFilter.Topics = topics
 	return logFilter
 }
 
@@ -68,3 +69,42 @@ func BuildEthTxFilter(txHash *string, blockNumber *int, blockHash *string, confi
 	}
 	return ethTxFilter
 }
+
+// BuildDecodedLogFilter builds a decoded log filter from nullable parameters.
+func BuildDecodedLogFilter(contractAddress *string, eventName string, startBlock *int, endBlock *int) DecodedLogFilter {
+	decodedLogFilter := DecodedLogFilter{
+		EventName: eventName,
+		Args:      make(map[string]string),
+	}
+	if contractAddress != nil {
+		decodedLogFilter.ContractAddress = *contractAddress
+	}
+	if startBlock != nil {
+		decodedLogFilter.StartBlock = uint64(*startBlock)
+	}
+	if endBlock != nil {
+		decodedLogFilter.EndBlock = uint64(*endBlock)
+	}
+	return decodedLogFilter
+}
+
+// BuildCallFrameFilter builds a call frame filter from nullable parameters.
+func BuildCallFrameFilter(txHash *string, fromAddress *string, toAddress *string, startBlock *int, endBlock *int) CallFrameFilter {
+	callFrameFilter := CallFrameFilter{}
+	if txHash != nil {
+		callFrameFilter.TxHash = *txHash
+	}
+	if fromAddress != nil {
+		callFrameFilter.FromAddress = *fromAddress
+	}
+	if toAddress != nil {
+		callFrameFilter.ToAddress = *toAddress
+	}
+	if startBlock != nil {
+		callFrameFilter.StartBlock = uint64(*startBlock)
+	}
+	if endBlock != nil {
+		callFrameFilter.EndBlock = uint64(*endBlock)
+	}
+	return callFrameFilter
+}
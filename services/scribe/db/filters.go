@@ -10,6 +10,33 @@ type LogFilter struct {
 	BlockHash       string
 	Index           uint64
 	Confirmed       bool
+	// Topics filters logs by topic. Each position matches any of its values, and empty positions match any topic.
+	Topics [][]string
+	// ContractAddresses filters logs to those of any of the contracts.
+	ContractAddresses []string
+}
+
+// DecodedLogFilter is a filter to use when querying the database for decoded logs.
+type DecodedLogFilter struct {
+	ChainID         uint32
+	ContractAddress string
+	EventName       string
+	// Args filters decoded logs by argument values, keyed by argument name. All arguments must match.
+	Args map[string]string
+	// StartBlock and EndBlock optionally restrict the block range. An EndBlock of 0 means no upper bound.
+	StartBlock uint64
+	EndBlock   uint64
+}
+
+// CallFrameFilter is a filter to use when querying the database for call frames.
+type CallFrameFilter struct {
+	ChainID     uint32
+	TxHash      string
+	FromAddress string
+	ToAddress   string
+	// StartBlock and EndBlock optionally restrict the block range. An EndBlock of 0 means no upper bound.
+	StartBlock uint64
+	EndBlock   uint64
 }
 
 // ReceiptFilter is a filter to use when querying the database for receipts.
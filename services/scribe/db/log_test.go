@@ -2,6 +2,7 @@
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/synapsecns/sanguine/services/scribe/db"
 
@@ -104,6 +105,64 @@ func (t *DBSuite) TestDeleteLogsForBlockHash() {
 	})
 }
 
+func (t *DBSuite) TestRetrieveLogsWithTopics() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		sender := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		logA := t.buildLog(contractAddress, 1)
+		logA.Topics[1] = common.BytesToHash(sender.Bytes())
+		logB := t.buildLog(contractAddress, 2)
+		logB.Topics[0] = logA.Topics[0]
+		logC := t.buildLog(contractAddress, 3)
+		err := testDB.StoreLogs(t.GetTestContext(), chainID, logA, logB, logC)
+		Nil(t.T(), err)
+
+		// Each position matches any of its values.
+		logFilter := db.LogFilter{ChainID: chainID, Topics: [][]string{{logA.Topics[0].String(), logC.Topics[0].String()}}}
+		retrievedLogs, err := testDB.RetrieveLogsInRangeAsc(t.GetTestContext(), logFilter, 1, 3, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 3, len(retrievedLogs))
+
+		// Indexed arguments can be filtered by address, and empty positions match anything.
+		logFilter.Topics = [][]string{{logA.Topics[0].String()}, {sender.String()}}
+		retrievedLogs, err = testDB.RetrieveLogsWithFilter(t.GetTestContext(), logFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(retrievedLogs))
+		Equal(t.T(), logA.BlockNumber, retrievedLogs[0].BlockNumber)
+
+		logFilter.Topics = [][]string{{}, {}, {logB.Topics[2].String()}}
+		retrievedLogs, err = testDB.RetrieveLogsWithFilter(t.GetTestContext(), logFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(retrievedLogs))
+		Equal(t.T(), logB.BlockNumber, retrievedLogs[0].BlockNumber)
+
+		logFilter.Topics = [][]string{{"not a topic"}}
+		_, err = testDB.RetrieveLogsWithFilter(t.GetTestContext(), logFilter, 1)
+		NotNil(t.T(), err)
+	})
+}
+
+func (t *DBSuite) TestRetrieveLogsWithContractAddresses() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractA := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		contractB := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		contractC := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		err := testDB.StoreLogs(t.GetTestContext(), chainID, t.buildLog(contractA, 1), t.buildLog(contractB, 2), t.buildLog(contractC, 3))
+		Nil(t.T(), err)
+
+		logFilter := db.LogFilter{ChainID: chainID, ContractAddresses: []string{contractA.String(), strings.ToLower(contractC.String())}}
+		retrievedLogs, err := testDB.RetrieveLogsInRangeAsc(t.GetTestContext(), logFilter, 1, 3, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 2, len(retrievedLogs))
+		Equal(t.T(), contractA, retrievedLogs[0].Address)
+		Equal(t.T(), contractC, retrievedLogs[1].Address)
+	})
+}
+
 func (t *DBSuite) TestLogCount() {
 	t.RunOnAllDBs(func(testDB db.EventDB) {
 		chainID := gofakeit.Uint32()
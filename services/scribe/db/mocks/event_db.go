@@ -61,6 +61,27 @@ func (_m *EventDB) DeleteEthTxsForBlockHash(ctx context.Context, blockHash commo
 	return r0
 }
 
+// DeleteEthTxsForTxHashes provides a mock function with given fields: ctx, chainID, txHashes
+func (_m *EventDB) DeleteEthTxsForTxHashes(ctx context.Context, chainID uint32, txHashes ...common.Hash) error {
+	_va := make([]interface{}, len(txHashes))
+	for _i := range txHashes {
+		_va[_i] = txHashes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...common.Hash) error); ok {
+		r0 = rf(ctx, chainID, txHashes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteLogsForBlockHash provides a mock function with given fields: ctx, blockHash, chainID
 func (_m *EventDB) DeleteLogsForBlockHash(ctx context.Context, blockHash common.Hash, chainID uint32) error {
 	ret := _m.Called(ctx, blockHash, chainID)
@@ -89,6 +110,27 @@ func (_m *EventDB) DeleteReceiptsForBlockHash(ctx context.Context, chainID uint3
 	return r0
 }
 
+// DeleteReceiptsForTxHashes provides a mock function with given fields: ctx, chainID, txHashes
+func (_m *EventDB) DeleteReceiptsForTxHashes(ctx context.Context, chainID uint32, txHashes ...common.Hash) error {
+	_va := make([]interface{}, len(txHashes))
+	for _i := range txHashes {
+		_va[_i] = txHashes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...common.Hash) error); ok {
+		r0 = rf(ctx, chainID, txHashes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FlushFromHeadTables provides a mock function with given fields: ctx, time
 func (_m *EventDB) FlushFromHeadTables(ctx context.Context, time int64) error {
 	ret := _m.Called(ctx, time)
@@ -103,6 +145,85 @@ func (_m *EventDB) FlushFromHeadTables(ctx context.Context, time int64) error {
 	return r0
 }
 
+// RemoveIndexerProgress provides a mock function with given fields: ctx, chainID, contractAddresses
+func (_m *EventDB) RemoveIndexerProgress(ctx context.Context, chainID uint32, contractAddresses ...common.Address) error {
+	_va := make([]interface{}, len(contractAddresses))
+	for _i := range contractAddresses {
+		_va[_i] = contractAddresses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...common.Address) error); ok {
+		r0 = rf(ctx, chainID, contractAddresses...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveLogs provides a mock function with given fields: ctx, chainID, logs
+func (_m *EventDB) RemoveLogs(ctx context.Context, chainID uint32, logs ...types.Log) error {
+	_va := make([]interface{}, len(logs))
+	for _i := range logs {
+		_va[_i] = logs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...types.Log) error); ok {
+		r0 = rf(ctx, chainID, logs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveOrphanedBlocks provides a mock function with given fields: ctx, chainID, fromBlock, blockHashes
+func (_m *EventDB) RemoveOrphanedBlocks(ctx context.Context, chainID uint32, fromBlock uint64, blockHashes []common.Hash) error {
+	ret := _m.Called(ctx, chainID, fromBlock, blockHashes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, []common.Hash) error); ok {
+		r0 = rf(ctx, chainID, fromBlock, blockHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RetrieveBlockHashesInRange provides a mock function with given fields: ctx, chainID, startBlock, endBlock
+func (_m *EventDB) RetrieveBlockHashesInRange(ctx context.Context, chainID uint32, startBlock uint64, endBlock uint64) (map[uint64]common.Hash, error) {
+	ret := _m.Called(ctx, chainID, startBlock, endBlock)
+
+	var r0 map[uint64]common.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64) map[uint64]common.Hash); ok {
+		r0 = rf(ctx, chainID, startBlock, endBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]common.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64, uint64) error); ok {
+		r1 = rf(ctx, chainID, startBlock, endBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveBlockTime provides a mock function with given fields: ctx, chainID, blockNumber
 func (_m *EventDB) RetrieveBlockTime(ctx context.Context, chainID uint32, blockNumber uint64) (uint64, error) {
 	ret := _m.Called(ctx, chainID, blockNumber)
@@ -145,6 +266,98 @@ func (_m *EventDB) RetrieveBlockTimesCountForChain(ctx context.Context, chainID
 	return r0, r1
 }
 
+// RetrieveBlockTimesInRange provides a mock function with given fields: ctx, chainID, startBlock, endBlock
+func (_m *EventDB) RetrieveBlockTimesInRange(ctx context.Context, chainID uint32, startBlock uint64, endBlock uint64) (map[uint64]uint64, error) {
+	ret := _m.Called(ctx, chainID, startBlock, endBlock)
+
+	var r0 map[uint64]uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64) map[uint64]uint64); ok {
+		r0 = rf(ctx, chainID, startBlock, endBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]uint64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64, uint64) error); ok {
+		r1 = rf(ctx, chainID, startBlock, endBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveCallFramesWithFilter provides a mock function with given fields: ctx, callFrameFilter, page
+func (_m *EventDB) RetrieveCallFramesWithFilter(ctx context.Context, callFrameFilter db.CallFrameFilter, page int) ([]db.CallFrame, error) {
+	ret := _m.Called(ctx, callFrameFilter, page)
+
+	var r0 []db.CallFrame
+	if rf, ok := ret.Get(0).(func(context.Context, db.CallFrameFilter, int) []db.CallFrame); ok {
+		r0 = rf(ctx, callFrameFilter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.CallFrame)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.CallFrameFilter, int) error); ok {
+		r1 = rf(ctx, callFrameFilter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveDeadLetters provides a mock function with given fields: ctx, sinkName, page
+func (_m *EventDB) RetrieveDeadLetters(ctx context.Context, sinkName string, page int) ([]db.DeadLetter, error) {
+	ret := _m.Called(ctx, sinkName, page)
+
+	var r0 []db.DeadLetter
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []db.DeadLetter); ok {
+		r0 = rf(ctx, sinkName, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.DeadLetter)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, sinkName, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveDecodedLogsWithFilter provides a mock function with given fields: ctx, decodedLogFilter, page
+func (_m *EventDB) RetrieveDecodedLogsWithFilter(ctx context.Context, decodedLogFilter db.DecodedLogFilter, page int) ([]db.DecodedLog, error) {
+	ret := _m.Called(ctx, decodedLogFilter, page)
+
+	var r0 []db.DecodedLog
+	if rf, ok := ret.Get(0).(func(context.Context, db.DecodedLogFilter, int) []db.DecodedLog); ok {
+		r0 = rf(ctx, decodedLogFilter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.DecodedLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.DecodedLogFilter, int) error); ok {
+		r1 = rf(ctx, decodedLogFilter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveEthTxsInRange provides a mock function with given fields: ctx, ethTxFilter, startBlock, endBlock, page
 func (_m *EventDB) RetrieveEthTxsInRange(ctx context.Context, ethTxFilter db.EthTxFilter, startBlock uint64, endBlock uint64, page int) ([]db.TxWithBlockNumber, error) {
 	ret := _m.Called(ctx, ethTxFilter, startBlock, endBlock, page)
@@ -191,6 +404,29 @@ func (_m *EventDB) RetrieveEthTxsWithFilter(ctx context.Context, ethTxFilter db.
 	return r0, r1
 }
 
+// RetrieveFactoryChildren provides a mock function with given fields: ctx, chainID
+func (_m *EventDB) RetrieveFactoryChildren(ctx context.Context, chainID uint32) ([]db.FactoryChild, error) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 []db.FactoryChild
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) []db.FactoryChild); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FactoryChild)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveFirstBlockStored provides a mock function with given fields: ctx, chainID
 func (_m *EventDB) RetrieveFirstBlockStored(ctx context.Context, chainID uint32) (uint64, error) {
 	ret := _m.Called(ctx, chainID)
@@ -212,6 +448,29 @@ func (_m *EventDB) RetrieveFirstBlockStored(ctx context.Context, chainID uint32)
 	return r0, r1
 }
 
+// RetrieveIndexerProgress provides a mock function with given fields: ctx, chainID
+func (_m *EventDB) RetrieveIndexerProgress(ctx context.Context, chainID uint32) ([]db.IndexerProgress, error) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 []db.IndexerProgress
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) []db.IndexerProgress); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.IndexerProgress)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveLastBlockStored provides a mock function with given fields: ctx, chainID
 func (_m *EventDB) RetrieveLastBlockStored(ctx context.Context, chainID uint32) (uint64, error) {
 	ret := _m.Called(ctx, chainID)
@@ -298,6 +557,27 @@ func (_m *EventDB) RetrieveLastIndexedMultiple(ctx context.Context, contractAddr
 	return r0, r1
 }
 
+// RetrieveLastRemovedLogID provides a mock function with given fields: ctx, chainID
+func (_m *EventDB) RetrieveLastRemovedLogID(ctx context.Context, chainID uint32) (uint64, error) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) uint64); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveLogCountForContract provides a mock function with given fields: ctx, contractAddress, chainID
 func (_m *EventDB) RetrieveLogCountForContract(ctx context.Context, contractAddress common.Address, chainID uint32) (int64, error) {
 	ret := _m.Called(ctx, contractAddress, chainID)
@@ -524,6 +804,52 @@ func (_m *EventDB) RetrieveReceiptsWithStaleBlockHash(ctx context.Context, chain
 	return r0, r1
 }
 
+// RetrieveRemovedLogs provides a mock function with given fields: ctx, logFilter, afterID, page
+func (_m *EventDB) RetrieveRemovedLogs(ctx context.Context, logFilter db.LogFilter, afterID uint64, page int) ([]db.RemovedLog, error) {
+	ret := _m.Called(ctx, logFilter, afterID, page)
+
+	var r0 []db.RemovedLog
+	if rf, ok := ret.Get(0).(func(context.Context, db.LogFilter, uint64, int) []db.RemovedLog); ok {
+		r0 = rf(ctx, logFilter, afterID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.RemovedLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.LogFilter, uint64, int) error); ok {
+		r1 = rf(ctx, logFilter, afterID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveSinkCursor provides a mock function with given fields: ctx, sinkName, chainID
+func (_m *EventDB) RetrieveSinkCursor(ctx context.Context, sinkName string, chainID uint32) (*db.SinkCursor, error) {
+	ret := _m.Called(ctx, sinkName, chainID)
+
+	var r0 *db.SinkCursor
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint32) *db.SinkCursor); ok {
+		r0 = rf(ctx, sinkName, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.SinkCursor)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint32) error); ok {
+		r1 = rf(ctx, sinkName, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveUnconfirmedEthTxsFromHeadRangeQuery provides a mock function with given fields: ctx, receiptFilter, startBlock, endBlock, lastIndexed, page
 func (_m *EventDB) RetrieveUnconfirmedEthTxsFromHeadRangeQuery(ctx context.Context, receiptFilter db.EthTxFilter, startBlock uint64, endBlock uint64, lastIndexed uint64, page int) ([]db.TxWithBlockNumber, error) {
 	ret := _m.Called(ctx, receiptFilter, startBlock, endBlock, lastIndexed, page)
@@ -547,6 +873,20 @@ func (_m *EventDB) RetrieveUnconfirmedEthTxsFromHeadRangeQuery(ctx context.Conte
 	return r0, r1
 }
 
+// StoreBlockHash provides a mock function with given fields: ctx, chainID, blockNumber, blockHash
+func (_m *EventDB) StoreBlockHash(ctx context.Context, chainID uint32, blockNumber uint64, blockHash common.Hash) error {
+	ret := _m.Called(ctx, chainID, blockNumber, blockHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, common.Hash) error); ok {
+		r0 = rf(ctx, chainID, blockNumber, blockHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreBlockTime provides a mock function with given fields: ctx, chainID, blockNumber, timestamp
 func (_m *EventDB) StoreBlockTime(ctx context.Context, chainID uint32, blockNumber uint64, timestamp uint64) error {
 	ret := _m.Called(ctx, chainID, blockNumber, timestamp)
@@ -561,6 +901,69 @@ func (_m *EventDB) StoreBlockTime(ctx context.Context, chainID uint32, blockNumb
 	return r0
 }
 
+// StoreCallFrames provides a mock function with given fields: ctx, chainID, frames
+func (_m *EventDB) StoreCallFrames(ctx context.Context, chainID uint32, frames ...db.CallFrame) error {
+	_va := make([]interface{}, len(frames))
+	for _i := range frames {
+		_va[_i] = frames[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...db.CallFrame) error); ok {
+		r0 = rf(ctx, chainID, frames...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreDeadLetters provides a mock function with given fields: ctx, deadLetters
+func (_m *EventDB) StoreDeadLetters(ctx context.Context, deadLetters ...db.DeadLetter) error {
+	_va := make([]interface{}, len(deadLetters))
+	for _i := range deadLetters {
+		_va[_i] = deadLetters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...db.DeadLetter) error); ok {
+		r0 = rf(ctx, deadLetters...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreDecodedLogs provides a mock function with given fields: ctx, chainID, logs
+func (_m *EventDB) StoreDecodedLogs(ctx context.Context, chainID uint32, logs ...db.DecodedLog) error {
+	_va := make([]interface{}, len(logs))
+	for _i := range logs {
+		_va[_i] = logs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...db.DecodedLog) error); ok {
+		r0 = rf(ctx, chainID, logs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreEthTx provides a mock function with given fields: ctx, tx, chainID, blockHash, blockNumber, transactionIndex
 func (_m *EventDB) StoreEthTx(ctx context.Context, tx *types.Transaction, chainID uint32, blockHash common.Hash, blockNumber uint64, transactionIndex uint64) error {
 	ret := _m.Called(ctx, tx, chainID, blockHash, blockNumber, transactionIndex)
@@ -589,6 +992,41 @@ func (_m *EventDB) StoreEthTxAtHead(ctx context.Context, tx *types.Transaction,
 	return r0
 }
 
+// StoreFactoryChild provides a mock function with given fields: ctx, chainID, child
+func (_m *EventDB) StoreFactoryChild(ctx context.Context, chainID uint32, child db.FactoryChild) error {
+	ret := _m.Called(ctx, chainID, child)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, db.FactoryChild) error); ok {
+		r0 = rf(ctx, chainID, child)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreIndexerProgress provides a mock function with given fields: ctx, progress
+func (_m *EventDB) StoreIndexerProgress(ctx context.Context, progress ...db.IndexerProgress) error {
+	_va := make([]interface{}, len(progress))
+	for _i := range progress {
+		_va[_i] = progress[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...db.IndexerProgress) error); ok {
+		r0 = rf(ctx, progress...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreLastConfirmedBlock provides a mock function with given fields: ctx, chainID, blockNumber
 func (_m *EventDB) StoreLastConfirmedBlock(ctx context.Context, chainID uint32, blockNumber uint64) error {
 	ret := _m.Called(ctx, chainID, blockNumber)
@@ -701,6 +1139,20 @@ func (_m *EventDB) StoreReceiptAtHead(ctx context.Context, chainID uint32, recei
 	return r0
 }
 
+// StoreSinkCursor provides a mock function with given fields: ctx, sinkName, chainID, cursor
+func (_m *EventDB) StoreSinkCursor(ctx context.Context, sinkName string, chainID uint32, cursor db.SinkCursor) error {
+	ret := _m.Called(ctx, sinkName, chainID, cursor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint32, db.SinkCursor) error); ok {
+		r0 = rf(ctx, sinkName, chainID, cursor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewEventDB interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,85 @@
+package db_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveIndexerProgress() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		eta := 90 * time.Second
+		lastErrorAt := time.Unix(1000, 0)
+
+		backfilling := db.IndexerProgress{
+			ChainID:         chainID,
+			ContractAddress: common.BigToAddress(big.NewInt(1)),
+			Mode:            db.BackfillMode,
+			CurrentBlock:    100,
+			TargetBlock:     1000,
+			HeadBlock:       1000,
+			Rates:           []db.IndexingRate{{Window: time.Minute, BlocksPerSecond: 10}, {Window: 5 * time.Minute, BlocksPerSecond: 2.5}},
+			ETA:             &eta,
+			LastError:       "could not get logs",
+			LastErrorAt:     &lastErrorAt,
+			LastProgressAt:  time.Unix(1100, 0),
+			UpdatedAt:       time.Unix(1200, 0),
+		}
+		livefilling := db.IndexerProgress{
+			ChainID:         chainID,
+			ContractAddress: common.BigToAddress(big.NewInt(2)),
+			Mode:            db.LivefillMode,
+			CurrentBlock:    999,
+			TargetBlock:     1000,
+			HeadBlock:       1000,
+			Rates:           []db.IndexingRate{},
+			LastProgressAt:  time.Unix(1100, 0),
+			UpdatedAt:       time.Unix(1200, 0),
+		}
+		otherChain := livefilling
+		otherChain.ChainID = chainID + 1
+
+		err := testDB.StoreIndexerProgress(t.GetTestContext(), livefilling, backfilling, otherChain)
+		Nil(t.T(), err)
+
+		progress, err := testDB.RetrieveIndexerProgress(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.IndexerProgress{backfilling, livefilling}, progress)
+		Equal(t.T(), uint64(900), progress[0].Lag())
+
+		// Storing progress again replaces it.
+		backfilling.CurrentBlock = 500
+		backfilling.ETA = nil
+		err = testDB.StoreIndexerProgress(t.GetTestContext(), backfilling)
+		Nil(t.T(), err)
+
+		progress, err = testDB.RetrieveIndexerProgress(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.IndexerProgress{backfilling, livefilling}, progress)
+
+		// Removed progress is deleted for the contract only.
+		err = testDB.RemoveIndexerProgress(t.GetTestContext(), chainID, backfilling.ContractAddress)
+		Nil(t.T(), err)
+
+		progress, err = testDB.RetrieveIndexerProgress(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.IndexerProgress{livefilling}, progress)
+
+		// Removing a chain's progress leaves other chains.
+		err = testDB.RemoveIndexerProgress(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+
+		progress, err = testDB.RetrieveIndexerProgress(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Empty(t.T(), progress)
+
+		progress, err = testDB.RetrieveIndexerProgress(t.GetTestContext(), otherChain.ChainID)
+		Nil(t.T(), err)
+		Equal(t.T(), []db.IndexerProgress{otherChain}, progress)
+	})
+}
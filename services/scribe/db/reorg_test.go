@@ -0,0 +1,119 @@
+package db_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveBlockHashes() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+
+		for i := uint64(1); i <= 5; i++ {
+			err := testDB.StoreBlockHash(t.GetTestContext(), chainID, i, common.BigToHash(big.NewInt(int64(i))))
+			Nil(t.T(), err)
+		}
+		// Storing a block again replaces its hash.
+		err := testDB.StoreBlockHash(t.GetTestContext(), chainID, 3, common.BigToHash(big.NewInt(30)))
+		Nil(t.T(), err)
+
+		blockHashes, err := testDB.RetrieveBlockHashesInRange(t.GetTestContext(), chainID, 2, 4)
+		Nil(t.T(), err)
+		Equal(t.T(), map[uint64]common.Hash{
+			2: common.BigToHash(big.NewInt(2)),
+			3: common.BigToHash(big.NewInt(30)),
+			4: common.BigToHash(big.NewInt(4)),
+		}, blockHashes)
+	})
+}
+
+func (t *DBSuite) TestRemoveOrphanedBlocks() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		// Index a log in each of blocks 1-3.
+		var indexedHashes []common.Hash
+		for i := uint64(1); i <= 3; i++ {
+			log := t.buildLog(contractAddress, i)
+			err := testDB.StoreLogs(t.GetTestContext(), chainID, log)
+			Nil(t.T(), err)
+			err = testDB.StoreBlockHash(t.GetTestContext(), chainID, i, log.BlockHash)
+			Nil(t.T(), err)
+			indexedHashes = append(indexedHashes, log.BlockHash)
+		}
+		err := testDB.StoreLastIndexed(t.GetTestContext(), contractAddress, chainID, 3, false)
+		Nil(t.T(), err)
+
+		lastRemovedID, err := testDB.RetrieveLastRemovedLogID(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), uint64(0), lastRemovedID)
+
+		// Orphan blocks 2 and 3.
+		err = testDB.RemoveOrphanedBlocks(t.GetTestContext(), chainID, 2, indexedHashes[1:])
+		Nil(t.T(), err)
+
+		logFilter := db.LogFilter{ChainID: chainID, ContractAddress: contractAddress.String()}
+		retrievedLogs, err := testDB.RetrieveLogsWithFilter(t.GetTestContext(), logFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(retrievedLogs))
+		Equal(t.T(), uint64(1), retrievedLogs[0].BlockNumber)
+
+		blockHashes, err := testDB.RetrieveBlockHashesInRange(t.GetTestContext(), chainID, 1, 3)
+		Nil(t.T(), err)
+		Equal(t.T(), map[uint64]common.Hash{1: indexedHashes[0]}, blockHashes)
+
+		lastIndexed, err := testDB.RetrieveLastIndexed(t.GetTestContext(), contractAddress, chainID, false)
+		Nil(t.T(), err)
+		Equal(t.T(), uint64(1), lastIndexed)
+
+		// The deleted logs are kept as removed logs, in block order.
+		removedLogs, err := testDB.RetrieveRemovedLogs(t.GetTestContext(), logFilter, 0, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 2, len(removedLogs))
+		for i, removedLog := range removedLogs {
+			True(t.T(), removedLog.Log.Removed)
+			Equal(t.T(), uint64(i+2), removedLog.Log.BlockNumber)
+			Equal(t.T(), indexedHashes[i+1], removedLog.Log.BlockHash)
+		}
+
+		lastRemovedID, err = testDB.RetrieveLastRemovedLogID(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), removedLogs[1].ID, lastRemovedID)
+
+		removedLogs, err = testDB.RetrieveRemovedLogs(t.GetTestContext(), logFilter, lastRemovedID, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 0, len(removedLogs))
+	})
+}
+
+func (t *DBSuite) TestRemoveLogs() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		logA := t.buildLog(contractAddress, 1)
+		logB := t.buildLog(contractAddress, 2)
+		err := testDB.StoreLogs(t.GetTestContext(), chainID, logA, logB)
+		Nil(t.T(), err)
+
+		err = testDB.RemoveLogs(t.GetTestContext(), chainID, logA)
+		Nil(t.T(), err)
+
+		logFilter := db.LogFilter{ChainID: chainID, ContractAddress: contractAddress.String()}
+		retrievedLogs, err := testDB.RetrieveLogsWithFilter(t.GetTestContext(), logFilter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(retrievedLogs))
+		Equal(t.T(), logB.TxHash, retrievedLogs[0].TxHash)
+
+		// The deleted log is kept as a removed log.
+		removedLogs, err := testDB.RetrieveRemovedLogs(t.GetTestContext(), logFilter, 0, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(removedLogs))
+		Equal(t.T(), logA.TxHash, removedLogs[0].Log.TxHash)
+	})
+}
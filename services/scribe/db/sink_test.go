@@ -0,0 +1,62 @@
+package db_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveSinkCursor() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		sinkName := gofakeit.Word() + gofakeit.UUID()
+
+		cursor, err := testDB.RetrieveSinkCursor(t.GetTestContext(), sinkName, chainID)
+		Nil(t.T(), err)
+		Nil(t.T(), cursor)
+
+		Nil(t.T(), testDB.StoreSinkCursor(t.GetTestContext(), sinkName, chainID, db.SinkCursor{BlockNumber: 10, TxIndex: 2, Index: 5, RemovedID: 1}))
+		Nil(t.T(), testDB.StoreSinkCursor(t.GetTestContext(), sinkName, chainID+1, db.SinkCursor{BlockNumber: 1}))
+		// Storing a cursor replaces the previous one.
+		Nil(t.T(), testDB.StoreSinkCursor(t.GetTestContext(), sinkName, chainID, db.SinkCursor{BlockNumber: 11, TxIndex: 0, Index: 7, RemovedID: 3}))
+
+		cursor, err = testDB.RetrieveSinkCursor(t.GetTestContext(), sinkName, chainID)
+		Nil(t.T(), err)
+		Equal(t.T(), &db.SinkCursor{BlockNumber: 11, TxIndex: 0, Index: 7, RemovedID: 3}, cursor)
+	})
+}
+
+func (t *DBSuite) TestStoreRetrieveDeadLetters() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		sinkName := gofakeit.Word() + gofakeit.UUID()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		failedAt := time.Unix(time.Now().Unix(), 0)
+
+		var deadLetters []db.DeadLetter
+		for i := uint64(1); i <= 3; i++ {
+			deadLetters = append(deadLetters, db.DeadLetter{
+				SinkName: sinkName,
+				ChainID:  chainID,
+				Log:      t.buildLog(contractAddress, i),
+				Error:    "webhook returned 500",
+				FailedAt: failedAt,
+			})
+		}
+		Nil(t.T(), testDB.StoreDeadLetters(t.GetTestContext(), deadLetters...))
+		Nil(t.T(), testDB.StoreDeadLetters(t.GetTestContext(), db.DeadLetter{SinkName: sinkName + "other", Log: t.buildLog(contractAddress, 4)}))
+
+		retrieved, err := testDB.RetrieveDeadLetters(t.GetTestContext(), sinkName, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), len(deadLetters), len(retrieved))
+		for i := range retrieved {
+			NotZero(t.T(), retrieved[i].ID)
+			deadLetters[i].ID = retrieved[i].ID
+			Equal(t.T(), deadLetters[i], retrieved[i])
+		}
+	})
+}
@@ -11,6 +11,7 @@
 
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dbcommon/postgrestest"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
@@ -21,6 +22,7 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
 	"gorm.io/gorm/schema"
 )
@@ -30,6 +32,8 @@ type DBSuite struct {
 	dbs           []db.EventDB
 	logIndex      atomic.Int64
 	scribeMetrics metrics.Handler
+	// postgresConnString is the postgres database used when postgres tests are enabled
+	postgresConnString string
 }
 
 // NewEventDBSuite creates a new EventDBSuite.
@@ -50,6 +54,7 @@ func (t *DBSuite) SetupTest() {
 
 	t.dbs = []db.EventDB{sqliteStore}
 	t.setupMysqlDB()
+	t.setupPostgresDB()
 }
 
 func (t *DBSuite) SetupSuite() {
@@ -68,6 +73,10 @@ func (t *DBSuite) SetupSuite() {
 	var err error
 	t.scribeMetrics, err = metrics.NewByType(t.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
 	t.Require().Nil(err)
+
+	if os.Getenv(dbcommon.EnablePostgresTestVar) == "true" {
+		t.postgresConnString = postgrestest.GetConnString(t.T())
+	}
 }
 
 func (t *DBSuite) setupMysqlDB() {
@@ -102,6 +111,21 @@ func (t *DBSuite) setupMysqlDB() {
 	t.dbs = append(t.dbs, mysqlStore)
 }
 
+func (t *DBSuite) setupPostgresDB() {
+	if t.postgresConnString == "" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("test%d_", t.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(t.GetTestContext(), t.postgresConnString, t.scribeMetrics, false)
+	t.Require().NoError(err)
+
+	t.dbs = append(t.dbs, postgresStore)
+}
+
 func (t *DBSuite) RunOnAllDBs(testFunc func(testDB db.EventDB)) {
 	t.T().Helper()
 
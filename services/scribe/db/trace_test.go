@@ -0,0 +1,70 @@
+package db_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveCallFrames() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		txHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+		blockHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+		sender := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		router := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		recipient := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		frames := []db.CallFrame{
+			{
+				TxHash: txHash, BlockNumber: 10, BlockHash: blockHash, TransactionIndex: 1,
+				Index: 0, TraceAddress: []uint64{}, Type: "CALL", From: sender, To: router,
+				Value: big.NewInt(5), Gas: 100000, GasUsed: 50000, Input: []byte{1, 2, 3, 4},
+			},
+			{
+				TxHash: txHash, BlockNumber: 10, BlockHash: blockHash, TransactionIndex: 1,
+				Index: 1, TraceAddress: []uint64{0}, Type: "STATICCALL", From: router, To: recipient,
+				Gas: 20000, GasUsed: 1000, Output: []byte{5},
+			},
+			{
+				TxHash: txHash, BlockNumber: 10, BlockHash: blockHash, TransactionIndex: 1,
+				Index: 2, TraceAddress: []uint64{1}, Type: "CALL", From: router, To: recipient,
+				Value: big.NewInt(5), Gas: 20000, GasUsed: 2000,
+			},
+			{
+				TxHash: txHash, BlockNumber: 10, BlockHash: blockHash, TransactionIndex: 1,
+				Index: 3, TraceAddress: []uint64{1, 0}, Type: "CALL", From: recipient, To: sender,
+				Value: big.NewInt(0), Gas: 2300, GasUsed: 2300, Error: "out of gas",
+			},
+		}
+		Nil(t.T(), testDB.StoreCallFrames(t.GetTestContext(), chainID, frames...))
+		// Storing frames again is a no-op.
+		Nil(t.T(), testDB.StoreCallFrames(t.GetTestContext(), chainID, frames...))
+
+		retrievedFrames, err := testDB.RetrieveCallFramesWithFilter(t.GetTestContext(), db.CallFrameFilter{ChainID: chainID, TxHash: txHash.String()}, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), frames, retrievedFrames)
+		Equal(t.T(), 2, retrievedFrames[3].Depth())
+
+		retrievedFrames, err = testDB.RetrieveCallFramesWithFilter(t.GetTestContext(), db.CallFrameFilter{
+			ChainID:     chainID,
+			FromAddress: router.String(),
+			ToAddress:   recipient.String(),
+		}, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 2, len(retrievedFrames))
+
+		retrievedFrames, err = testDB.RetrieveCallFramesWithFilter(t.GetTestContext(), db.CallFrameFilter{ChainID: chainID, StartBlock: 11}, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 0, len(retrievedFrames))
+
+		// Frames are deleted with their orphaned block.
+		Nil(t.T(), testDB.RemoveOrphanedBlocks(t.GetTestContext(), chainID, 10, []common.Hash{blockHash}))
+		retrievedFrames, err = testDB.RetrieveCallFramesWithFilter(t.GetTestContext(), db.CallFrameFilter{ChainID: chainID}, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 0, len(retrievedFrames))
+	})
+}
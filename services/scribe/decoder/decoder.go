@@ -0,0 +1,194 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/ethergo/parser/hardhat"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+var errNoABI = errors.New("no abi")
+
+// Decoder decodes logs with the ABIs of the contracts that emitted them.
+type Decoder struct {
+	// mux guards abis, which grows as contracts are added.
+	mux sync.RWMutex
+	// abis is a map from contract address -> abi.
+	abis map[common.Address]*abi.ABI
+}
+
+// NewDecoder creates a decoder for a chain from the ABIs of its deployments and contracts.
+// Contract ABIs take precedence over the chain's deployments.
+func NewDecoder(chainConfig config.ChainConfig) (*Decoder, error) {
+	abis := make(map[common.Address]*abi.ABI)
+
+	if chainConfig.Deployments != "" {
+		deploymentABIs, err := LoadDeploymentABIs(chainConfig.Deployments, chainConfig.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		for address, contractABI := range deploymentABIs {
+			abis[address] = contractABI
+		}
+	}
+
+	for _, contract := range chainConfig.Contracts {
+		if contract.ABI == "" {
+			continue
+		}
+		contractABI, err := LoadABI(contract.ABI)
+		if err != nil {
+			return nil, fmt.Errorf("could not load abi of %s: %w", contract.Address, err)
+		}
+		abis[common.HexToAddress(contract.Address)] = contractABI
+	}
+
+	return &Decoder{abis: abis}, nil
+}
+
+// HasABIs returns true if the decoder has the ABI of any contract.
+func (d *Decoder) HasABIs() bool {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	return len(d.abis) > 0
+}
+
+// AddABI decodes the logs of a contract with an ABI, replacing any ABI the contract already had.
+func (d *Decoder) AddABI(address common.Address, contractABI *abi.ABI) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.abis[address] = contractABI
+}
+
+// Decode decodes a log with the ABI of the contract that emitted it. It returns false if the contract's ABI is
+// unknown or the ABI doesn't contain the event.
+func (d *Decoder) Decode(log types.Log) (decodedLog db.DecodedLog, ok bool, err error) {
+	d.mux.RLock()
+	contractABI, ok := d.abis[log.Address]
+	d.mux.RUnlock()
+	if !ok || len(log.Topics) == 0 {
+		return db.DecodedLog{}, false, nil
+	}
+	event, err := contractABI.EventByID(log.Topics[0])
+	if err != nil {
+		//nolint: nilerr
+		return db.DecodedLog{}, false, nil
+	}
+
+	// Name unnamed arguments by position so they don't collide.
+	inputs := make(abi.Arguments, len(event.Inputs))
+	var indexed abi.Arguments
+	for i, input := range event.Inputs {
+		input.Name = argName(input, i)
+		inputs[i] = input
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+
+	values := make(map[string]interface{})
+	if len(log.Data) > 0 {
+		err = inputs.UnpackIntoMap(values, log.Data)
+		if err != nil {
+			return db.DecodedLog{}, false, fmt.Errorf("could not unpack %s data: %w", event.Name, err)
+		}
+	}
+	err = abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:])
+	if err != nil {
+		return db.DecodedLog{}, false, fmt.Errorf("could not parse %s topics: %w", event.Name, err)
+	}
+
+	args := make(map[string]string, len(inputs))
+	for _, input := range inputs {
+		args[input.Name], err = scribeTypes.FormatArg(values[input.Name])
+		if err != nil {
+			return db.DecodedLog{}, false, fmt.Errorf("could not format %s argument %s: %w", event.Name, input.Name, err)
+		}
+	}
+
+	return db.DecodedLog{
+		Log:       log,
+		EventName: event.Name,
+		Args:      args,
+	}, true, nil
+}
+
+// argName gets the name of an event argument, naming unnamed arguments by position.
+func argName(input abi.Argument, position int) string {
+	if input.Name == "" {
+		return fmt.Sprintf("arg%d", position)
+	}
+	return input.Name
+}
+
+// LoadABI loads an ABI from a file containing either a JSON ABI or a build artifact with an `abi` field
+// (e.g. hardhat or foundry artifacts and hardhat deployments).
+func LoadABI(path string) (*abi.ABI, error) {
+	//nolint: gosec
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("could not read abi file: %w", err)
+	}
+
+	abiJSON := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(abiJSON, "[") {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		err = json.Unmarshal(data, &artifact)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse artifact %s: %w", path, err)
+		}
+		if len(artifact.ABI) == 0 {
+			return nil, fmt.Errorf("artifact %s has no abi: %w", path, errNoABI)
+		}
+		abiJSON = string(artifact.ABI)
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse abi %s: %w", path, err)
+	}
+	return &parsedABI, nil
+}
+
+// LoadDeploymentABIs loads the ABIs of the contracts deployed on a chain from a hardhat deployments directory,
+// keyed by contract address.
+func LoadDeploymentABIs(deployPath string, chainID uint32) (map[common.Address]*abi.ABI, error) {
+	contracts, err := hardhat.GetDeployments(deployPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not get deployments: %w", err)
+	}
+
+	abis := make(map[common.Address]*abi.ABI)
+	for _, contract := range contracts {
+		network, ok := contract.Networks[strconv.Itoa(int(chainID))]
+		if !ok {
+			continue
+		}
+
+		abiJSON, err := json.Marshal(contract.Abi)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode abi of %s: %w", contract.Name, err)
+		}
+		parsedABI, err := abi.JSON(strings.NewReader(string(abiJSON)))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse abi of %s: %w", contract.Name, err)
+		}
+		abis[common.HexToAddress(network.Address)] = &parsedABI
+	}
+
+	return abis, nil
+}
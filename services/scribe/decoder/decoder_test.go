@@ -0,0 +1,93 @@
+package decoder_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/decoder"
+)
+
+const transferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(filet.TmpDir(t, ""), name)
+	Nil(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestLoadABI(t *testing.T) {
+	parsedABI, err := decoder.LoadABI(writeFile(t, "abi.json", transferABI))
+	Nil(t, err)
+	Contains(t, parsedABI.Events, "Transfer")
+
+	// Build artifacts are read from their abi field.
+	parsedABI, err = decoder.LoadABI(writeFile(t, "artifact.json", `{"contractName":"Token","abi":`+transferABI+`}`))
+	Nil(t, err)
+	Contains(t, parsedABI.Events, "Transfer")
+
+	_, err = decoder.LoadABI(writeFile(t, "empty.json", `{"contractName":"Token"}`))
+	NotNil(t, err)
+}
+
+func TestDecode(t *testing.T) {
+	token := mocks.MockAddress()
+	logDecoder, err := decoder.NewDecoder(config.ChainConfig{
+		ChainID:   1,
+		Contracts: config.ContractConfigs{{Address: token.String(), ABI: writeFile(t, "abi.json", transferABI)}},
+	})
+	Nil(t, err)
+	True(t, logDecoder.HasABIs())
+
+	from := mocks.MockAddress()
+	to := mocks.MockAddress()
+	log := types.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+	}
+
+	decodedLog, ok, err := logDecoder.Decode(log)
+	Nil(t, err)
+	True(t, ok)
+	Equal(t, "Transfer", decodedLog.EventName)
+	Equal(t, map[string]string{
+		"from": strings.ToLower(from.Hex()),
+		"to":   strings.ToLower(to.Hex()),
+		// Unnamed arguments are named by position.
+		"arg2": "100",
+	}, decodedLog.Args)
+
+	// Logs of unknown events or contracts aren't decoded.
+	unknownLog := log
+	unknownLog.Topics = []common.Hash{crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))}
+	_, ok, err = logDecoder.Decode(unknownLog)
+	Nil(t, err)
+	False(t, ok)
+
+	unknownLog = log
+	unknownLog.Address = mocks.MockAddress()
+	_, ok, err = logDecoder.Decode(unknownLog)
+	Nil(t, err)
+	False(t, ok)
+
+	// Malformed logs return an error.
+	malformedLog := log
+	malformedLog.Data = []byte{1}
+	_, _, err = logDecoder.Decode(malformedLog)
+	NotNil(t, err)
+}
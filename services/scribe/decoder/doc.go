@@ -0,0 +1,3 @@
+// Package decoder decodes logs with the ABIs of the contracts that emitted them, so events can be stored and queried
+// by name and argument values.
+package decoder
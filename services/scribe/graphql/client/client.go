@@ -8,6 +8,7 @@
 
 	"github.com/Yamashou/gqlgenc/client"
 	"github.com/synapsecns/sanguine/services/scribe/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/scribe/graphql/server/types"
 )
 
 type Client struct {
@@ -19,24 +20,27 @@ func NewClient(cli *http.Client, baseURL string, options ...client.HTTPRequestOp
 }
 
 type Query struct {
-	Logs                     []*model.Log         "json:\"logs\" graphql:\"logs\""
-	LogsRange                []*model.Log         "json:\"logsRange\" graphql:\"logsRange\""
-	Receipts                 []*model.Receipt     "json:\"receipts\" graphql:\"receipts\""
-	ReceiptsRange            []*model.Receipt     "json:\"receiptsRange\" graphql:\"receiptsRange\""
-	Transactions             []*model.Transaction "json:\"transactions\" graphql:\"transactions\""
-	TransactionsRange        []*model.Transaction "json:\"transactionsRange\" graphql:\"transactionsRange\""
-	BlockTime                *int                 "json:\"blockTime\" graphql:\"blockTime\""
-	LastStoredBlockNumber    *int                 "json:\"lastStoredBlockNumber\" graphql:\"lastStoredBlockNumber\""
-	FirstStoredBlockNumber   *int                 "json:\"firstStoredBlockNumber\" graphql:\"firstStoredBlockNumber\""
-	LastConfirmedBlockNumber *int                 "json:\"lastConfirmedBlockNumber\" graphql:\"lastConfirmedBlockNumber\""
-	TxSender                 *string              "json:\"txSender\" graphql:\"txSender\""
-	LastIndexed              *int                 "json:\"lastIndexed\" graphql:\"lastIndexed\""
-	LogCount                 *int                 "json:\"logCount\" graphql:\"logCount\""
-	ReceiptCount             *int                 "json:\"receiptCount\" graphql:\"receiptCount\""
-	BlockTimeCount           *int                 "json:\"blockTimeCount\" graphql:\"blockTimeCount\""
-	LogsAtHeadRange          []*model.Log         "json:\"logsAtHeadRange\" graphql:\"logsAtHeadRange\""
-	ReceiptsAtHeadRange      []*model.Receipt     "json:\"receiptsAtHeadRange\" graphql:\"receiptsAtHeadRange\""
-	TransactionsAtHeadRange  []*model.Transaction "json:\"transactionsAtHeadRange\" graphql:\"transactionsAtHeadRange\""
+	Logs                     []*model.Log            "json:\"logs\" graphql:\"logs\""
+	LogsRange                []*model.Log            "json:\"logsRange\" graphql:\"logsRange\""
+	DecodedLogs              []*model.DecodedLog     "json:\"decodedLogs\" graphql:\"decodedLogs\""
+	IndexingStatus           []*model.IndexingStatus "json:\"indexingStatus\" graphql:\"indexingStatus\""
+	CallFrames               []*model.CallFrame      "json:\"callFrames\" graphql:\"callFrames\""
+	Receipts                 []*model.Receipt        "json:\"receipts\" graphql:\"receipts\""
+	ReceiptsRange            []*model.Receipt        "json:\"receiptsRange\" graphql:\"receiptsRange\""
+	Transactions             []*model.Transaction    "json:\"transactions\" graphql:\"transactions\""
+	TransactionsRange        []*model.Transaction    "json:\"transactionsRange\" graphql:\"transactionsRange\""
+	BlockTime                *int                    "json:\"blockTime\" graphql:\"blockTime\""
+	LastStoredBlockNumber    *int                    "json:\"lastStoredBlockNumber\" graphql:\"lastStoredBlockNumber\""
+	FirstStoredBlockNumber   *int                    "json:\"firstStoredBlockNumber\" graphql:\"firstStoredBlockNumber\""
+	LastConfirmedBlockNumber *int                    "json:\"lastConfirmedBlockNumber\" graphql:\"lastConfirmedBlockNumber\""
+	TxSender                 *string                 "json:\"txSender\" graphql:\"txSender\""
+	LastIndexed              *int                    "json:\"lastIndexed\" graphql:\"lastIndexed\""
+	LogCount                 *int                    "json:\"logCount\" graphql:\"logCount\""
+	ReceiptCount             *int                    "json:\"receiptCount\" graphql:\"receiptCount\""
+	BlockTimeCount           *int                    "json:\"blockTimeCount\" graphql:\"blockTimeCount\""
+	LogsAtHeadRange          []*model.Log            "json:\"logsAtHeadRange\" graphql:\"logsAtHeadRange\""
+	ReceiptsAtHeadRange      []*model.Receipt        "json:\"receiptsAtHeadRange\" graphql:\"receiptsAtHeadRange\""
+	TransactionsAtHeadRange  []*model.Transaction    "json:\"transactionsAtHeadRange\" graphql:\"transactionsAtHeadRange\""
 }
 type GetLogs struct {
 	Response []*struct {
@@ -66,6 +70,65 @@ type GetLogsRange struct {
 		Removed         bool     "json:\"removed\" graphql:\"removed\""
 	} "json:\"response\" graphql:\"response\""
 }
+type GetDecodedLogs struct {
+	Response []*struct {
+		EventName string     "json:\"event_name\" graphql:\"event_name\""
+		Args      types.JSON "json:\"args\" graphql:\"args\""
+		Log       struct {
+			ContractAddress string   "json:\"contract_address\" graphql:\"contract_address\""
+			ChainID         int      "json:\"chain_id\" graphql:\"chain_id\""
+			Topics          []string "json:\"topics\" graphql:\"topics\""
+			Data            string   "json:\"data\" graphql:\"data\""
+			BlockNumber     int      "json:\"block_number\" graphql:\"block_number\""
+			TxHash          string   "json:\"tx_hash\" graphql:\"tx_hash\""
+			TxIndex         int      "json:\"tx_index\" graphql:\"tx_index\""
+			BlockHash       string   "json:\"block_hash\" graphql:\"block_hash\""
+			Index           int      "json:\"index\" graphql:\"index\""
+			Removed         bool     "json:\"removed\" graphql:\"removed\""
+		} "json:\"log\" graphql:\"log\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetIndexingStatus struct {
+	Response []*struct {
+		ChainID         int    "json:\"chain_id\" graphql:\"chain_id\""
+		ContractAddress string "json:\"contract_address\" graphql:\"contract_address\""
+		Mode            string "json:\"mode\" graphql:\"mode\""
+		CurrentBlock    int    "json:\"current_block\" graphql:\"current_block\""
+		TargetBlock     int    "json:\"target_block\" graphql:\"target_block\""
+		HeadBlock       int    "json:\"head_block\" graphql:\"head_block\""
+		Lag             int    "json:\"lag\" graphql:\"lag\""
+		Rates           []*struct {
+			WindowSeconds   int     "json:\"window_seconds\" graphql:\"window_seconds\""
+			BlocksPerSecond float64 "json:\"blocks_per_second\" graphql:\"blocks_per_second\""
+		} "json:\"rates\" graphql:\"rates\""
+		EtaSeconds       *int    "json:\"eta_seconds\" graphql:\"eta_seconds\""
+		LastError        *string "json:\"last_error\" graphql:\"last_error\""
+		LastErrorTime    *int    "json:\"last_error_time\" graphql:\"last_error_time\""
+		LastProgressTime int     "json:\"last_progress_time\" graphql:\"last_progress_time\""
+		UpdateTime       int     "json:\"update_time\" graphql:\"update_time\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetCallFrames struct {
+	Response []*struct {
+		ChainID          int     "json:\"chain_id\" graphql:\"chain_id\""
+		TxHash           string  "json:\"tx_hash\" graphql:\"tx_hash\""
+		BlockNumber      int     "json:\"block_number\" graphql:\"block_number\""
+		BlockHash        string  "json:\"block_hash\" graphql:\"block_hash\""
+		TransactionIndex int     "json:\"transaction_index\" graphql:\"transaction_index\""
+		Index            int     "json:\"index\" graphql:\"index\""
+		TraceAddress     []int   "json:\"trace_address\" graphql:\"trace_address\""
+		Depth            int     "json:\"depth\" graphql:\"depth\""
+		Type             string  "json:\"type\" graphql:\"type\""
+		From             string  "json:\"from\" graphql:\"from\""
+		To               string  "json:\"to\" graphql:\"to\""
+		Value            *string "json:\"value\" graphql:\"value\""
+		Gas              int     "json:\"gas\" graphql:\"gas\""
+		GasUsed          int     "json:\"gas_used\" graphql:\"gas_used\""
+		Input            string  "json:\"input\" graphql:\"input\""
+		Output           string  "json:\"output\" graphql:\"output\""
+		Error            *string "json:\"error\" graphql:\"error\""
+	} "json:\"response\" graphql:\"response\""
+}
 type GetLogsAtHeadRange struct {
 	Response []*struct {
 		ContractAddress string   "json:\"contract_address\" graphql:\"contract_address\""
@@ -360,6 +423,118 @@ func (c *Client) GetLogsRange(ctx context.Context, chainID int, startBlock int,
 	return &res, nil
 }
 
+const GetDecodedLogsDocument = `query GetDecodedLogs ($chain_id: Int!, $event_name: String!, $contract_address: String, $args: [ArgFilter!], $page: Int!) {
+	response: decodedLogs(chain_id: $chain_id, event_name: $event_name, contract_address: $contract_address, args: $args, page: $page) {
+		event_name
+		args
+		log {
+			contract_address
+			chain_id
+			topics
+			data
+			block_number
+			tx_hash
+			tx_index
+			block_hash
+			index
+			removed
+		}
+	}
+}
+`
+
+func (c *Client) GetDecodedLogs(ctx context.Context, chainID int, eventName string, contractAddress *string, args []*model.ArgFilter, page int, httpRequestOptions ...client.HTTPRequestOption) (*GetDecodedLogs, error) {
+	vars := map[string]interface{}{
+		"chain_id":         chainID,
+		"event_name":       eventName,
+		"contract_address": contractAddress,
+		"args":             args,
+		"page":             page,
+	}
+
+	var res GetDecodedLogs
+	if err := c.Client.Post(ctx, "GetDecodedLogs", GetDecodedLogsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetIndexingStatusDocument = `query GetIndexingStatus ($chain_id: Int) {
+	response: indexingStatus(chain_id: $chain_id) {
+		chain_id
+		contract_address
+		mode
+		current_block
+		target_block
+		head_block
+		lag
+		rates {
+			window_seconds
+			blocks_per_second
+		}
+		eta_seconds
+		last_error
+		last_error_time
+		last_progress_time
+		update_time
+	}
+}
+`
+
+func (c *Client) GetIndexingStatus(ctx context.Context, chainID *int, httpRequestOptions ...client.HTTPRequestOption) (*GetIndexingStatus, error) {
+	vars := map[string]interface{}{
+		"chain_id": chainID,
+	}
+
+	var res GetIndexingStatus
+	if err := c.Client.Post(ctx, "GetIndexingStatus", GetIndexingStatusDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetCallFramesDocument = `query GetCallFrames ($chain_id: Int!, $tx_hash: String, $from_address: String, $to_address: String, $page: Int!) {
+	response: callFrames(chain_id: $chain_id, tx_hash: $tx_hash, from_address: $from_address, to_address: $to_address, page: $page) {
+		chain_id
+		tx_hash
+		block_number
+		block_hash
+		transaction_index
+		index
+		trace_address
+		depth
+		type
+		from
+		to
+		value
+		gas
+		gas_used
+		input
+		output
+		error
+	}
+}
+`
+
+func (c *Client) GetCallFrames(ctx context.Context, chainID int, txHash *string, fromAddress *string, toAddress *string, page int, httpRequestOptions ...client.HTTPRequestOption) (*GetCallFrames, error) {
+	vars := map[string]interface{}{
+		"chain_id":     chainID,
+		"tx_hash":      txHash,
+		"from_address": fromAddress,
+		"to_address":   toAddress,
+		"page":         page,
+	}
+
+	var res GetCallFrames
+	if err := c.Client.Post(ctx, "GetCallFrames", GetCallFramesDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetLogsAtHeadRangeDocument = `query GetLogsAtHeadRange ($chain_id: Int!, $start_block: Int!, $end_block: Int!, $page: Int!) {
 	response: logsAtHeadRange(chain_id: $chain_id, start_block: $start_block, end_block: $end_block, page: $page) {
 		contract_address
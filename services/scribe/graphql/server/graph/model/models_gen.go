@@ -6,12 +6,64 @@
 	"github.com/synapsecns/sanguine/services/scribe/graphql/server/types"
 )
 
+type ArgFilter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 type BlockTime struct {
 	ChainID     int `json:"chain_id"`
 	BlockNumber int `json:"block_number"`
 	Timestamp   int `json:"timestamp"`
 }
 
+type CallFrame struct {
+	ChainID          int     `json:"chain_id"`
+	TxHash           string  `json:"tx_hash"`
+	BlockNumber      int     `json:"block_number"`
+	BlockHash        string  `json:"block_hash"`
+	TransactionIndex int     `json:"transaction_index"`
+	Index            int     `json:"index"`
+	TraceAddress     []int   `json:"trace_address"`
+	Depth            int     `json:"depth"`
+	Type             string  `json:"type"`
+	From             string  `json:"from"`
+	To               string  `json:"to"`
+	Value            *string `json:"value,omitempty"`
+	Gas              int     `json:"gas"`
+	GasUsed          int     `json:"gas_used"`
+	Input            string  `json:"input"`
+	Output           string  `json:"output"`
+	Error            *string `json:"error,omitempty"`
+}
+
+type DecodedLog struct {
+	EventName string     `json:"event_name"`
+	Args      types.JSON `json:"args"`
+	Log       *Log       `json:"log"`
+}
+
+type IndexingRate struct {
+	WindowSeconds   int     `json:"window_seconds"`
+	BlocksPerSecond float64 `json:"blocks_per_second"`
+}
+
+type IndexingStatus struct {
+	ChainID          int             `json:"chain_id"`
+	ContractAddress  string          `json:"contract_address"`
+	Mode             string          `json:"mode"`
+	CurrentBlock     int             `json:"current_block"`
+	TargetBlock      int             `json:"target_block"`
+	HeadBlock        int             `json:"head_block"`
+	Lag              int             `json:"lag"`
+	Rates            []*IndexingRate `json:"rates"`
+	EtaSeconds       *int            `json:"eta_seconds,omitempty"`
+	LastError        *string         `json:"last_error,omitempty"`
+	LastErrorTime    *int            `json:"last_error_time,omitempty"`
+	LastProgressTime int             `json:"last_progress_time"`
+	UpdateTime       int             `json:"update_time"`
+}
+
 type Log struct {
 	ContractAddress string       `json:"contract_address"`
 	ChainID         int          `json:"chain_id"`
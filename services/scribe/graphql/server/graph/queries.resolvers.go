@@ -20,8 +20,8 @@
 )
 
 // Logs is the resolver for the logs field.
-func (r *queryResolver) Logs(ctx context.Context, contractAddress *string, chainID int, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, page int) ([]*model.Log, error) {
-	logsFilter := db.BuildLogFilter(contractAddress, blockNumber, txHash, txIndex, blockHash, index, confirmed)
+func (r *queryResolver) Logs(ctx context.Context, contractAddress *string, chainID int, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, topics [][]string, page int) ([]*model.Log, error) {
+	logsFilter := db.BuildLogFilter(contractAddress, blockNumber, txHash, txIndex, blockHash, index, confirmed, topics)
 	logsFilter.ChainID = uint32(chainID)
 	logs, err := r.DB.RetrieveLogsWithFilter(ctx, logsFilter, page)
 	if err != nil {
@@ -32,8 +32,8 @@ func (r *queryResolver) Logs(ctx context.Context, contractAddress *string, chain
 }
 
 // LogsRange is the resolver for the logsRange field.
-func (r *queryResolver) LogsRange(ctx context.Context, contractAddress *string, chainID int, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, startBlock int, endBlock int, page int, asc *bool) ([]*model.Log, error) {
-	logsFilter := db.BuildLogFilter(contractAddress, blockNumber, txHash, txIndex, blockHash, index, confirmed)
+func (r *queryResolver) LogsRange(ctx context.Context, contractAddress *string, chainID int, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, topics [][]string, startBlock int, endBlock int, page int, asc *bool) ([]*model.Log, error) {
+	logsFilter := db.BuildLogFilter(contractAddress, blockNumber, txHash, txIndex, blockHash, index, confirmed, topics)
 	logsFilter.ChainID = uint32(chainID)
 	var logs []*types.Log
 	var err error
@@ -50,6 +50,49 @@ func (r *queryResolver) LogsRange(ctx context.Context, contractAddress *string,
 	return r.logsToModelLogs(logs, logsFilter.ChainID), nil
 }
 
+// DecodedLogs is the resolver for the decodedLogs field.
+func (r *queryResolver) DecodedLogs(ctx context.Context, chainID int, eventName string, contractAddress *string, args []*model.ArgFilter, startBlock *int, endBlock *int, page int) ([]*model.DecodedLog, error) {
+	decodedLogFilter := db.BuildDecodedLogFilter(contractAddress, eventName, startBlock, endBlock)
+	decodedLogFilter.ChainID = uint32(chainID)
+	for _, arg := range args {
+		decodedLogFilter.Args[arg.Name] = arg.Value
+	}
+
+	decodedLogs, err := r.DB.RetrieveDecodedLogsWithFilter(ctx, decodedLogFilter, page)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving decoded logs: %w", err)
+	}
+
+	return r.decodedLogsToModelDecodedLogs(decodedLogs, decodedLogFilter.ChainID), nil
+}
+
+// IndexingStatus is the resolver for the indexingStatus field.
+func (r *queryResolver) IndexingStatus(ctx context.Context, chainID *int) ([]*model.IndexingStatus, error) {
+	var chain uint32
+	if chainID != nil {
+		chain = uint32(*chainID)
+	}
+
+	progress, err := r.DB.RetrieveIndexerProgress(ctx, chain)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving indexing status: %w", err)
+	}
+
+	return r.progressToModelIndexingStatuses(progress), nil
+}
+
+// CallFrames is the resolver for the callFrames field.
+func (r *queryResolver) CallFrames(ctx context.Context, chainID int, txHash *string, fromAddress *string, toAddress *string, startBlock *int, endBlock *int, page int) ([]*model.CallFrame, error) {
+	callFrameFilter := db.BuildCallFrameFilter(txHash, fromAddress, toAddress, startBlock, endBlock)
+	callFrameFilter.ChainID = uint32(chainID)
+	frames, err := r.DB.RetrieveCallFramesWithFilter(ctx, callFrameFilter, page)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving call frames: %w", err)
+	}
+
+	return r.callFramesToModelCallFrames(frames, callFrameFilter.ChainID), nil
+}
+
 // Receipts is the resolver for the receipts field.
 func (r *queryResolver) Receipts(ctx context.Context, chainID int, txHash *string, contractAddress *string, blockHash *string, blockNumber *int, txIndex *int, confirmed *bool, page int) ([]*model.Receipt, error) {
 	receiptsFilter := db.BuildReceiptFilter(txHash, contractAddress, blockHash, blockNumber, txIndex, confirmed)
@@ -234,8 +277,8 @@ func (r *queryResolver) BlockTimeCount(ctx context.Context, chainID int) (*int,
 }
 
 // LogsAtHeadRange is the resolver for the logsAtHeadRange field.
-func (r *queryResolver) LogsAtHeadRange(ctx context.Context, contractAddress *string, chainID int, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, startBlock int, endBlock int, page int) ([]*model.Log, error) {
-	logsFilter := db.BuildLogFilter(contractAddress, blockNumber, txHash, txIndex, blockHash, index, confirmed)
+func (r *queryResolver) LogsAtHeadRange(ctx context.Context, contractAddress *string, chainID int, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool, topics [][]string, startBlock int, endBlock int, page int) ([]*model.Log, error) {
+	logsFilter := db.BuildLogFilter(contractAddress, blockNumber, txHash, txIndex, blockHash, index, confirmed, topics)
 	logsFilter.ChainID = uint32(chainID)
 	logs, err := r.DB.RetrieveLogsFromHeadRangeQuery(ctx, logsFilter, uint64(startBlock), uint64(endBlock), page)
 	if err != nil {
@@ -14,6 +14,7 @@
 	"github.com/jpillora/backoff"
 	"github.com/synapsecns/sanguine/services/scribe/db"
 	"github.com/synapsecns/sanguine/services/scribe/graphql/server/graph/model"
+	graphqlTypes "github.com/synapsecns/sanguine/services/scribe/graphql/server/types"
 )
 
 var logger = log.Logger("scribe-graph")
@@ -74,6 +75,100 @@ func (r Resolver) logToModelLog(log *types.Log, chainID uint32) *model.Log {
 	}
 }
 
+func (r Resolver) decodedLogsToModelDecodedLogs(decodedLogs []db.DecodedLog, chainID uint32) []*model.DecodedLog {
+	modelDecodedLogs := make([]*model.DecodedLog, len(decodedLogs))
+	for i := range decodedLogs {
+		args := make(graphqlTypes.JSON, len(decodedLogs[i].Args))
+		for name, value := range decodedLogs[i].Args {
+			args[name] = value
+		}
+
+		modelDecodedLogs[i] = &model.DecodedLog{
+			EventName: decodedLogs[i].EventName,
+			Args:      args,
+			Log:       r.logToModelLog(&decodedLogs[i].Log, chainID),
+		}
+	}
+
+	return modelDecodedLogs
+}
+
+func (r Resolver) callFramesToModelCallFrames(frames []db.CallFrame, chainID uint32) []*model.CallFrame {
+	modelFrames := make([]*model.CallFrame, len(frames))
+	for i, frame := range frames {
+		traceAddress := make([]int, len(frame.TraceAddress))
+		for j, position := range frame.TraceAddress {
+			traceAddress[j] = int(position)
+		}
+
+		modelFrames[i] = &model.CallFrame{
+			ChainID:          int(chainID),
+			TxHash:           frame.TxHash.String(),
+			BlockNumber:      int(frame.BlockNumber),
+			BlockHash:        frame.BlockHash.String(),
+			TransactionIndex: int(frame.TransactionIndex),
+			Index:            int(frame.Index),
+			TraceAddress:     traceAddress,
+			Depth:            frame.Depth(),
+			Type:             frame.Type,
+			From:             frame.From.String(),
+			To:               frame.To.String(),
+			Gas:              int(frame.Gas),
+			GasUsed:          int(frame.GasUsed),
+			Input:            common.Bytes2Hex(frame.Input),
+			Output:           common.Bytes2Hex(frame.Output),
+		}
+		if frame.Value != nil {
+			value := frame.Value.String()
+			modelFrames[i].Value = &value
+		}
+		if frame.Error != "" {
+			frameError := frame.Error
+			modelFrames[i].Error = &frameError
+		}
+	}
+
+	return modelFrames
+}
+
+func (r Resolver) progressToModelIndexingStatuses(progress []db.IndexerProgress) []*model.IndexingStatus {
+	statuses := make([]*model.IndexingStatus, len(progress))
+	for i, contractProgress := range progress {
+		rates := make([]*model.IndexingRate, len(contractProgress.Rates))
+		for j, rate := range contractProgress.Rates {
+			rates[j] = &model.IndexingRate{
+				WindowSeconds:   int(rate.Window.Seconds()),
+				BlocksPerSecond: rate.BlocksPerSecond,
+			}
+		}
+
+		statuses[i] = &model.IndexingStatus{
+			ChainID:          int(contractProgress.ChainID),
+			ContractAddress:  contractProgress.ContractAddress.String(),
+			Mode:             string(contractProgress.Mode),
+			CurrentBlock:     int(contractProgress.CurrentBlock),
+			TargetBlock:      int(contractProgress.TargetBlock),
+			HeadBlock:        int(contractProgress.HeadBlock),
+			Lag:              int(contractProgress.Lag()),
+			Rates:            rates,
+			LastProgressTime: int(contractProgress.LastProgressAt.Unix()),
+			UpdateTime:       int(contractProgress.UpdatedAt.Unix()),
+		}
+		if contractProgress.ETA != nil {
+			etaSeconds := int(contractProgress.ETA.Seconds())
+			statuses[i].EtaSeconds = &etaSeconds
+		}
+		if contractProgress.LastErrorAt != nil {
+			lastError := contractProgress.LastError
+			lastErrorTime := int(contractProgress.LastErrorAt.Unix())
+			statuses[i].LastError = &lastError
+			statuses[i].LastErrorTime = &lastErrorTime
+		}
+	}
+
+	return statuses
+}
+
 func (r Resolver) ethTxsToModelTransactions(ctx context.Context, ethTxs []db.TxWithBlockNumber, chainID uint32) []*model.Transaction {
 	modelTxs := make([]*model.Transaction, len(ethTxs))
 
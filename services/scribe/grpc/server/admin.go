@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	pbscribe "github.com/synapsecns/sanguine/services/scribe/grpc/types/types/v1"
+	"github.com/synapsecns/sanguine/services/scribe/service"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Admin changes the chains and contracts indexed by a running scribe.
+type Admin interface {
+	// AddChain starts indexing a chain.
+	AddChain(ctx context.Context, chainConfig config.ChainConfig) error
+	// RemoveChain stops indexing a chain.
+	RemoveChain(ctx context.Context, chainID uint32) error
+	// AddContract starts indexing a contract on a chain.
+	AddContract(ctx context.Context, chainID uint32, contract config.ContractConfig) error
+	// RemoveContract stops indexing a contract on a chain.
+	RemoveContract(ctx context.Context, chainID uint32, address common.Address) error
+	// BackfillContract indexes a block range of a contract on a chain again, without changing its last indexed block.
+	BackfillContract(ctx context.Context, chainID uint32, address common.Address, startBlock, endBlock uint64) error
+}
+
+// SetupAdminServer sets up the admin grpc server. Requests must be authorized with the token as a bearer token.
+func SetupAdminServer(ctx context.Context, engine *gin.Engine, admin Admin, token string, handler metrics.Handler) (*grpc.Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("admin token cannot be empty")
+	}
+
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor(otelgrpc.WithTracerProvider(handler.GetTracerProvider()))),
+	)
+	sImpl := adminServer{
+		admin: admin,
+		token: token,
+	}
+
+	mux := runtime.NewServeMux()
+	pbscribe.RegisterAdminServiceServer(s, &sImpl)
+	err := pbscribe.RegisterAdminServiceHandlerServer(ctx, mux, &sImpl)
+	if err != nil {
+		return nil, fmt.Errorf("could not register admin server")
+	}
+
+	engine.NoRoute(func(c *gin.Context) {
+		c.Status(200)
+		gin.WrapF(mux.ServeHTTP)(c)
+	})
+
+	return s, nil
+}
+
+type adminServer struct {
+	// admin is the scribe to change.
+	admin Admin
+	// token is the bearer token requests are authorized with.
+	token string
+	pbscribe.UnimplementedAdminServiceServer
+}
+
+// authorize checks the request's bearer token. The gateway forwards the http authorization header as metadata, so
+// this covers both grpc and http requests.
+func (s *adminServer) authorize(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing authorization")
+	}
+
+	for _, authorization := range md.Get("authorization") {
+		token, found := strings.CutPrefix(authorization, "Bearer ")
+		if found && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid authorization")
+}
+
+func (s *adminServer) AddChain(ctx context.Context, req *pbscribe.AddChainRequest) (*pbscribe.AddChainResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.admin.AddChain(ctx, req.GetChain().ToNative())
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &pbscribe.AddChainResponse{}, nil
+}
+
+func (s *adminServer) RemoveChain(ctx context.Context, req *pbscribe.RemoveChainRequest) (*pbscribe.RemoveChainResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.admin.RemoveChain(ctx, req.GetChainId())
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &pbscribe.RemoveChainResponse{}, nil
+}
+
+func (s *adminServer) AddContract(ctx context.Context, req *pbscribe.AddContractRequest) (*pbscribe.AddContractResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.admin.AddContract(ctx, req.GetChainId(), req.GetContract().ToNative())
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &pbscribe.AddContractResponse{}, nil
+}
+
+func (s *adminServer) RemoveContract(ctx context.Context, req *pbscribe.RemoveContractRequest) (*pbscribe.RemoveContractResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.GetAddress()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.GetAddress())
+	}
+
+	err := s.admin.RemoveContract(ctx, req.GetChainId(), common.HexToAddress(req.GetAddress()))
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &pbscribe.RemoveContractResponse{}, nil
+}
+
+func (s *adminServer) BackfillContract(ctx context.Context, req *pbscribe.BackfillContractRequest) (*pbscribe.BackfillContractResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.GetAddress()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.GetAddress())
+	}
+
+	err := s.admin.BackfillContract(ctx, req.GetChainId(), common.HexToAddress(req.GetAddress()), req.GetStartBlock(), req.GetEndBlock())
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &pbscribe.BackfillContractResponse{}, nil
+}
+
+// adminError converts an error returned by the admin to a grpc status.
+func adminError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrChainNotFound), errors.Is(err, service.ErrContractNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrChainExists), errors.Is(err, service.ErrContractExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrNotIndexing):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrInvalidRange), errors.Is(err, service.ErrInvalidConfig):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
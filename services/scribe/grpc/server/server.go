@@ -19,6 +19,9 @@
 	"time"
 )
 
+// retrieveLogsBackoff is how long to wait before retrying a failed log retrieval.
+const retrieveLogsBackoff = 3 * time.Second
+
 // SetupGRPCServer sets up the grpc server.
 func SetupGRPCServer(ctx context.Context, engine *gin.Engine, eventDB db.EventDB, handler metrics.Handler) (*grpc.Server, error) {
 	s := grpc.NewServer(
@@ -66,31 +69,86 @@ func (s *server) FilterLogs(ctx context.Context, req *pbscribe.FilterLogsRequest
 	}, nil
 }
 
-//nolint:gocognit,cyclop
-func (s *server) StreamLogs(req *pbscribe.StreamLogsRequest, res pbscribe.ScribeService_StreamLogsServer) error {
-	streamNewBlocks := false
-	retrieveLogsBackoff := 3
-	fromBlock, toBlock, err := s.setBlocks(res.Context(), req)
+func (s *server) FilterDecodedLogs(ctx context.Context, req *pbscribe.FilterDecodedLogsRequest) (*pbscribe.FilterDecodedLogsResponse, error) {
+	decodedLogs, err := s.db.RetrieveDecodedLogsWithFilter(ctx, req.GetFilter().ToNative(), int(req.Page))
 	if err != nil {
-		return fmt.Errorf("could not set blocks: %w", err)
+		return nil, fmt.Errorf("error retreiving decoded logs: %w", err)
+	}
+
+	return &pbscribe.FilterDecodedLogsResponse{
+		Logs: pbscribe.FromNativeDecodedLogs(decodedLogs),
+	}, nil
+}
+
+// FilterCallFrames gets the call frames of traced transactions matching a filter, latest transaction first.
+func (s *server) FilterCallFrames(ctx context.Context, req *pbscribe.FilterCallFramesRequest) (*pbscribe.FilterCallFramesResponse, error) {
+	frames, err := s.db.RetrieveCallFramesWithFilter(ctx, req.GetFilter().ToNative(), int(req.Page))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving call frames: %w", err)
 	}
 
-	wait := 0
-	nextFromBlock := uint64(0)
+	return &pbscribe.FilterCallFramesResponse{
+		CallFrames: pbscribe.FromNativeCallFrames(frames),
+	}, nil
+}
+
+// StreamLogs streams the logs matching a filter in (block number, tx index, log index) order. Each message carries a
+// cursor that resumes the stream right after it, so consumers that reconnect with their last cursor receive every
+// log exactly once.
+//
+//nolint:gocognit,cyclop
+func (s *server) StreamLogs(req *pbscribe.StreamLogsRequest, res pbscribe.ScribeService_StreamLogsServer) error {
+	streamNewBlocks := req.ToBlock == "latest"
 	logFilter := req.Filter.ToNative()
 	logFilter.ChainID = req.Filter.ChainId
 
-	if req.ToBlock == "latest" {
-		streamNewBlocks = true
+	addresses := streamAddresses(req)
+	if len(req.ContractAddresses) > 0 {
+		logFilter.ContractAddress = ""
+		for _, address := range addresses {
+			logFilter.ContractAddresses = append(logFilter.ContractAddresses, address.String())
+		}
 	}
 
-	for {
-		var retrievedLogs []*types.Log
+	fromBlock, toBlock, err := s.setBlocks(res.Context(), req, addresses)
+	if err != nil {
+		return fmt.Errorf("could not set blocks: %w", err)
+	}
 
-		if nextFromBlock > fromBlock {
-			fromBlock = nextFromBlock
+	// Logs removed by reorgs after the stream starts are sent to the consumer with removed set to true.
+	startBlock := fromBlock
+	var lastRemovedID uint64
+	if streamNewBlocks {
+		lastRemovedID, err = s.db.RetrieveLastRemovedLogID(res.Context(), req.Filter.ChainId)
+		if err != nil {
+			return fmt.Errorf("could not get last removed log: %w", err)
 		}
+	}
+
+	// cursor is the position of the last message sent. Logs at or before it are not sent again.
+	var cursor *pbscribe.LogCursor
+	if req.Cursor != "" {
+		resumeCursor, err := pbscribe.DecodeLogCursor(req.Cursor)
+		if err != nil {
+			return fmt.Errorf("could not resume stream: %w", err)
+		}
+		cursor = &resumeCursor
+		fromBlock = resumeCursor.BlockNumber
+		if streamNewBlocks {
+			// Removals are checked from the cursor's block. A cursor without a removal position keeps the last
+			// removal, so removals from before the stream started aren't replayed.
+			startBlock = resumeCursor.BlockNumber
+			if resumeCursor.RemovedID != 0 {
+				lastRemovedID = resumeCursor.RemovedID
+			}
+		}
+	}
+
+	heartbeatInterval := time.Duration(req.HeartbeatInterval) * time.Second
+	lastSent := time.Now()
+	wait := time.Duration(0)
 
+	for {
 		ctx, span := s.handler.Tracer().Start(res.Context(), "grpc.StreamLogsLoop", trace.WithAttributes(
 			attribute.Int(metrics.ChainID, int(req.Filter.ChainId)),
 			attribute.String(metrics.ContractAddress, req.Filter.ContractAddress.GetData()),
@@ -98,80 +156,189 @@ func (s *server) StreamLogs(req *pbscribe.StreamLogsRequest, res pbscribe.Scribe
 			attribute.Int("toBlock", int(toBlock)),
 		))
 
-		page := 1
-
-		for {
-			logs, err := s.db.RetrieveLogsInRangeAsc(ctx, logFilter, fromBlock, toBlock, page)
-			if err != nil {
-				time.Sleep(time.Duration(retrieveLogsBackoff) * time.Second)
-				continue
-			}
-
-			retrievedLogs = append(retrievedLogs, logs...)
-
-			// See if we do not need to get the next page.
-			if len(logs) < base.PageSize {
-				break
-			}
-
-			span.AddEvent("Getting next page. Page: " + strconv.Itoa(page))
-
-			page++
+		retrievedLogs, err := s.retrieveLogs(ctx, logFilter, fromBlock, toBlock)
+		if err != nil {
+			span.End()
+			return nil
 		}
 
 		// Convert the logs to the protobuf format and send them through the stream.
+		sent := 0
 		for _, log := range retrievedLogs {
+			if cursor != nil && !cursor.IsBefore(log) {
+				continue
+			}
+
+			logCursor := pbscribe.NewLogCursor(log)
+			logCursor.RemovedID = lastRemovedID
 			err = res.Send(&pbscribe.StreamLogsResponse{
-				Log: pbscribe.FromNativeLog(log),
+				Log:    pbscribe.FromNativeLog(log),
+				Cursor: logCursor.Encode(),
 			})
 			if err != nil {
+				span.End()
 				return fmt.Errorf("could not send log: %w", err)
 			}
+			cursor = &logCursor
+			lastSent = time.Now()
+			sent++
 
 			span.AddEvent("Sending log.", trace.WithAttributes(
 				attribute.String(metrics.TxHash, log.TxHash.String()),
 			))
 		}
 
-		span.AddEvent("Got logs. Count: " + strconv.Itoa(len(retrievedLogs)))
+		span.AddEvent("Sent logs. Count: " + strconv.Itoa(sent))
+		span.End()
 
 		if !streamNewBlocks {
-			go func() {
-				span.End()
-			}()
 			return nil
 		}
 
 	STREAM:
 		for {
 			select {
-			case <-ctx.Done():
+			case <-res.Context().Done():
 				return nil
-			default:
-				// TODO: Make wait time configurable (?).
-				time.Sleep(time.Duration(wait) * time.Second)
-				wait = 1
-				latestScribeBlock, err := s.db.RetrieveLastIndexed(ctx, common.HexToAddress(req.Filter.ContractAddress.GetData()), req.Filter.ChainId, false)
+			// TODO: Make wait time configurable (?).
+			case <-time.After(wait):
+				wait = time.Second
+				ctx := res.Context()
+
+				removedFrom, err := s.sendRemovedLogs(ctx, res, logFilter, &lastRemovedID, startBlock, toBlock)
+				if err != nil {
+					return err
+				}
+				// Rewind so the logs re-indexed from the canonical chain are streamed again.
+				if removedFrom != nil && *removedFrom > 0 {
+					rewindCursor := pbscribe.EndOfBlockCursor(*removedFrom - 1)
+					rewindCursor.RemovedID = lastRemovedID
+					cursor = &rewindCursor
+					fromBlock = *removedFrom
+					toBlock = *removedFrom - 1
+					lastSent = time.Now()
+				}
+
+				latestScribeBlock, err := s.lastIndexed(ctx, req.Filter.ChainId, addresses)
 				if err != nil {
 					continue
 				}
 
 				if latestScribeBlock > toBlock {
-					nextFromBlock = toBlock + 1
+					fromBlock = toBlock + 1
 					toBlock = latestScribeBlock
 					wait = 0
+					break STREAM
+				}
 
-					span.AddEvent("New block. From: " + strconv.Itoa(int(nextFromBlock)) + " To: " + strconv.Itoa(int(toBlock)))
+				if heartbeatInterval > 0 && time.Since(lastSent) >= heartbeatInterval {
+					// Every log up to toBlock has been sent, so the stream can be resumed from the end of it.
+					heartbeatCursor := pbscribe.EndOfBlockCursor(toBlock)
+					heartbeatCursor.RemovedID = lastRemovedID
+					err = res.Send(&pbscribe.StreamLogsResponse{
+						Heartbeat: &pbscribe.Heartbeat{BlockNumber: toBlock},
+						Cursor:    heartbeatCursor.Encode(),
+					})
+					if err != nil {
+						return fmt.Errorf("could not send heartbeat: %w", err)
+					}
+					cursor = &heartbeatCursor
+					lastSent = time.Now()
+				}
+			}
+		}
+	}
+}
+
+// retrieveLogs retrieves every page of logs in a block range, retrying until the context is canceled.
+func (s *server) retrieveLogs(ctx context.Context, logFilter db.LogFilter, fromBlock, toBlock uint64) ([]*types.Log, error) {
+	var retrievedLogs []*types.Log
+	page := 1
+	for {
+		logs, err := s.db.RetrieveLogsInRangeAsc(ctx, logFilter, fromBlock, toBlock, page)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("context canceled while retrieving logs: %w", ctx.Err())
+			case <-time.After(retrieveLogsBackoff):
+				continue
+			}
+		}
 
-					go func() {
-						span.End()
-					}()
+		retrievedLogs = append(retrievedLogs, logs...)
 
-					break STREAM
-				}
+		// See if we do not need to get the next page.
+		if len(logs) < base.PageSize {
+			return retrievedLogs, nil
+		}
+
+		page++
+	}
+}
+
+// sendRemovedLogs sends the logs removed by reorgs since lastRemovedID that were already streamed, advancing lastRemovedID.
+// It returns the lowest block number a removed log was sent for, or nil if none were sent. Removed logs are sent with
+// a cursor before their block, so a consumer that reconnects receives the re-indexed logs of the block.
+func (s *server) sendRemovedLogs(ctx context.Context, res pbscribe.ScribeService_StreamLogsServer, logFilter db.LogFilter, lastRemovedID *uint64, startBlock, toBlock uint64) (*uint64, error) {
+	var sentLogs []db.RemovedLog
+	var removedFrom *uint64
+	for {
+		removedLogs, err := s.db.RetrieveRemovedLogs(ctx, logFilter, *lastRemovedID, 1)
+		if err != nil {
+			// Removed logs are retried on the next poll.
+			break
+		}
+
+		for _, removedLog := range removedLogs {
+			*lastRemovedID = removedLog.ID
+			if removedLog.Log.BlockNumber < startBlock || removedLog.Log.BlockNumber > toBlock {
+				continue
 			}
+
+			sentLogs = append(sentLogs, removedLog)
+			if removedFrom == nil || removedLog.Log.BlockNumber < *removedFrom {
+				blockNumber := removedLog.Log.BlockNumber
+				removedFrom = &blockNumber
+			}
+		}
+
+		if len(removedLogs) < base.PageSize {
+			break
 		}
 	}
+
+	if removedFrom == nil {
+		return nil, nil
+	}
+
+	var removedCursor pbscribe.LogCursor
+	if *removedFrom > 0 {
+		removedCursor = pbscribe.EndOfBlockCursor(*removedFrom - 1)
+	}
+	removedCursor.RemovedID = *lastRemovedID
+	for _, removedLog := range sentLogs {
+		err := res.Send(&pbscribe.StreamLogsResponse{
+			Log:    pbscribe.FromNativeLog(removedLog.Log),
+			Cursor: removedCursor.Encode(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not send removed log: %w", err)
+		}
+	}
+
+	return removedFrom, nil
+}
+
+// IndexingStatus gets the indexing progress of every contract on a chain, or on every chain if no chain is set.
+func (s *server) IndexingStatus(ctx context.Context, req *pbscribe.IndexingStatusRequest) (*pbscribe.IndexingStatusResponse, error) {
+	progress, err := s.db.RetrieveIndexerProgress(ctx, req.GetChainId())
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving indexing status: %w", err)
+	}
+
+	return &pbscribe.IndexingStatusResponse{
+		Statuses: pbscribe.FromNativeIndexingStatuses(progress),
+	}, nil
 }
 
 func (s *server) Check(context.Context, *pbscribe.HealthCheckRequest) (*pbscribe.HealthCheckResponse, error) {
@@ -196,16 +363,56 @@ func (s *server) Watch(_ *pbscribe.HealthCheckRequest, res pbscribe.ScribeServic
 	}
 }
 
-func (s *server) setBlocks(ctx context.Context, req *pbscribe.StreamLogsRequest) (uint64, uint64, error) {
+// streamAddresses gets the contracts a stream is filtered to, or the zero address if it is not filtered by contract.
+func streamAddresses(req *pbscribe.StreamLogsRequest) []common.Address {
+	var addresses []common.Address
+	if req.Filter.GetContractAddress().GetData() != "" {
+		addresses = append(addresses, common.HexToAddress(req.Filter.ContractAddress.GetData()))
+	}
+	for _, address := range req.ContractAddresses {
+		addresses = append(addresses, common.HexToAddress(address))
+	}
+	if len(addresses) == 0 {
+		addresses = append(addresses, common.Address{})
+	}
+	return addresses
+}
+
+// lastIndexed gets the last block indexed for every contract of a stream.
+func (s *server) lastIndexed(ctx context.Context, chainID uint32, addresses []common.Address) (uint64, error) {
+	if len(addresses) == 1 {
+		lastIndexed, err := s.db.RetrieveLastIndexed(ctx, addresses[0], chainID, false)
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve last indexed block: %w", err)
+		}
+		return lastIndexed, nil
+	}
+
+	lastIndexedMap, err := s.db.RetrieveLastIndexedMultiple(ctx, addresses, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve last indexed blocks: %w", err)
+	}
+
+	// Logs are only streamed up to the block every contract has been indexed to.
+	lastIndexed := lastIndexedMap[addresses[0]]
+	for _, address := range addresses[1:] {
+		if lastIndexedMap[address] < lastIndexed {
+			lastIndexed = lastIndexedMap[address]
+		}
+	}
+	return lastIndexed, nil
+}
+
+func (s *server) setBlocks(ctx context.Context, req *pbscribe.StreamLogsRequest, addresses []common.Address) (uint64, uint64, error) {
 	blocks := []string{req.FromBlock, req.ToBlock}
 	resBlocks := make([]uint64, 2)
 
 	for i, block := range blocks {
 		switch block {
 		case "latest":
-			lastIndexed, err := s.db.RetrieveLastIndexed(ctx, common.HexToAddress(req.Filter.ContractAddress.GetData()), req.Filter.ChainId, false)
+			lastIndexed, err := s.lastIndexed(ctx, req.Filter.ChainId, addresses)
 			if err != nil {
-				return 0, 0, fmt.Errorf("could not retrieve last indexed block: %w", err)
+				return 0, 0, err
 			}
 
 			resBlocks[i] = lastIndexed
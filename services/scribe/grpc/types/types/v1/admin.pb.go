@@ -0,0 +1,1203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: types/v1/admin.proto
+
+package pbscribe
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ContractConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// end_block stops indexing the contract at a block, 0 to keep livefilling it.
+	EndBlock    uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	RefreshRate uint64 `protobuf:"varint,4,opt,name=refresh_rate,json=refreshRate,proto3" json:"refresh_rate,omitempty"`
+	// traces indexes the internal calls of transactions that touch the contract.
+	Traces bool `protobuf:"varint,5,opt,name=traces,proto3" json:"traces,omitempty"`
+	// topics restricts the events indexed for the contract. Each position lists the accepted values for that topic
+	// (event signatures, topic hashes or indexed argument values), and empty positions match any value.
+	Topics []*TopicFilter `protobuf:"bytes,6,rep,name=topics,proto3" json:"topics,omitempty"`
+	// abi is a path to the contract's ABI on the scribe host, used to decode its logs.
+	Abi string `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (x *ContractConfig) Reset() {
+	*x = ContractConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractConfig) ProtoMessage() {}
+
+func (x *ContractConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractConfig.ProtoReflect.Descriptor instead.
+func (*ContractConfig) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContractConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ContractConfig) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *ContractConfig) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *ContractConfig) GetRefreshRate() uint64 {
+	if x != nil {
+		return x.RefreshRate
+	}
+	return 0
+}
+
+func (x *ContractConfig) GetTraces() bool {
+	if x != nil {
+		return x.Traces
+	}
+	return false
+}
+
+func (x *ContractConfig) GetTopics() []*TopicFilter {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ContractConfig) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+type FactoryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// event is the signature of the child creation event, with indexed parameters marked.
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// child_argument is the (zero based) index of the event parameter holding the child contract address.
+	ChildArgument uint32 `protobuf:"varint,4,opt,name=child_argument,json=childArgument,proto3" json:"child_argument,omitempty"`
+}
+
+func (x *FactoryConfig) Reset() {
+	*x = FactoryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FactoryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactoryConfig) ProtoMessage() {}
+
+func (x *FactoryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactoryConfig.ProtoReflect.Descriptor instead.
+func (*FactoryConfig) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FactoryConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FactoryConfig) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *FactoryConfig) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *FactoryConfig) GetChildArgument() uint32 {
+	if x != nil {
+		return x.ChildArgument
+	}
+	return 0
+}
+
+type ChainConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId               uint32            `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contracts             []*ContractConfig `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Confirmations         uint64            `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	GetLogsRange          uint64            `protobuf:"varint,4,opt,name=get_logs_range,json=getLogsRange,proto3" json:"get_logs_range,omitempty"`
+	GetLogsBatchAmount    uint64            `protobuf:"varint,5,opt,name=get_logs_batch_amount,json=getLogsBatchAmount,proto3" json:"get_logs_batch_amount,omitempty"`
+	StoreConcurrency      uint32            `protobuf:"varint,6,opt,name=store_concurrency,json=storeConcurrency,proto3" json:"store_concurrency,omitempty"`
+	ConcurrencyThreshold  uint64            `protobuf:"varint,7,opt,name=concurrency_threshold,json=concurrencyThreshold,proto3" json:"concurrency_threshold,omitempty"`
+	LivefillThreshold     uint64            `protobuf:"varint,8,opt,name=livefill_threshold,json=livefillThreshold,proto3" json:"livefill_threshold,omitempty"`
+	LivefillRange         uint64            `protobuf:"varint,9,opt,name=livefill_range,json=livefillRange,proto3" json:"livefill_range,omitempty"`
+	LivefillFlushInterval uint64            `protobuf:"varint,10,opt,name=livefill_flush_interval,json=livefillFlushInterval,proto3" json:"livefill_flush_interval,omitempty"`
+	Factories             []*FactoryConfig  `protobuf:"bytes,11,rep,name=factories,proto3" json:"factories,omitempty"`
+	// deployments is a hardhat deployments directory on the scribe host, used to decode the logs of deployed contracts.
+	Deployments string `protobuf:"bytes,12,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	// reorg_check_depth is the number of blocks behind the last indexed block to check for reorgs, 0 to disable.
+	ReorgCheckDepth uint64 `protobuf:"varint,13,opt,name=reorg_check_depth,json=reorgCheckDepth,proto3" json:"reorg_check_depth,omitempty"`
+	// reorg_check_interval is how long to wait between reorg checks (in seconds).
+	ReorgCheckInterval uint64 `protobuf:"varint,14,opt,name=reorg_check_interval,json=reorgCheckInterval,proto3" json:"reorg_check_interval,omitempty"`
+}
+
+func (x *ChainConfig) Reset() {
+	*x = ChainConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfig) ProtoMessage() {}
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChainConfig) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetContracts() []*ContractConfig {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetGetLogsRange() uint64 {
+	if x != nil {
+		return x.GetLogsRange
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetGetLogsBatchAmount() uint64 {
+	if x != nil {
+		return x.GetLogsBatchAmount
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetStoreConcurrency() uint32 {
+	if x != nil {
+		return x.StoreConcurrency
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetConcurrencyThreshold() uint64 {
+	if x != nil {
+		return x.ConcurrencyThreshold
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetLivefillThreshold() uint64 {
+	if x != nil {
+		return x.LivefillThreshold
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetLivefillRange() uint64 {
+	if x != nil {
+		return x.LivefillRange
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetLivefillFlushInterval() uint64 {
+	if x != nil {
+		return x.LivefillFlushInterval
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetFactories() []*FactoryConfig {
+	if x != nil {
+		return x.Factories
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetDeployments() string {
+	if x != nil {
+		return x.Deployments
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetReorgCheckDepth() uint64 {
+	if x != nil {
+		return x.ReorgCheckDepth
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetReorgCheckInterval() uint64 {
+	if x != nil {
+		return x.ReorgCheckInterval
+	}
+	return 0
+}
+
+type AddChainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain *ChainConfig `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *AddChainRequest) Reset() {
+	*x = AddChainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChainRequest) ProtoMessage() {}
+
+func (x *AddChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChainRequest.ProtoReflect.Descriptor instead.
+func (*AddChainRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddChainRequest) GetChain() *ChainConfig {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+type AddChainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddChainResponse) Reset() {
+	*x = AddChainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChainResponse) ProtoMessage() {}
+
+func (x *AddChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChainResponse.ProtoReflect.Descriptor instead.
+func (*AddChainResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+type RemoveChainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *RemoveChainRequest) Reset() {
+	*x = RemoveChainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChainRequest) ProtoMessage() {}
+
+func (x *RemoveChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChainRequest.ProtoReflect.Descriptor instead.
+func (*RemoveChainRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveChainRequest) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type RemoveChainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveChainResponse) Reset() {
+	*x = RemoveChainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChainResponse) ProtoMessage() {}
+
+func (x *RemoveChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChainResponse.ProtoReflect.Descriptor instead.
+func (*RemoveChainResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+type AddContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId  uint32          `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract *ContractConfig `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (x *AddContractRequest) Reset() {
+	*x = AddContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddContractRequest) ProtoMessage() {}
+
+func (x *AddContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddContractRequest.ProtoReflect.Descriptor instead.
+func (*AddContractRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddContractRequest) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *AddContractRequest) GetContract() *ContractConfig {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+type AddContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddContractResponse) Reset() {
+	*x = AddContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddContractResponse) ProtoMessage() {}
+
+func (x *AddContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddContractResponse.ProtoReflect.Descriptor instead.
+func (*AddContractResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+type RemoveContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RemoveContractRequest) Reset() {
+	*x = RemoveContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContractRequest) ProtoMessage() {}
+
+func (x *RemoveContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContractRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContractRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RemoveContractRequest) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *RemoveContractRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type RemoveContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveContractResponse) Reset() {
+	*x = RemoveContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContractResponse) ProtoMessage() {}
+
+func (x *RemoveContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContractResponse.ProtoReflect.Descriptor instead.
+func (*RemoveContractResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+type BackfillContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId    uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	StartBlock uint64 `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock   uint64 `protobuf:"varint,4,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (x *BackfillContractRequest) Reset() {
+	*x = BackfillContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackfillContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillContractRequest) ProtoMessage() {}
+
+func (x *BackfillContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillContractRequest.ProtoReflect.Descriptor instead.
+func (*BackfillContractRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BackfillContractRequest) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *BackfillContractRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BackfillContractRequest) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *BackfillContractRequest) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+type BackfillContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackfillContractResponse) Reset() {
+	*x = BackfillContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_admin_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackfillContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillContractResponse) ProtoMessage() {}
+
+func (x *BackfillContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_admin_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillContractResponse.ProtoReflect.Descriptor instead.
+func (*BackfillContractResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+var File_types_v1_admin_proto protoreflect.FileDescriptor
+
+var file_types_v1_admin_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x62,
+	0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69, 0x22, 0x87, 0x01, 0x0a,
+	0x0d, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x41, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x86, 0x05, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x67,
+	0x73, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6c, 0x69,
+	0x76, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x69, 0x76, 0x65, 0x66, 0x69, 0x6c, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x76,
+	0x65, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x6c, 0x69, 0x76, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x6c, 0x75,
+	0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x6c, 0x69, 0x76, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65,
+	0x6f, 0x72, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x6f,
+	0x72, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0x3e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22,
+	0x12, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x8c, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x1a, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe7, 0x04, 0x0a,
+	0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x66, 0x0a,
+	0x08, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x64, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0b, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64,
+	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a,
+	0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01,
+	0x0a, 0x10, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x22, 0x20, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65, 0x63, 0x6e, 0x73, 0x2f,
+	0x73, 0x61, 0x6e, 0x67, 0x75, 0x69, 0x6e, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x3b, 0x70, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_v1_admin_proto_rawDescOnce sync.Once
+	file_types_v1_admin_proto_rawDescData = file_types_v1_admin_proto_rawDesc
+)
+
+func file_types_v1_admin_proto_rawDescGZIP() []byte {
+	file_types_v1_admin_proto_rawDescOnce.Do(func() {
+		file_types_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_v1_admin_proto_rawDescData)
+	})
+	return file_types_v1_admin_proto_rawDescData
+}
+
+var file_types_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_types_v1_admin_proto_goTypes = []interface{}{
+	(*ContractConfig)(nil),           // 0: types.v1.ContractConfig
+	(*FactoryConfig)(nil),            // 1: types.v1.FactoryConfig
+	(*ChainConfig)(nil),              // 2: types.v1.ChainConfig
+	(*AddChainRequest)(nil),          // 3: types.v1.AddChainRequest
+	(*AddChainResponse)(nil),         // 4: types.v1.AddChainResponse
+	(*RemoveChainRequest)(nil),       // 5: types.v1.RemoveChainRequest
+	(*RemoveChainResponse)(nil),      // 6: types.v1.RemoveChainResponse
+	(*AddContractRequest)(nil),       // 7: types.v1.AddContractRequest
+	(*AddContractResponse)(nil),      // 8: types.v1.AddContractResponse
+	(*RemoveContractRequest)(nil),    // 9: types.v1.RemoveContractRequest
+	(*RemoveContractResponse)(nil),   // 10: types.v1.RemoveContractResponse
+	(*BackfillContractRequest)(nil),  // 11: types.v1.BackfillContractRequest
+	(*BackfillContractResponse)(nil), // 12: types.v1.BackfillContractResponse
+	(*TopicFilter)(nil),              // 13: types.v1.TopicFilter
+}
+var file_types_v1_admin_proto_depIdxs = []int32{
+	13, // 0: types.v1.ContractConfig.topics:type_name -> types.v1.TopicFilter
+	0,  // 1: types.v1.ChainConfig.contracts:type_name -> types.v1.ContractConfig
+	1,  // 2: types.v1.ChainConfig.factories:type_name -> types.v1.FactoryConfig
+	2,  // 3: types.v1.AddChainRequest.chain:type_name -> types.v1.ChainConfig
+	0,  // 4: types.v1.AddContractRequest.contract:type_name -> types.v1.ContractConfig
+	3,  // 5: types.v1.AdminService.AddChain:input_type -> types.v1.AddChainRequest
+	5,  // 6: types.v1.AdminService.RemoveChain:input_type -> types.v1.RemoveChainRequest
+	7,  // 7: types.v1.AdminService.AddContract:input_type -> types.v1.AddContractRequest
+	9,  // 8: types.v1.AdminService.RemoveContract:input_type -> types.v1.RemoveContractRequest
+	11, // 9: types.v1.AdminService.BackfillContract:input_type -> types.v1.BackfillContractRequest
+	4,  // 10: types.v1.AdminService.AddChain:output_type -> types.v1.AddChainResponse
+	6,  // 11: types.v1.AdminService.RemoveChain:output_type -> types.v1.RemoveChainResponse
+	8,  // 12: types.v1.AdminService.AddContract:output_type -> types.v1.AddContractResponse
+	10, // 13: types.v1.AdminService.RemoveContract:output_type -> types.v1.RemoveContractResponse
+	12, // 14: types.v1.AdminService.BackfillContract:output_type -> types.v1.BackfillContractResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_types_v1_admin_proto_init() }
+func file_types_v1_admin_proto_init() {
+	if File_types_v1_admin_proto != nil {
+		return
+	}
+	file_types_v1_filter_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_types_v1_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactoryConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackfillContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackfillContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_v1_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_types_v1_admin_proto_goTypes,
+		DependencyIndexes: file_types_v1_admin_proto_depIdxs,
+		MessageInfos:      file_types_v1_admin_proto_msgTypes,
+	}.Build()
+	File_types_v1_admin_proto = out.File
+	file_types_v1_admin_proto_rawDesc = nil
+	file_types_v1_admin_proto_goTypes = nil
+	file_types_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: types/v1/admin.proto
+
+package pbscribe
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	AddChain(ctx context.Context, in *AddChainRequest, opts ...grpc.CallOption) (*AddChainResponse, error)
+	RemoveChain(ctx context.Context, in *RemoveChainRequest, opts ...grpc.CallOption) (*RemoveChainResponse, error)
+	AddContract(ctx context.Context, in *AddContractRequest, opts ...grpc.CallOption) (*AddContractResponse, error)
+	RemoveContract(ctx context.Context, in *RemoveContractRequest, opts ...grpc.CallOption) (*RemoveContractResponse, error)
+	// BackfillContract indexes a block range of a contract again without changing its last indexed block.
+	BackfillContract(ctx context.Context, in *BackfillContractRequest, opts ...grpc.CallOption) (*BackfillContractResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) AddChain(ctx context.Context, in *AddChainRequest, opts ...grpc.CallOption) (*AddChainResponse, error) {
+	out := new(AddChainResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.AdminService/AddChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveChain(ctx context.Context, in *RemoveChainRequest, opts ...grpc.CallOption) (*RemoveChainResponse, error) {
+	out := new(RemoveChainResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.AdminService/RemoveChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddContract(ctx context.Context, in *AddContractRequest, opts ...grpc.CallOption) (*AddContractResponse, error) {
+	out := new(AddContractResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.AdminService/AddContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveContract(ctx context.Context, in *RemoveContractRequest, opts ...grpc.CallOption) (*RemoveContractResponse, error) {
+	out := new(RemoveContractResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.AdminService/RemoveContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BackfillContract(ctx context.Context, in *BackfillContractRequest, opts ...grpc.CallOption) (*BackfillContractResponse, error) {
+	out := new(BackfillContractResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.AdminService/BackfillContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	AddChain(context.Context, *AddChainRequest) (*AddChainResponse, error)
+	RemoveChain(context.Context, *RemoveChainRequest) (*RemoveChainResponse, error)
+	AddContract(context.Context, *AddContractRequest) (*AddContractResponse, error)
+	RemoveContract(context.Context, *RemoveContractRequest) (*RemoveContractResponse, error)
+	// BackfillContract indexes a block range of a contract again without changing its last indexed block.
+	BackfillContract(context.Context, *BackfillContractRequest) (*BackfillContractResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) AddChain(context.Context, *AddChainRequest) (*AddChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChain not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveChain(context.Context, *RemoveChainRequest) (*RemoveChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChain not implemented")
+}
+func (UnimplementedAdminServiceServer) AddContract(context.Context, *AddContractRequest) (*AddContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContract not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveContract(context.Context, *RemoveContractRequest) (*RemoveContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContract not implemented")
+}
+func (UnimplementedAdminServiceServer) BackfillContract(context.Context, *BackfillContractRequest) (*BackfillContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillContract not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_AddChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.AdminService/AddChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddChain(ctx, req.(*AddChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.AdminService/RemoveChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveChain(ctx, req.(*RemoveChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.AdminService/AddContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddContract(ctx, req.(*AddContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.AdminService/RemoveContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveContract(ctx, req.(*RemoveContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BackfillContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BackfillContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.AdminService/BackfillContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BackfillContract(ctx, req.(*BackfillContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "types.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddChain",
+			Handler:    _AdminService_AddChain_Handler,
+		},
+		{
+			MethodName: "RemoveChain",
+			Handler:    _AdminService_RemoveChain_Handler,
+		},
+		{
+			MethodName: "AddContract",
+			Handler:    _AdminService_AddContract_Handler,
+		},
+		{
+			MethodName: "RemoveContract",
+			Handler:    _AdminService_RemoveContract_Handler,
+		},
+		{
+			MethodName: "BackfillContract",
+			Handler:    _AdminService_BackfillContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "types/v1/admin.proto",
+}
@@ -0,0 +1,119 @@
+package pbscribe
+
+import (
+	"github.com/synapsecns/sanguine/services/scribe/config"
+)
+
+// ToNative converts the contract config to native.
+func (x *ContractConfig) ToNative() config.ContractConfig {
+	var topics [][]string
+	for _, topicFilter := range x.GetTopics() {
+		topics = append(topics, topicFilter.GetValues())
+	}
+
+	return config.ContractConfig{
+		Address:     x.GetAddress(),
+		StartBlock:  x.GetStartBlock(),
+		EndBlock:    x.GetEndBlock(),
+		RefreshRate: x.GetRefreshRate(),
+		Topics:      topics,
+		ABI:         x.GetAbi(),
+		Traces:      x.GetTraces(),
+	}
+}
+
+// FromNativeContractConfig converts a native contract config to a protobuf contract config.
+func FromNativeContractConfig(contract config.ContractConfig) *ContractConfig {
+	topics := make([]*TopicFilter, len(contract.Topics))
+	for i, values := range contract.Topics {
+		topics[i] = &TopicFilter{Values: values}
+	}
+
+	return &ContractConfig{
+		Address:     contract.Address,
+		StartBlock:  contract.StartBlock,
+		EndBlock:    contract.EndBlock,
+		RefreshRate: contract.RefreshRate,
+		Topics:      topics,
+		Abi:         contract.ABI,
+		Traces:      contract.Traces,
+	}
+}
+
+// ToNative converts the factory config to native.
+func (x *FactoryConfig) ToNative() config.FactoryConfig {
+	return config.FactoryConfig{
+		Address:       x.GetAddress(),
+		StartBlock:    x.GetStartBlock(),
+		Event:         x.GetEvent(),
+		ChildArgument: int(x.GetChildArgument()),
+	}
+}
+
+// FromNativeFactoryConfig converts a native factory config to a protobuf factory config.
+func FromNativeFactoryConfig(factory config.FactoryConfig) *FactoryConfig {
+	return &FactoryConfig{
+		Address:       factory.Address,
+		StartBlock:    factory.StartBlock,
+		Event:         factory.Event,
+		ChildArgument: uint32(factory.ChildArgument),
+	}
+}
+
+// ToNative converts the chain config to native.
+func (x *ChainConfig) ToNative() config.ChainConfig {
+	contracts := make(config.ContractConfigs, len(x.GetContracts()))
+	for i, contract := range x.GetContracts() {
+		contracts[i] = contract.ToNative()
+	}
+	factories := make(config.FactoryConfigs, len(x.GetFactories()))
+	for i, factory := range x.GetFactories() {
+		factories[i] = factory.ToNative()
+	}
+
+	return config.ChainConfig{
+		ChainID:               x.GetChainId(),
+		Contracts:             contracts,
+		Factories:             factories,
+		Deployments:           x.GetDeployments(),
+		Confirmations:         x.GetConfirmations(),
+		GetLogsRange:          x.GetGetLogsRange(),
+		GetLogsBatchAmount:    x.GetGetLogsBatchAmount(),
+		StoreConcurrency:      int(x.GetStoreConcurrency()),
+		ConcurrencyThreshold:  x.GetConcurrencyThreshold(),
+		LivefillThreshold:     x.GetLivefillThreshold(),
+		LivefillRange:         x.GetLivefillRange(),
+		LivefillFlushInterval: x.GetLivefillFlushInterval(),
+		ReorgCheckDepth:       x.GetReorgCheckDepth(),
+		ReorgCheckInterval:    x.GetReorgCheckInterval(),
+	}
+}
+
+// FromNativeChainConfig converts a native chain config to a protobuf chain config.
+func FromNativeChainConfig(chain config.ChainConfig) *ChainConfig {
+	contracts := make([]*ContractConfig, len(chain.Contracts))
+	for i, contract := range chain.Contracts {
+		contracts[i] = FromNativeContractConfig(contract)
+	}
+	factories := make([]*FactoryConfig, len(chain.Factories))
+	for i, factory := range chain.Factories {
+		factories[i] = FromNativeFactoryConfig(factory)
+	}
+
+	return &ChainConfig{
+		ChainId:               chain.ChainID,
+		Contracts:             contracts,
+		Factories:             factories,
+		Deployments:           chain.Deployments,
+		Confirmations:         chain.Confirmations,
+		GetLogsRange:          chain.GetLogsRange,
+		GetLogsBatchAmount:    chain.GetLogsBatchAmount,
+		StoreConcurrency:      uint32(chain.StoreConcurrency),
+		ConcurrencyThreshold:  chain.ConcurrencyThreshold,
+		LivefillThreshold:     chain.LivefillThreshold,
+		LivefillRange:         chain.LivefillRange,
+		LivefillFlushInterval: chain.LivefillFlushInterval,
+		ReorgCheckDepth:       chain.ReorgCheckDepth,
+		ReorgCheckInterval:    chain.ReorgCheckInterval,
+	}
+}
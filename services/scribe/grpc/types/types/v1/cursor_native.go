@@ -0,0 +1,81 @@
+package pbscribe
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// cursorLength is the length of an encoded cursor before base64 encoding.
+const cursorLength = 32
+
+// ErrInvalidCursor is returned when a stream cursor cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// LogCursor is the position of a log in a stream. Logs are streamed in (block number, tx index, log index) order.
+type LogCursor struct {
+	BlockNumber uint64
+	TxIndex     uint64
+	Index       uint64
+	// RemovedID is the id of the last log removed by a reorg that the stream has handled.
+	RemovedID uint64
+}
+
+// NewLogCursor gets the cursor of a log.
+func NewLogCursor(log *types.Log) LogCursor {
+	return LogCursor{
+		BlockNumber: log.BlockNumber,
+		TxIndex:     uint64(log.TxIndex),
+		Index:       uint64(log.Index),
+	}
+}
+
+// EndOfBlockCursor gets the cursor after every log of a block.
+func EndOfBlockCursor(blockNumber uint64) LogCursor {
+	return LogCursor{
+		BlockNumber: blockNumber,
+		TxIndex:     math.MaxUint64,
+		Index:       math.MaxUint64,
+	}
+}
+
+// Encode encodes the cursor as an opaque string.
+func (c LogCursor) Encode() string {
+	encoded := make([]byte, cursorLength)
+	binary.BigEndian.PutUint64(encoded[0:8], c.BlockNumber)
+	binary.BigEndian.PutUint64(encoded[8:16], c.TxIndex)
+	binary.BigEndian.PutUint64(encoded[16:24], c.Index)
+	binary.BigEndian.PutUint64(encoded[24:32], c.RemovedID)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// DecodeLogCursor decodes a cursor encoded with Encode.
+func DecodeLogCursor(cursor string) (LogCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(decoded) != cursorLength {
+		return LogCursor{}, fmt.Errorf("could not decode %s: %w", cursor, ErrInvalidCursor)
+	}
+
+	return LogCursor{
+		BlockNumber: binary.BigEndian.Uint64(decoded[0:8]),
+		TxIndex:     binary.BigEndian.Uint64(decoded[8:16]),
+		Index:       binary.BigEndian.Uint64(decoded[16:24]),
+		RemovedID:   binary.BigEndian.Uint64(decoded[24:32]),
+	}, nil
+}
+
+// IsBefore returns true if a log comes after the cursor.
+func (c LogCursor) IsBefore(log *types.Log) bool {
+	logCursor := NewLogCursor(log)
+	if c.BlockNumber != logCursor.BlockNumber {
+		return c.BlockNumber < logCursor.BlockNumber
+	}
+	if c.TxIndex != logCursor.TxIndex {
+		return c.TxIndex < logCursor.TxIndex
+	}
+	return c.Index < logCursor.Index
+}
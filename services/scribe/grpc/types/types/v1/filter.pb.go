@@ -33,6 +33,9 @@ type LogFilter struct {
 	BlockHash       *NullableString `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	Index           *NullableUint64 `protobuf:"bytes,7,opt,name=index,proto3" json:"index,omitempty"`
 	Confirmed       *NullableBool   `protobuf:"bytes,8,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// topics filters logs by topic position. Each position matches any of its values (event signatures, topic hashes or
+	// addresses), and empty positions match any topic.
+	Topics []*TopicFilter `protobuf:"bytes,9,rep,name=topics,proto3" json:"topics,omitempty"`
 }
 
 func (x *LogFilter) Reset() {
@@ -123,13 +126,244 @@ func (x *LogFilter) GetConfirmed() *NullableBool {
 	return nil
 }
 
+func (x *LogFilter) GetTopics() []*TopicFilter {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type TopicFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *TopicFilter) Reset() {
+	*x = TopicFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_filter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicFilter) ProtoMessage() {}
+
+func (x *TopicFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_filter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicFilter.ProtoReflect.Descriptor instead.
+func (*TopicFilter) Descriptor() ([]byte, []int) {
+	return file_types_v1_filter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TopicFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type DecodedLogFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId         uint32          `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress *NullableString `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	EventName       string          `protobuf:"bytes,3,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// args filters decoded logs by argument values, keyed by argument name. All arguments must match.
+	Args       map[string]string `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StartBlock uint64            `protobuf:"varint,5,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// end_block is the last block to include, 0 for no upper bound.
+	EndBlock uint64 `protobuf:"varint,6,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (x *DecodedLogFilter) Reset() {
+	*x = DecodedLogFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_filter_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedLogFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedLogFilter) ProtoMessage() {}
+
+func (x *DecodedLogFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_filter_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedLogFilter.ProtoReflect.Descriptor instead.
+func (*DecodedLogFilter) Descriptor() ([]byte, []int) {
+	return file_types_v1_filter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DecodedLogFilter) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *DecodedLogFilter) GetContractAddress() *NullableString {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return nil
+}
+
+func (x *DecodedLogFilter) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *DecodedLogFilter) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *DecodedLogFilter) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *DecodedLogFilter) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+type CallFrameFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId     uint32          `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash      *NullableString `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	FromAddress *NullableString `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   *NullableString `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	StartBlock  uint64          `protobuf:"varint,5,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// end_block is the last block to include, 0 for no upper bound.
+	EndBlock uint64 `protobuf:"varint,6,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (x *CallFrameFilter) Reset() {
+	*x = CallFrameFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_filter_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallFrameFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallFrameFilter) ProtoMessage() {}
+
+func (x *CallFrameFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_filter_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallFrameFilter.ProtoReflect.Descriptor instead.
+func (*CallFrameFilter) Descriptor() ([]byte, []int) {
+	return file_types_v1_filter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CallFrameFilter) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *CallFrameFilter) GetTxHash() *NullableString {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *CallFrameFilter) GetFromAddress() *NullableString {
+	if x != nil {
+		return x.FromAddress
+	}
+	return nil
+}
+
+func (x *CallFrameFilter) GetToAddress() *NullableString {
+	if x != nil {
+		return x.ToAddress
+	}
+	return nil
+}
+
+func (x *CallFrameFilter) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *CallFrameFilter) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
 var File_types_v1_filter_proto protoreflect.FileDescriptor
 
 var file_types_v1_filter_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
 	0x31, 0x1a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x03, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x46,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x03, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x46,
 	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
 	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61,
@@ -156,12 +390,55 @@ func (x *LogFilter) GetConfirmed() *NullableBool {
 	0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
 	0x31, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x09,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65, 0x63,
-	0x6e, 0x73, 0x2f, 0x73, 0x61, 0x6e, 0x67, 0x75, 0x69, 0x6e, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x70, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x25, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0xc2, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x72,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x37, 0x0a, 0x09, 0x41,
+	0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x02, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65,
+	0x63, 0x6e, 0x73, 0x2f, 0x73, 0x61, 0x6e, 0x67, 0x75, 0x69, 0x6e, 0x65, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x70, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -176,26 +453,36 @@ func file_types_v1_filter_proto_rawDescGZIP() []byte {
 	return file_types_v1_filter_proto_rawDescData
 }
 
-var file_types_v1_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_types_v1_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_types_v1_filter_proto_goTypes = []interface{}{
-	(*LogFilter)(nil),      // 0: types.v1.LogFilter
-	(*NullableString)(nil), // 1: types.v1.NullableString
-	(*NullableUint64)(nil), // 2: types.v1.NullableUint64
-	(*NullableBool)(nil),   // 3: types.v1.NullableBool
+	(*LogFilter)(nil),        // 0: types.v1.LogFilter
+	(*TopicFilter)(nil),      // 1: types.v1.TopicFilter
+	(*DecodedLogFilter)(nil), // 2: types.v1.DecodedLogFilter
+	(*CallFrameFilter)(nil),  // 3: types.v1.CallFrameFilter
+	nil,                      // 4: types.v1.DecodedLogFilter.ArgsEntry
+	(*NullableString)(nil),   // 5: types.v1.NullableString
+	(*NullableUint64)(nil),   // 6: types.v1.NullableUint64
+	(*NullableBool)(nil),     // 7: types.v1.NullableBool
 }
 var file_types_v1_filter_proto_depIdxs = []int32{
-	1, // 0: types.v1.LogFilter.contract_address:type_name -> types.v1.NullableString
-	2, // 1: types.v1.LogFilter.block_number:type_name -> types.v1.NullableUint64
-	1, // 2: types.v1.LogFilter.tx_hash:type_name -> types.v1.NullableString
-	2, // 3: types.v1.LogFilter.tx_index:type_name -> types.v1.NullableUint64
-	1, // 4: types.v1.LogFilter.block_hash:type_name -> types.v1.NullableString
-	2, // 5: types.v1.LogFilter.index:type_name -> types.v1.NullableUint64
-	3, // 6: types.v1.LogFilter.confirmed:type_name -> types.v1.NullableBool
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	5,  // 0: types.v1.LogFilter.contract_address:type_name -> types.v1.NullableString
+	6,  // 1: types.v1.LogFilter.block_number:type_name -> types.v1.NullableUint64
+	5,  // 2: types.v1.LogFilter.tx_hash:type_name -> types.v1.NullableString
+	6,  // 3: types.v1.LogFilter.tx_index:type_name -> types.v1.NullableUint64
+	5,  // 4: types.v1.LogFilter.block_hash:type_name -> types.v1.NullableString
+	6,  // 5: types.v1.LogFilter.index:type_name -> types.v1.NullableUint64
+	7,  // 6: types.v1.LogFilter.confirmed:type_name -> types.v1.NullableBool
+	1,  // 7: types.v1.LogFilter.topics:type_name -> types.v1.TopicFilter
+	5,  // 8: types.v1.DecodedLogFilter.contract_address:type_name -> types.v1.NullableString
+	4,  // 9: types.v1.DecodedLogFilter.args:type_name -> types.v1.DecodedLogFilter.ArgsEntry
+	5,  // 10: types.v1.CallFrameFilter.tx_hash:type_name -> types.v1.NullableString
+	5,  // 11: types.v1.CallFrameFilter.from_address:type_name -> types.v1.NullableString
+	5,  // 12: types.v1.CallFrameFilter.to_address:type_name -> types.v1.NullableString
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_types_v1_filter_proto_init() }
@@ -217,6 +504,42 @@ func file_types_v1_filter_proto_init() {
 				return nil
 			}
 		}
+		file_types_v1_filter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_filter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedLogFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_filter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallFrameFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -224,7 +547,7 @@ type x struct{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v1_filter_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
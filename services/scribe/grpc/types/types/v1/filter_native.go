@@ -44,6 +44,35 @@ func (x *LogFilter) ToNative() db.LogFilter {
 		logFilter.Confirmed = x.Confirmed.GetData()
 	}
 
+	var topics [][]string
+	for _, topicFilter := range x.Topics {
+		topics = append(topics, topicFilter.GetValues())
+	}
+
 	// we use this function to assure new functionality gets included here
-	return db.BuildLogFilter(&logFilter.ContractAddress, blockNumber, &logFilter.TxHash, txIndex, &logFilter.BlockHash, index, &logFilter.Confirmed)
+	return db.BuildLogFilter(&logFilter.ContractAddress, blockNumber, &logFilter.TxHash, txIndex, &logFilter.BlockHash, index, &logFilter.Confirmed, topics)
+}
+
+// ToNative converts the decoded log filter to native.
+func (x *DecodedLogFilter) ToNative() db.DecodedLogFilter {
+	return db.DecodedLogFilter{
+		ChainID:         x.GetChainId(),
+		ContractAddress: x.GetContractAddress().GetData(),
+		EventName:       x.GetEventName(),
+		Args:            x.GetArgs(),
+		StartBlock:      x.GetStartBlock(),
+		EndBlock:        x.GetEndBlock(),
+	}
+}
+
+// ToNative converts the call frame filter to native.
+func (x *CallFrameFilter) ToNative() db.CallFrameFilter {
+	return db.CallFrameFilter{
+		ChainID:     x.GetChainId(),
+		TxHash:      x.GetTxHash().GetData(),
+		FromAddress: x.GetFromAddress().GetData(),
+		ToAddress:   x.GetToAddress().GetData(),
+		StartBlock:  x.GetStartBlock(),
+		EndBlock:    x.GetEndBlock(),
+	}
 }
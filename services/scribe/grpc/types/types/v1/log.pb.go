@@ -131,6 +131,71 @@ func (x *Log) GetRemoved() bool {
 	return false
 }
 
+type DecodedLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log       *Log   `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	EventName string `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// args are the event's arguments keyed by name. Addresses, hashes and bytes are lowercase hex, integers are
+	// decimal and other non scalar values are JSON encoded.
+	Args map[string]string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DecodedLog) Reset() {
+	*x = DecodedLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_log_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedLog) ProtoMessage() {}
+
+func (x *DecodedLog) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_log_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedLog.ProtoReflect.Descriptor instead.
+func (*DecodedLog) Descriptor() ([]byte, []int) {
+	return file_types_v1_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DecodedLog) GetLog() *Log {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *DecodedLog) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *DecodedLog) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
 var File_types_v1_log_proto protoreflect.FileDescriptor
 
 var file_types_v1_log_proto_rawDesc = []byte{
@@ -156,12 +221,24 @@ func (x *Log) GetRemoved() bool {
 	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69,
 	0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x44, 0x5a, 0x42, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73,
-	0x65, 0x63, 0x6e, 0x73, 0x2f, 0x73, 0x61, 0x6e, 0x67, 0x75, 0x69, 0x6e, 0x65, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x70, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xb9, 0x01, 0x0a, 0x0a,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x1f, 0x0a, 0x03, 0x6c, 0x6f,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x2e, 0x41,
+	0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x1a, 0x37,
+	0x0a, 0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65, 0x63, 0x6e, 0x73,
+	0x2f, 0x73, 0x61, 0x6e, 0x67, 0x75, 0x69, 0x6e, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x3b, 0x70, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -176,22 +253,26 @@ func file_types_v1_log_proto_rawDescGZIP() []byte {
 	return file_types_v1_log_proto_rawDescData
 }
 
-var file_types_v1_log_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_types_v1_log_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_types_v1_log_proto_goTypes = []interface{}{
-	(*Log)(nil),     // 0: types.v1.Log
-	(*Address)(nil), // 1: types.v1.Address
-	(*Hash)(nil),    // 2: types.v1.Hash
+	(*Log)(nil),        // 0: types.v1.Log
+	(*DecodedLog)(nil), // 1: types.v1.DecodedLog
+	nil,                // 2: types.v1.DecodedLog.ArgsEntry
+	(*Address)(nil),    // 3: types.v1.Address
+	(*Hash)(nil),       // 4: types.v1.Hash
 }
 var file_types_v1_log_proto_depIdxs = []int32{
-	1, // 0: types.v1.Log.address:type_name -> types.v1.Address
-	2, // 1: types.v1.Log.topics:type_name -> types.v1.Hash
-	2, // 2: types.v1.Log.tx_hash:type_name -> types.v1.Hash
-	2, // 3: types.v1.Log.block_hash:type_name -> types.v1.Hash
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 0: types.v1.Log.address:type_name -> types.v1.Address
+	4, // 1: types.v1.Log.topics:type_name -> types.v1.Hash
+	4, // 2: types.v1.Log.tx_hash:type_name -> types.v1.Hash
+	4, // 3: types.v1.Log.block_hash:type_name -> types.v1.Hash
+	0, // 4: types.v1.DecodedLog.log:type_name -> types.v1.Log
+	2, // 5: types.v1.DecodedLog.args:type_name -> types.v1.DecodedLog.ArgsEntry
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_types_v1_log_proto_init() }
@@ -213,6 +294,18 @@ func file_types_v1_log_proto_init() {
 				return nil
 			}
 		}
+		file_types_v1_log_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -220,7 +313,7 @@ type x struct{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v1_log_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
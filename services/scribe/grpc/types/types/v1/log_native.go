@@ -2,6 +2,7 @@
 
 import (
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/db"
 )
 
 // FromNativeLog converts a native log to a proto log.
@@ -49,3 +50,29 @@ func ToNativeLogs(logs []*Log) (res []*types.Log) {
 	}
 	return res
 }
+
+// FromNativeDecodedLog converts a native decoded log to a proto decoded log.
+func FromNativeDecodedLog(decodedLog db.DecodedLog) *DecodedLog {
+	return &DecodedLog{
+		Log:       FromNativeLog(&decodedLog.Log),
+		EventName: decodedLog.EventName,
+		Args:      decodedLog.Args,
+	}
+}
+
+// ToNative converts a decoded log to a native decoded log.
+func (x *DecodedLog) ToNative() db.DecodedLog {
+	return db.DecodedLog{
+		Log:       *x.GetLog().ToLog(),
+		EventName: x.GetEventName(),
+		Args:      x.GetArgs(),
+	}
+}
+
+// FromNativeDecodedLogs is a helper function for converting a batch of decoded logs all at once.
+func FromNativeDecodedLogs(decodedLogs []db.DecodedLog) (res []*DecodedLog) {
+	for _, decodedLog := range decodedLogs {
+		res = append(res, FromNativeDecodedLog(decodedLog))
+	}
+	return res
+}
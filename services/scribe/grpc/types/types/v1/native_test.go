@@ -6,8 +6,12 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
 	pbscribe "github.com/synapsecns/sanguine/services/scribe/grpc/types/types/v1"
+	"math/big"
 	"testing"
+	"time"
 )
 
 func TestAddressConversion(t *testing.T) {
@@ -47,6 +51,106 @@ func TestLogsConversion(t *testing.T) {
 	Equal(t, mockLogs, reConvertedLogs)
 }
 
+func TestDecodedLogsConversion(t *testing.T) {
+	for _, log := range mocks.GetMockLogs(t, 50) {
+		decodedLog := db.DecodedLog{
+			Log:       log,
+			EventName: "Transfer",
+			Args:      map[string]string{"to": mocks.MockAddress().String(), "value": "1"},
+		}
+
+		reConvertedLog := pbscribe.FromNativeDecodedLog(decodedLog).ToNative()
+		Equal(t, decodedLog, reConvertedLog)
+	}
+}
+
+func TestCallFrameConversion(t *testing.T) {
+	frames := []db.CallFrame{
+		{
+			TxHash:       common.BigToHash(big.NewInt(1)),
+			BlockNumber:  10,
+			BlockHash:    common.BigToHash(big.NewInt(2)),
+			TraceAddress: []uint64{},
+			Type:         "CALL",
+			From:         mocks.MockAddress(),
+			To:           mocks.MockAddress(),
+			Value:        big.NewInt(5),
+			Gas:          100000,
+			GasUsed:      21000,
+			Input:        []byte{1, 2, 3, 4},
+		},
+		{
+			TxHash:       common.BigToHash(big.NewInt(1)),
+			BlockNumber:  10,
+			BlockHash:    common.BigToHash(big.NewInt(2)),
+			Index:        1,
+			TraceAddress: []uint64{0},
+			Type:         "DELEGATECALL",
+			From:         mocks.MockAddress(),
+			To:           mocks.MockAddress(),
+			Output:       []byte{5},
+			Error:        "execution reverted",
+		},
+	}
+
+	for _, frame := range frames {
+		Equal(t, frame, pbscribe.FromNativeCallFrame(frame).ToNative())
+	}
+}
+
+func TestLogCursor(t *testing.T) {
+	log := &types.Log{BlockNumber: 10, TxIndex: 2, Index: 5}
+	cursor := pbscribe.NewLogCursor(log)
+	cursor.RemovedID = 7
+
+	decodedCursor, err := pbscribe.DecodeLogCursor(cursor.Encode())
+	Nil(t, err)
+	Equal(t, cursor, decodedCursor)
+
+	False(t, cursor.IsBefore(log))
+	True(t, cursor.IsBefore(&types.Log{BlockNumber: 10, TxIndex: 2, Index: 6}))
+	True(t, cursor.IsBefore(&types.Log{BlockNumber: 10, TxIndex: 3, Index: 0}))
+	True(t, cursor.IsBefore(&types.Log{BlockNumber: 11}))
+	False(t, cursor.IsBefore(&types.Log{BlockNumber: 10, TxIndex: 1, Index: 9}))
+
+	endOfBlock := pbscribe.EndOfBlockCursor(10)
+	False(t, endOfBlock.IsBefore(&types.Log{BlockNumber: 10, TxIndex: 100, Index: 100}))
+	True(t, endOfBlock.IsBefore(&types.Log{BlockNumber: 11}))
+
+	_, err = pbscribe.DecodeLogCursor("not a cursor")
+	ErrorIs(t, err, pbscribe.ErrInvalidCursor)
+}
+
+func TestIndexingStatusConversion(t *testing.T) {
+	eta := 90 * time.Second
+	progress := db.IndexerProgress{
+		ChainID:         1,
+		ContractAddress: mocks.MockAddress(),
+		Mode:            db.BackfillMode,
+		CurrentBlock:    100,
+		TargetBlock:     1000,
+		HeadBlock:       1200,
+		Rates:           []db.IndexingRate{{Window: time.Minute, BlocksPerSecond: 2.5}},
+		ETA:             &eta,
+		LastProgressAt:  time.Unix(1100, 0),
+		UpdatedAt:       time.Unix(1200, 0),
+	}
+
+	status := pbscribe.FromNativeIndexingStatus(progress)
+	Equal(t, progress.ContractAddress.String(), status.GetContractAddress())
+	Equal(t, "backfill", status.GetMode())
+	Equal(t, uint64(1100), status.GetLag())
+	Equal(t, uint64(60), status.GetRates()[0].GetWindowSeconds())
+	Equal(t, 2.5, status.GetRates()[0].GetBlocksPerSecond())
+	Equal(t, int64(90), status.GetEtaSeconds())
+	Equal(t, int64(0), status.GetLastErrorTime())
+	Equal(t, int64(1200), status.GetUpdateTime())
+
+	// An unknown ETA is reported as -1.
+	progress.ETA = nil
+	Equal(t, int64(-1), pbscribe.FromNativeIndexingStatus(progress).GetEtaSeconds())
+}
+
 // LogsPointer wraps logs in a pointer.
 func LogsPointer(logs []types.Log) (res []*types.Log) {
 	for _, log := range logs {
@@ -54,3 +158,37 @@ func LogsPointer(logs []types.Log) (res []*types.Log) {
 	}
 	return res
 }
+
+func TestChainConfigConversion(t *testing.T) {
+	chainConfig := config.ChainConfig{
+		ChainID: 1,
+		Contracts: config.ContractConfigs{{
+			Address:     mocks.MockAddress().String(),
+			StartBlock:  10,
+			EndBlock:    20,
+			RefreshRate: 5,
+			Topics:      [][]string{{"Transfer(address,address,uint256)"}, {}, {mocks.MockAddress().String()}},
+			ABI:         "abis/token.json",
+			Traces:      true,
+		}},
+		Factories: config.FactoryConfigs{{
+			Address:       mocks.MockAddress().String(),
+			StartBlock:    30,
+			Event:         "PairCreated(address indexed token0, address indexed token1, address pair, uint256)",
+			ChildArgument: 2,
+		}},
+		Deployments:           "deployments/mainnet",
+		Confirmations:         3,
+		GetLogsRange:          100,
+		GetLogsBatchAmount:    2,
+		StoreConcurrency:      4,
+		ConcurrencyThreshold:  50,
+		LivefillThreshold:     200,
+		LivefillRange:         10,
+		LivefillFlushInterval: 60,
+		ReorgCheckDepth:       64,
+		ReorgCheckInterval:    30,
+	}
+
+	Equal(t, chainConfig, pbscribe.FromNativeChainConfig(chainConfig).ToNative())
+}
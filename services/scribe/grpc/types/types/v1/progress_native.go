@@ -0,0 +1,47 @@
+package pbscribe
+
+import (
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+// FromNativeIndexingStatuses converts native indexing progress to protobuf indexing statuses.
+func FromNativeIndexingStatuses(progress []db.IndexerProgress) []*IndexingStatus {
+	statuses := make([]*IndexingStatus, len(progress))
+	for i, contractProgress := range progress {
+		statuses[i] = FromNativeIndexingStatus(contractProgress)
+	}
+	return statuses
+}
+
+// FromNativeIndexingStatus converts native indexing progress to a protobuf indexing status.
+func FromNativeIndexingStatus(progress db.IndexerProgress) *IndexingStatus {
+	rates := make([]*IndexingRate, len(progress.Rates))
+	for i, rate := range progress.Rates {
+		rates[i] = &IndexingRate{
+			WindowSeconds:   uint64(rate.Window.Seconds()),
+			BlocksPerSecond: rate.BlocksPerSecond,
+		}
+	}
+
+	status := &IndexingStatus{
+		ChainId:          progress.ChainID,
+		ContractAddress:  progress.ContractAddress.String(),
+		Mode:             string(progress.Mode),
+		CurrentBlock:     progress.CurrentBlock,
+		TargetBlock:      progress.TargetBlock,
+		HeadBlock:        progress.HeadBlock,
+		Lag:              progress.Lag(),
+		Rates:            rates,
+		EtaSeconds:       -1,
+		LastError:        progress.LastError,
+		LastProgressTime: progress.LastProgressAt.Unix(),
+		UpdateTime:       progress.UpdatedAt.Unix(),
+	}
+	if progress.ETA != nil {
+		status.EtaSeconds = int64(progress.ETA.Seconds())
+	}
+	if progress.LastErrorAt != nil {
+		status.LastErrorTime = progress.LastErrorAt.Unix()
+	}
+	return status
+}
@@ -26,7 +26,10 @@ type ScribeServiceClient interface {
 	Check(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	Watch(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (ScribeService_WatchClient, error)
 	FilterLogs(ctx context.Context, in *FilterLogsRequest, opts ...grpc.CallOption) (*FilterLogsResponse, error)
+	FilterDecodedLogs(ctx context.Context, in *FilterDecodedLogsRequest, opts ...grpc.CallOption) (*FilterDecodedLogsResponse, error)
 	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (ScribeService_StreamLogsClient, error)
+	IndexingStatus(ctx context.Context, in *IndexingStatusRequest, opts ...grpc.CallOption) (*IndexingStatusResponse, error)
+	FilterCallFrames(ctx context.Context, in *FilterCallFramesRequest, opts ...grpc.CallOption) (*FilterCallFramesResponse, error)
 }
 
 type scribeServiceClient struct {
@@ -87,6 +90,15 @@ func (c *scribeServiceClient) FilterLogs(ctx context.Context, in *FilterLogsRequ
 	return out, nil
 }
 
+func (c *scribeServiceClient) FilterDecodedLogs(ctx context.Context, in *FilterDecodedLogsRequest, opts ...grpc.CallOption) (*FilterDecodedLogsResponse, error) {
+	out := new(FilterDecodedLogsResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.ScribeService/FilterDecodedLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scribeServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (ScribeService_StreamLogsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ScribeService_ServiceDesc.Streams[1], "/types.v1.ScribeService/StreamLogs", opts...)
 	if err != nil {
@@ -119,6 +131,24 @@ func (x *scribeServiceStreamLogsClient) Recv() (*StreamLogsResponse, error) {
 	return m, nil
 }
 
+func (c *scribeServiceClient) IndexingStatus(ctx context.Context, in *IndexingStatusRequest, opts ...grpc.CallOption) (*IndexingStatusResponse, error) {
+	out := new(IndexingStatusResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.ScribeService/IndexingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scribeServiceClient) FilterCallFrames(ctx context.Context, in *FilterCallFramesRequest, opts ...grpc.CallOption) (*FilterCallFramesResponse, error) {
+	out := new(FilterCallFramesResponse)
+	err := c.cc.Invoke(ctx, "/types.v1.ScribeService/FilterCallFrames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScribeServiceServer is the server API for ScribeService service.
 // All implementations must embed UnimplementedScribeServiceServer
 // for forward compatibility
@@ -127,7 +157,10 @@ type ScribeServiceServer interface {
 	Check(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	Watch(*HealthCheckRequest, ScribeService_WatchServer) error
 	FilterLogs(context.Context, *FilterLogsRequest) (*FilterLogsResponse, error)
+	FilterDecodedLogs(context.Context, *FilterDecodedLogsRequest) (*FilterDecodedLogsResponse, error)
 	StreamLogs(*StreamLogsRequest, ScribeService_StreamLogsServer) error
+	IndexingStatus(context.Context, *IndexingStatusRequest) (*IndexingStatusResponse, error)
+	FilterCallFrames(context.Context, *FilterCallFramesRequest) (*FilterCallFramesResponse, error)
 	mustEmbedUnimplementedScribeServiceServer()
 }
 
@@ -144,9 +177,18 @@ func (UnimplementedScribeServiceServer) Watch(*HealthCheckRequest, ScribeService
 func (UnimplementedScribeServiceServer) FilterLogs(context.Context, *FilterLogsRequest) (*FilterLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterLogs not implemented")
 }
+func (UnimplementedScribeServiceServer) FilterDecodedLogs(context.Context, *FilterDecodedLogsRequest) (*FilterDecodedLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterDecodedLogs not implemented")
+}
 func (UnimplementedScribeServiceServer) StreamLogs(*StreamLogsRequest, ScribeService_StreamLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
 }
+func (UnimplementedScribeServiceServer) IndexingStatus(context.Context, *IndexingStatusRequest) (*IndexingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexingStatus not implemented")
+}
+func (UnimplementedScribeServiceServer) FilterCallFrames(context.Context, *FilterCallFramesRequest) (*FilterCallFramesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterCallFrames not implemented")
+}
 func (UnimplementedScribeServiceServer) mustEmbedUnimplementedScribeServiceServer() {}
 
 // UnsafeScribeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +259,24 @@ func _ScribeService_FilterLogs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScribeService_FilterDecodedLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterDecodedLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScribeServiceServer).FilterDecodedLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.ScribeService/FilterDecodedLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScribeServiceServer).FilterDecodedLogs(ctx, req.(*FilterDecodedLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScribeService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamLogsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -238,6 +298,42 @@ func (x *scribeServiceStreamLogsServer) Send(m *StreamLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ScribeService_IndexingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScribeServiceServer).IndexingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.ScribeService/IndexingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScribeServiceServer).IndexingStatus(ctx, req.(*IndexingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScribeService_FilterCallFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterCallFramesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScribeServiceServer).FilterCallFrames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.v1.ScribeService/FilterCallFrames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScribeServiceServer).FilterCallFrames(ctx, req.(*FilterCallFramesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScribeService_ServiceDesc is the grpc.ServiceDesc for ScribeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +349,18 @@ func (x *scribeServiceStreamLogsServer) Send(m *StreamLogsResponse) error {
 			MethodName: "FilterLogs",
 			Handler:    _ScribeService_FilterLogs_Handler,
 		},
+		{
+			MethodName: "FilterDecodedLogs",
+			Handler:    _ScribeService_FilterDecodedLogs_Handler,
+		},
+		{
+			MethodName: "IndexingStatus",
+			Handler:    _ScribeService_IndexingStatus_Handler,
+		},
+		{
+			MethodName: "FilterCallFrames",
+			Handler:    _ScribeService_FilterCallFrames_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,66 @@
+package pbscribe
+
+import (
+	"math/big"
+
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+// FromNativeCallFrame converts a native call frame to a proto call frame.
+func FromNativeCallFrame(frame db.CallFrame) *CallFrame {
+	callFrame := &CallFrame{
+		TxHash:           FromNativeHash(frame.TxHash),
+		BlockNumber:      frame.BlockNumber,
+		BlockHash:        FromNativeHash(frame.BlockHash),
+		TransactionIndex: frame.TransactionIndex,
+		Index:            frame.Index,
+		TraceAddress:     frame.TraceAddress,
+		Type:             frame.Type,
+		From:             FromNativeAddress(frame.From),
+		To:               FromNativeAddress(frame.To),
+		Gas:              frame.Gas,
+		GasUsed:          frame.GasUsed,
+		Input:            frame.Input,
+		Output:           frame.Output,
+		Error:            frame.Error,
+	}
+	if frame.Value != nil {
+		callFrame.Value = frame.Value.String()
+	}
+	return callFrame
+}
+
+// ToNative converts a call frame to a native call frame.
+func (x *CallFrame) ToNative() db.CallFrame {
+	frame := db.CallFrame{
+		TxHash:           x.GetTxHash().ToHash(),
+		BlockNumber:      x.GetBlockNumber(),
+		BlockHash:        x.GetBlockHash().ToHash(),
+		TransactionIndex: x.GetTransactionIndex(),
+		Index:            x.GetIndex(),
+		TraceAddress:     x.GetTraceAddress(),
+		Type:             x.GetType(),
+		From:             x.GetFrom().ToAddress(),
+		To:               x.GetTo().ToAddress(),
+		Gas:              x.GetGas(),
+		GasUsed:          x.GetGasUsed(),
+		Input:            x.GetInput(),
+		Output:           x.GetOutput(),
+		Error:            x.GetError(),
+	}
+	if value, ok := new(big.Int).SetString(x.GetValue(), 10); ok {
+		frame.Value = value
+	}
+	if frame.TraceAddress == nil {
+		frame.TraceAddress = []uint64{}
+	}
+	return frame
+}
+
+// FromNativeCallFrames is a helper function for converting a batch of call frames all at once.
+func FromNativeCallFrames(frames []db.CallFrame) (res []*CallFrame) {
+	for _, frame := range frames {
+		res = append(res, FromNativeCallFrame(frame))
+	}
+	return res
+}
@@ -39,6 +39,16 @@
 	FatalScribeError
 	// ErroneousHeadBlock is returned when the head block is below the last indexed.
 	ErroneousHeadBlock
+	// ReorgCheckError is returned when indexed block hashes cannot be verified against the chain.
+	ReorgCheckError
+	// FactoryChildError is returned when the child of a factory creation event cannot be decoded.
+	FactoryChildError
+	// DecodeLogError is returned when a log cannot be decoded with its contract's ABI.
+	DecodeLogError
+	// ProgressStoreError is returned when indexing progress cannot be stored.
+	ProgressStoreError
+	// SinkError is returned when logs cannot be delivered to a sink.
+	SinkError
 )
 
 const (
@@ -54,6 +64,10 @@
 	BackfillCompleted
 	// BeginBackfillIndexing is returned when a backfill is beginning.
 	BeginBackfillIndexing
+	// ReorgDetected is returned when indexed blocks were orphaned by a reorg and are being re-indexed.
+	ReorgDetected
+	// FactoryChildDiscovered is returned when a factory creates a new contract to index.
+	FactoryChildDiscovered
 )
 
 // ErrorType is a type of error.
@@ -101,6 +115,8 @@ func ReportIndexerError(err error, indexerData scribeTypes.IndexerConfig, errorT
 		logger.Warnf("Encountered empty getlogs chunk%s", unpackIndexerConfig(indexerData))
 	case ErroneousHeadBlock:
 		logger.Warnf("Head block is below last indexed block%s", unpackIndexerConfig(indexerData))
+	case DecodeLogError:
+		logger.Warnf("Could not decode log. Error: %v\n%s", errStr, unpackIndexerConfig(indexerData))
 	default:
 		logger.Errorf("Error: %v\n%s", errStr, unpackIndexerConfig(indexerData))
 	}
@@ -117,6 +133,14 @@ func ReportScribeError(err error, chainID uint32, errorType ErrorType) {
 		logger.Errorf("Could not get head block on chain %d. Error: %v", chainID, err)
 	case TestError:
 		logger.Errorf("Test error on chain %d. Error: %v", chainID, err)
+	case ReorgCheckError:
+		logger.Errorf("Could not check for reorgs on chain %d. Error: %v", chainID, err)
+	case FactoryChildError:
+		logger.Errorf("Could not register factory child on chain %d. Error: %v", chainID, err)
+	case ProgressStoreError:
+		logger.Errorf("Could not store indexing progress. Error: %v", err)
+	case SinkError:
+		logger.Errorf("Could not deliver logs to sink on chain %d. Error: %v", chainID, err)
 
 	default:
 
@@ -140,6 +164,10 @@ func ReportScribeState(chainID uint32, block uint64, addresses []common.Address,
 		logger.Warnf("Flushing logs at head on chain %d", chainID)
 	case CreatingSQLStore:
 		logger.Warnf("Creating SQL store")
+	case ReorgDetected:
+		logger.Warnf("Reorg detected on chain %d, re-indexing from block %d", chainID, block)
+	case FactoryChildDiscovered:
+		logger.Warnf("Factory child discovered on chain %d on block %d (factory, child): %s", chainID, block, dumpAddresses(addresses))
 	default:
 		logger.Warnf("Event on chain %d on block %d while interacting with contract %s", chainID, block, dumpAddresses(addresses))
 	}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/decoder"
+)
+
+// ErrChainNotFound is returned when a chain is not indexed.
+var ErrChainNotFound = errors.New("chain not found")
+
+// ErrChainExists is returned when adding a chain that is already indexed.
+var ErrChainExists = errors.New("chain already exists")
+
+// ErrContractNotFound is returned when a contract is not indexed.
+var ErrContractNotFound = errors.New("contract not found")
+
+// ErrContractExists is returned when adding a contract that is already indexed.
+var ErrContractExists = errors.New("contract already exists")
+
+// ErrNotIndexing is returned when a chain is not indexing.
+var ErrNotIndexing = errors.New("chain is not indexing")
+
+// ErrInvalidConfig is returned when an added chain or contract's config is invalid.
+var ErrInvalidConfig = errors.New("invalid config")
+
+// ErrInvalidRange is returned when a backfill's end block is before its start block.
+var ErrInvalidRange = errors.New("invalid block range")
+
+// ClientDialer dials the clients used to index a chain added while scribe is running.
+type ClientDialer func(ctx context.Context, chainID uint32) ([]backend.ScribeBackend, error)
+
+// SetClientDialer sets the dialer used to create clients for chains added while scribe is running.
+func (s *Scribe) SetClientDialer(dialer ClientDialer) {
+	s.clientDialer = dialer
+}
+
+// SetConfigPath sets the path the config is saved to when chains or contracts are added or removed.
+func (s *Scribe) SetConfigPath(configPath string) {
+	s.configPath = configPath
+}
+
+// AddChain starts indexing a chain.
+func (s *Scribe) AddChain(ctx context.Context, chainConfig config.ChainConfig) error {
+	if ok, err := chainConfig.IsValid(); !ok {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, ok := s.chainIndexers[chainConfig.ChainID]; ok {
+		return fmt.Errorf("could not add chain %d: %w", chainConfig.ChainID, ErrChainExists)
+	}
+
+	clients, ok := s.clients[chainConfig.ChainID]
+	if !ok {
+		if s.clientDialer == nil {
+			return fmt.Errorf("could not add chain %d: no client dialer is set", chainConfig.ChainID)
+		}
+
+		var err error
+		clients, err = s.clientDialer(ctx, chainConfig.ChainID)
+		if err != nil {
+			return fmt.Errorf("could not dial clients for chain %d: %w", chainConfig.ChainID, err)
+		}
+		s.clients[chainConfig.ChainID] = clients
+	}
+
+	chainIndexer, err := NewChainIndexer(s.eventDB, clients, chainConfig, s.handler)
+	if err != nil {
+		return fmt.Errorf("could not create chain indexer: %w", err)
+	}
+	chainIndexer.SetProgressTracker(s.progressTracker)
+	s.chainIndexers[chainConfig.ChainID] = chainIndexer
+	s.config.Chains = append(s.config.Chains, chainConfig)
+
+	// Chains added before scribe starts are started with the rest.
+	if s.group != nil {
+		s.startChain(chainConfig.ChainID, chainIndexer)
+	}
+
+	return s.saveConfig()
+}
+
+// RemoveChain stops indexing a chain. Its indexed data is kept, but its indexing progress is removed.
+func (s *Scribe) RemoveChain(ctx context.Context, chainID uint32) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, ok := s.chainIndexers[chainID]; !ok {
+		return fmt.Errorf("could not remove chain %d: %w", chainID, ErrChainNotFound)
+	}
+
+	if cancelChain, ok := s.chainCancels[chainID]; ok {
+		cancelChain()
+		delete(s.chainCancels, chainID)
+	}
+	delete(s.chainIndexers, chainID)
+	s.progressTracker.RemoveChain(chainID)
+	err := s.eventDB.RemoveIndexerProgress(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("could not remove chain %d: %w", chainID, err)
+	}
+
+	for i := range s.config.Chains {
+		if s.config.Chains[i].ChainID == chainID {
+			s.config.Chains = append(s.config.Chains[:i:i], s.config.Chains[i+1:]...)
+			break
+		}
+	}
+
+	return s.saveConfig()
+}
+
+// AddContract starts indexing a contract on a chain.
+func (s *Scribe) AddContract(_ context.Context, chainID uint32, contract config.ContractConfig) error {
+	if ok, err := contract.IsValid(); !ok {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	chainIndexer, ok := s.chainIndexers[chainID]
+	if !ok {
+		return fmt.Errorf("could not add contract to chain %d: %w", chainID, ErrChainNotFound)
+	}
+
+	err := chainIndexer.AddContract(contract)
+	if err != nil {
+		return err
+	}
+
+	for i := range s.config.Chains {
+		if s.config.Chains[i].ChainID == chainID {
+			s.config.Chains[i].Contracts = append(s.config.Chains[i].Contracts, contract)
+			break
+		}
+	}
+
+	return s.saveConfig()
+}
+
+// RemoveContract stops indexing a contract on a chain. Its indexed data is kept, but its indexing progress is removed.
+func (s *Scribe) RemoveContract(ctx context.Context, chainID uint32, address common.Address) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	chainIndexer, ok := s.chainIndexers[chainID]
+	if !ok {
+		return fmt.Errorf("could not remove contract from chain %d: %w", chainID, ErrChainNotFound)
+	}
+
+	err := chainIndexer.RemoveContract(address)
+	if err != nil {
+		return err
+	}
+	err = s.eventDB.RemoveIndexerProgress(ctx, chainID, address)
+	if err != nil {
+		return fmt.Errorf("could not remove contract %s: %w", address, err)
+	}
+
+	for i := range s.config.Chains {
+		if s.config.Chains[i].ChainID == chainID {
+			s.config.Chains[i].Contracts = removeContractConfig(s.config.Chains[i].Contracts, address)
+			break
+		}
+	}
+
+	return s.saveConfig()
+}
+
+// BackfillContract indexes a block range of a contract on a chain again, without changing its last indexed block.
+func (s *Scribe) BackfillContract(ctx context.Context, chainID uint32, address common.Address, startBlock, endBlock uint64) error {
+	s.mux.RLock()
+	chainIndexer, ok := s.chainIndexers[chainID]
+	s.mux.RUnlock()
+	if !ok {
+		return fmt.Errorf("could not backfill contract on chain %d: %w", chainID, ErrChainNotFound)
+	}
+
+	return chainIndexer.Backfill(ctx, address, startBlock, endBlock)
+}
+
+// saveConfig saves the config to the config path, if one is set.
+func (s *Scribe) saveConfig() error {
+	if s.configPath == "" {
+		return nil
+	}
+
+	err := s.config.Save(s.configPath)
+	if err != nil {
+		return fmt.Errorf("could not save config: %w", err)
+	}
+	return nil
+}
+
+// AddContract starts indexing a contract. If the chain is not indexing, the contract is indexed when it starts again.
+// Its logs are decoded if it has an ABI.
+func (c *ChainIndexer) AddContract(contract config.ContractConfig) error {
+	var contractABI *abi.ABI
+	if contract.ABI != "" {
+		var err error
+		contractABI, err = decoder.LoadABI(contract.ABI)
+		if err != nil {
+			return fmt.Errorf("%w: could not load abi of %s: %w", ErrInvalidConfig, contract.Address, err)
+		}
+	}
+
+	c.contractsMux.Lock()
+	added := c.addContractLocked(contract)
+	indexCtx := c.indexCtx
+	c.contractsMux.Unlock()
+	if !added {
+		return fmt.Errorf("could not add contract %s: %w", contract.Address, ErrContractExists)
+	}
+	if contractABI != nil {
+		c.decoder.AddABI(common.HexToAddress(contract.Address), contractABI)
+	}
+	if indexCtx == nil {
+		return nil
+	}
+
+	// The contract is indexed by the next indexing run if the current one ends first.
+	select {
+	case <-indexCtx.Done():
+		return nil
+	case c.addedContracts <- contract:
+		return nil
+	}
+}
+
+// RemoveContract stops indexing a contract.
+func (c *ChainIndexer) RemoveContract(address common.Address) error {
+	c.contractsMux.Lock()
+	if !c.hasContractLocked(address) {
+		c.contractsMux.Unlock()
+		return fmt.Errorf("could not remove contract %s: %w", address, ErrContractNotFound)
+	}
+	c.chainConfig.Contracts = removeContractConfig(c.chainConfig.Contracts, address)
+	if cancelContract, ok := c.contractCancels[address]; ok {
+		cancelContract()
+		delete(c.contractCancels, address)
+	}
+	indexCtx := c.indexCtx
+	c.contractsMux.Unlock()
+	c.progressTracker.Remove(c.chainID, address)
+	if indexCtx == nil {
+		return nil
+	}
+
+	select {
+	case <-indexCtx.Done():
+		return nil
+	case c.removedContracts <- address:
+		return nil
+	}
+}
+
+// Backfill indexes a block range of a contract again, without changing its last indexed block.
+func (c *ChainIndexer) Backfill(ctx context.Context, address common.Address, startBlock, endBlock uint64) error {
+	if endBlock < startBlock {
+		return fmt.Errorf("could not backfill blocks %d to %d: %w", startBlock, endBlock, ErrInvalidRange)
+	}
+
+	c.contractsMux.RLock()
+	hasContract := c.hasContractLocked(address)
+	indexCtx := c.indexCtx
+	c.contractsMux.RUnlock()
+	if !hasContract {
+		return fmt.Errorf("could not backfill contract %s: %w", address, ErrContractNotFound)
+	}
+	if indexCtx == nil {
+		return fmt.Errorf("could not backfill contract %s: %w", address, ErrNotIndexing)
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context canceled while starting backfill: %w", ctx.Err())
+	case <-indexCtx.Done():
+		return fmt.Errorf("could not backfill contract %s: %w", address, ErrNotIndexing)
+	case c.backfills <- config.ContractConfig{Address: address.String(), StartBlock: startBlock, EndBlock: endBlock}:
+		return nil
+	}
+}
+
+// startIndexing records the context of an indexing run and gets the contracts to index in it. Contracts added
+// afterwards are sent to the run.
+func (c *ChainIndexer) startIndexing(indexCtx context.Context) config.ContractConfigs {
+	c.contractsMux.Lock()
+	defer c.contractsMux.Unlock()
+
+	c.indexCtx = indexCtx
+	c.livefillContracts = nil
+	return append(config.ContractConfigs{}, c.chainConfig.Contracts...)
+}
+
+// removeLivefillContract removes a contract from livefill.
+func (c *ChainIndexer) removeLivefillContract(address common.Address) {
+	c.livefillContracts = removeContractConfig(c.livefillContracts, address)
+}
+
+// removeContractConfig removes a contract from a list of contracts.
+func removeContractConfig(contracts []config.ContractConfig, address common.Address) []config.ContractConfig {
+	remaining := make([]config.ContractConfig, 0, len(contracts))
+	for _, contract := range contracts {
+		if common.HexToAddress(contract.Address) != address {
+			remaining = append(remaining, contract)
+		}
+	}
+	return remaining
+}
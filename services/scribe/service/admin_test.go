@@ -0,0 +1,250 @@
+package service_test
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/backends/geth"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+	"github.com/synapsecns/sanguine/services/scribe/testutil/testcontract"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+// TestAdminChanges tests that chains and contracts added or removed while scribe runs are saved to the config.
+func (s *ScribeSuite) TestAdminChanges() {
+	chainID := gofakeit.Uint32()
+	contractA := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	contractB := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	scribeConfig := config.Config{
+		Chains: config.ChainConfigs{{
+			ChainID:   chainID,
+			Contracts: config.ContractConfigs{{Address: contractA.String()}},
+		}},
+		RPCURL: gofakeit.URL(),
+	}
+	configFile := filet.TmpFile(s.T(), "", "")
+
+	scribe, err := service.NewScribe(s.testDB, map[uint32][]backend.ScribeBackend{chainID: {}}, scribeConfig, s.nullMetrics)
+	Nil(s.T(), err)
+	scribe.SetConfigPath(configFile.Name())
+	var dialedChains []uint32
+	scribe.SetClientDialer(func(ctx context.Context, chainID uint32) ([]backend.ScribeBackend, error) {
+		dialedChains = append(dialedChains, chainID)
+		return []backend.ScribeBackend{}, nil
+	})
+
+	savedConfig := func() config.Config {
+		decodedConfig, err := config.DecodeConfig(configFile.Name())
+		Nil(s.T(), err)
+		return decodedConfig
+	}
+	savedAddresses := func() (addresses []string) {
+		for _, contract := range savedConfig().Chains[0].Contracts {
+			addresses = append(addresses, contract.Address)
+		}
+		return addresses
+	}
+
+	// Contracts are added to their chain.
+	contractConfig := config.ContractConfig{Address: contractB.String(), StartBlock: 10}
+	Nil(s.T(), scribe.AddContract(s.GetTestContext(), chainID, contractConfig))
+	Equal(s.T(), []string{contractA.String(), contractB.String()}, savedAddresses())
+
+	ErrorIs(s.T(), scribe.AddContract(s.GetTestContext(), chainID, contractConfig), service.ErrContractExists)
+	ErrorIs(s.T(), scribe.AddContract(s.GetTestContext(), chainID+1, contractConfig), service.ErrChainNotFound)
+	ErrorIs(s.T(), scribe.AddContract(s.GetTestContext(), chainID, config.ContractConfig{Address: "0x1"}), service.ErrInvalidConfig)
+
+	storedProgress := func(chainID uint32) (addresses []common.Address) {
+		progress, err := s.testDB.RetrieveIndexerProgress(s.GetTestContext(), chainID)
+		Nil(s.T(), err)
+		for _, contractProgress := range progress {
+			addresses = append(addresses, contractProgress.ContractAddress)
+		}
+		return addresses
+	}
+	Nil(s.T(), s.testDB.StoreIndexerProgress(s.GetTestContext(),
+		db.IndexerProgress{ChainID: chainID, ContractAddress: contractA, Rates: []db.IndexingRate{}},
+		db.IndexerProgress{ChainID: chainID, ContractAddress: contractB, Rates: []db.IndexingRate{}},
+	))
+
+	// Contracts are removed from their chain, along with their progress.
+	Nil(s.T(), scribe.RemoveContract(s.GetTestContext(), chainID, contractA))
+	Equal(s.T(), []string{contractB.String()}, savedAddresses())
+	Equal(s.T(), []common.Address{contractB}, storedProgress(chainID))
+	ErrorIs(s.T(), scribe.RemoveContract(s.GetTestContext(), chainID, contractA), service.ErrContractNotFound)
+
+	// Backfills need a running chain and a valid range.
+	ErrorIs(s.T(), scribe.BackfillContract(s.GetTestContext(), chainID, contractB, 1, 10), service.ErrNotIndexing)
+	ErrorIs(s.T(), scribe.BackfillContract(s.GetTestContext(), chainID, contractB, 10, 1), service.ErrInvalidRange)
+	ErrorIs(s.T(), scribe.BackfillContract(s.GetTestContext(), chainID, contractA, 1, 10), service.ErrContractNotFound)
+
+	// Chains are dialed when added.
+	newChain := config.ChainConfig{
+		ChainID:   chainID + 1,
+		Contracts: config.ContractConfigs{{Address: contractA.String()}},
+	}
+	Nil(s.T(), scribe.AddChain(s.GetTestContext(), newChain))
+	Equal(s.T(), []uint32{newChain.ChainID}, dialedChains)
+	Equal(s.T(), 2, len(savedConfig().Chains))
+	ErrorIs(s.T(), scribe.AddChain(s.GetTestContext(), newChain), service.ErrChainExists)
+
+	Nil(s.T(), scribe.RemoveChain(s.GetTestContext(), chainID))
+	Equal(s.T(), []uint32{newChain.ChainID}, []uint32{savedConfig().Chains[0].ChainID})
+	Empty(s.T(), storedProgress(chainID))
+	ErrorIs(s.T(), scribe.RemoveChain(s.GetTestContext(), chainID), service.ErrChainNotFound)
+}
+
+// TestChainIndexerContractChanges tests that contracts added or removed while a chain is indexing are sent to it.
+func (s *ScribeSuite) TestChainIndexerContractChanges() {
+	contractA := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	chainConfig := config.ChainConfig{
+		ChainID:   gofakeit.Uint32(),
+		Contracts: config.ContractConfigs{{Address: contractA.String()}},
+	}
+	chainIndexer, err := service.NewChainIndexer(s.testDB, []backend.ScribeBackend{}, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+
+	indexCtx, cancelIndexing := context.WithCancel(s.GetTestContext())
+	Equal(s.T(), chainConfig.Contracts, chainIndexer.StartIndexing(indexCtx))
+
+	contractB := config.ContractConfig{Address: common.BigToAddress(big.NewInt(gofakeit.Int64())).String()}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- chainIndexer.AddContract(contractB)
+	}()
+	Equal(s.T(), contractB, <-chainIndexer.AddedContracts())
+	Nil(s.T(), <-errChan)
+
+	go func() {
+		errChan <- chainIndexer.RemoveContract(contractA)
+	}()
+	Equal(s.T(), contractA, <-chainIndexer.RemovedContracts())
+	Nil(s.T(), <-errChan)
+	Equal(s.T(), config.ContractConfigs{contractB}, chainIndexer.GetContracts())
+
+	// Once indexing stops, changes are picked up by the next indexing run.
+	cancelIndexing()
+	contractC := config.ContractConfig{Address: common.BigToAddress(big.NewInt(gofakeit.Int64())).String()}
+	Nil(s.T(), chainIndexer.AddContract(contractC))
+	Equal(s.T(), config.ContractConfigs{contractB, contractC}, chainIndexer.StartIndexing(s.GetTestContext()))
+}
+
+// TestAddContractDecoded tests that the logs of a contract added with an ABI are decoded.
+func (s *ScribeSuite) TestAddContractDecoded() {
+	chainConfig := config.ChainConfig{
+		ChainID:   gofakeit.Uint32(),
+		Contracts: config.ContractConfigs{{Address: common.BigToAddress(big.NewInt(gofakeit.Int64())).String()}},
+	}
+	chainIndexer, err := service.NewChainIndexer(s.testDB, []backend.ScribeBackend{}, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+
+	abiPath := filepath.Join(filet.TmpDir(s.T(), ""), "abi.json")
+	Nil(s.T(), os.WriteFile(abiPath, []byte(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Deposit","type":"event"}]`), 0600))
+
+	token := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	log := types.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Deposit(address,uint256)")),
+			common.BytesToHash(token.Bytes()),
+		},
+		Data: common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+	}
+	_, ok, err := chainIndexer.DecodeLog(log)
+	Nil(s.T(), err)
+	False(s.T(), ok)
+
+	Nil(s.T(), chainIndexer.AddContract(config.ContractConfig{Address: token.String(), ABI: abiPath}))
+	decodedLog, ok, err := chainIndexer.DecodeLog(log)
+	Nil(s.T(), err)
+	True(s.T(), ok)
+	Equal(s.T(), "Deposit", decodedLog.EventName)
+
+	// Contracts with ABIs that can't be loaded aren't added.
+	missingABI := config.ContractConfig{Address: common.BigToAddress(big.NewInt(gofakeit.Int64())).String(), ABI: abiPath + ".missing"}
+	ErrorIs(s.T(), chainIndexer.AddContract(missingABI), service.ErrInvalidConfig)
+	NotContains(s.T(), chainIndexer.GetContracts(), missingABI)
+}
+
+// TestAddedContractTopicsLivefill tests that the topic filter of a contract added while indexing is kept in livefill.
+func (s *ScribeSuite) TestAddedContractTopicsLivefill() {
+	chainID := gofakeit.Uint32()
+	simulatedChain := geth.NewEmbeddedBackendForChainID(s.GetTestContext(), s.T(), big.NewInt(int64(chainID)))
+	simulatedClient, err := backend.DialBackend(s.GetTestContext(), simulatedChain.RPCAddress(), s.nullMetrics)
+	Nil(s.T(), err)
+
+	testContract, testRef := s.manager.GetTestContract(s.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(s.GetTestContext(), nil)
+
+	// The livefill indexer is created before the contract is added.
+	chainConfig := config.ChainConfig{
+		ChainID:            chainID,
+		Confirmations:      0,
+		GetLogsBatchAmount: 1,
+		StoreConcurrency:   1,
+		GetLogsRange:       1,
+		LivefillRange:      1,
+		Contracts:          config.ContractConfigs{{Address: common.BigToAddress(big.NewInt(gofakeit.Int64())).String()}},
+	}
+	chainIndexer, err := service.NewChainIndexer(s.testDB, []backend.ScribeBackend{simulatedClient}, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+
+	indexCtx, cancel := context.WithCancel(s.GetTestContext())
+	defer cancel()
+	go func() {
+		_ = chainIndexer.Index(indexCtx)
+	}()
+	s.Eventually(func() bool {
+		return len(chainIndexer.GetLivefillContracts()) == 1
+	})
+
+	head, err := simulatedClient.BlockNumber(s.GetTestContext())
+	Nil(s.T(), err)
+
+	// Only index EventB.
+	Nil(s.T(), chainIndexer.AddContract(config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: head,
+		Topics:     [][]string{{"EventB(address,bytes,uint256,uint256)"}},
+	}))
+	s.Eventually(func() bool {
+		for _, contract := range chainIndexer.GetLivefillContracts() {
+			if common.HexToAddress(contract.Address) == testContract.Address() {
+				return true
+			}
+		}
+		return false
+	})
+
+	// The transaction emits both EventA and EventB.
+	tx, err := testRef.EmitEventAandB(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(s.T(), err)
+	simulatedChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(s.GetTestContext(), simulatedChain, tx)
+	Nil(s.T(), err)
+
+	s.Eventually(func() bool {
+		lastIndexed, err := s.testDB.RetrieveLastIndexed(s.GetTestContext(), testContract.Address(), chainID, scribeTypes.IndexingConfirmed)
+		return err == nil && lastIndexed >= txBlockNumber
+	})
+
+	testABI, err := testcontract.TestContractMetaData.GetAbi()
+	Nil(s.T(), err)
+	logs, err := s.testDB.RetrieveLogsWithFilter(s.GetTestContext(), db.LogFilter{ChainID: chainID, TxHash: tx.Hash().String()}, 1)
+	Nil(s.T(), err)
+	Equal(s.T(), 1, len(logs))
+	Equal(s.T(), testABI.Events["EventB"].ID, logs[0].Topics[0])
+}
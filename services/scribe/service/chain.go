@@ -2,14 +2,17 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/synapsecns/sanguine/services/scribe/backend"
 	"github.com/synapsecns/sanguine/services/scribe/logger"
 	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
+	"github.com/synapsecns/sanguine/services/scribe/service/progress"
 	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
 	"math/big"
 
 	"math"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +23,7 @@
 	"github.com/jpillora/backoff"
 	"github.com/synapsecns/sanguine/services/scribe/config"
 	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/decoder"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -42,6 +46,30 @@ type ChainIndexer struct {
 	livefillContracts []config.ContractConfig
 	// readyForLivefill is a chan
 	readyForLivefill chan config.ContractConfig
+	// factories is a map from address -> factory whose children are indexed automatically.
+	factories map[common.Address]factory
+	// addedContracts is a chan of contracts added while indexing, either discovered from factories or added by an
+	// admin, that need an indexer.
+	addedContracts chan config.ContractConfig
+	// removedContracts is a chan of contracts removed while indexing that need to be removed from livefill.
+	removedContracts chan common.Address
+	// backfills is a chan of contract block ranges to index without changing the contract's last indexed block.
+	backfills chan config.ContractConfig
+	// contractCancels is a map from address -> cancel func of the indexer bringing the contract to livefill.
+	contractCancels map[common.Address]context.CancelFunc
+	// indexCtx is the context of the current indexing run, nil if the chain has not started indexing.
+	indexCtx context.Context
+	// contractsMux protects the chain config's contracts, contractCancels and indexCtx, which change as contracts are added
+	// and removed while indexing.
+	contractsMux sync.RWMutex
+	// metersMux protects blockHeightMeters.
+	metersMux sync.Mutex
+	// decoder decodes logs with their contract's ABI. Contracts added with an ABI are added to it.
+	decoder *decoder.Decoder
+	// progressTracker tracks the indexing progress of the chain's contracts, nil if progress is not tracked.
+	progressTracker *progress.Tracker
+	// reorgGuard keeps indexers that started before a reorg was handled from storing last indexed blocks past it.
+	reorgGuard *indexer.ReorgGuard
 }
 
 // Used for handling logging of various context types.
@@ -80,6 +108,40 @@ func NewChainIndexer(eventDB db.EventDB, client []backend.ScribeBackend, chainCo
 		chainConfig.LivefillFlushInterval = 10800
 	}
 
+	if chainConfig.ReorgCheckInterval == 0 {
+		chainConfig.ReorgCheckInterval = 60
+	}
+
+	// Factories are indexed like any other contract so their creation events can be seen.
+	factories := make(map[common.Address]factory)
+	for _, factoryConfig := range chainConfig.Factories {
+		factory, err := newFactory(factoryConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not create factory %s: %w", factoryConfig.Address, err)
+		}
+		factoryAddress := common.HexToAddress(factoryConfig.Address)
+		factories[factoryAddress] = factory
+
+		isContract := false
+		for _, contract := range chainConfig.Contracts {
+			if common.HexToAddress(contract.Address) == factoryAddress {
+				isContract = true
+				break
+			}
+		}
+		if !isContract {
+			chainConfig.Contracts = append(chainConfig.Contracts, config.ContractConfig{
+				Address:    factoryAddress.String(),
+				StartBlock: factoryConfig.StartBlock,
+			})
+		}
+	}
+
+	logDecoder, err := decoder.NewDecoder(chainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create decoder: %w", err)
+	}
+
 	blockHeightMeterMap := make(map[common.Address]metric.Int64Histogram)
 	for _, contract := range chainConfig.Contracts {
 		blockHeightMeter, err := handler.Metrics().NewHistogram(fmt.Sprintf("scribe_block_meter_%d_%s", chainConfig.ChainID, contract.Address), "block_histogram", "a block height meter", "blocks")
@@ -97,9 +159,21 @@ func NewChainIndexer(eventDB db.EventDB, client []backend.ScribeBackend, chainCo
 		chainConfig:       chainConfig,
 		handler:           handler,
 		readyForLivefill:  make(chan config.ContractConfig),
+		factories:         factories,
+		addedContracts:    make(chan config.ContractConfig),
+		removedContracts:  make(chan common.Address),
+		backfills:         make(chan config.ContractConfig),
+		contractCancels:   make(map[common.Address]context.CancelFunc),
+		decoder:           logDecoder,
+		reorgGuard:        indexer.NewReorgGuard(),
 	}, nil
 }
 
+// SetProgressTracker sets the tracker the indexing progress of the chain's contracts is reported to.
+func (c *ChainIndexer) SetProgressTracker(tracker *progress.Tracker) {
+	c.progressTracker = tracker
+}
+
 // Index iterates over each contract indexer and calls Index concurrently on each one.
 // If `onlyOneBlock` is true, the indexer will only index the block at `currentBlock`.
 //
@@ -112,54 +186,45 @@ func (c *ChainIndexer) Index(parentContext context.Context) error {
 		return fmt.Errorf("could not get current block number while indexing: %w", err)
 	}
 
-	var contractAddresses []common.Address
-	for i := range c.chainConfig.Contracts {
-		contractAddresses = append(contractAddresses, common.HexToAddress(c.chainConfig.Contracts[i].Address))
+	err = c.loadFactoryChildren(parentContext)
+	if err != nil {
+		return fmt.Errorf("could not load factory children: %w", err)
 	}
+	contracts := c.startIndexing(indexCtx)
 
 	// Gets all last indexed infos for the contracts on the current chain to determine which contracts need to be initially livefilled.
-	lastIndexedMap, err := c.eventDB.RetrieveLastIndexedMultiple(parentContext, contractAddresses, c.chainConfig.ChainID)
+	lastIndexedMap, err := c.eventDB.RetrieveLastIndexedMultiple(parentContext, getAddressesFromConfig(contracts), c.chainConfig.ChainID)
 	if err != nil {
 		return fmt.Errorf("could not get last indexed map: %w", err)
 	}
 
-	for j := range c.chainConfig.Contracts {
-		contract := c.chainConfig.Contracts[j]
-		contractAddress := common.HexToAddress(contract.Address)
-		lastIndexed := lastIndexedMap[contractAddress]
+	for j := range contracts {
+		contract := contracts[j]
+		lastIndexed := lastIndexedMap[common.HexToAddress(contract.Address)]
 
 		// Does not consider if the config's start block is within the livefill threshold for simplicity.
 		// In this case, an indexer will bring the contract to head, and it will be passed to livefill.
 		// If there is no last indexed info for the contract, it will not be passed to livefill.
 		if *latestBlock-c.chainConfig.LivefillThreshold > lastIndexed && lastIndexed > 0 {
 			c.livefillContracts = append(c.livefillContracts, contract)
+			c.progressTracker.SetMode(c.chainID, common.HexToAddress(contract.Address), db.LivefillMode, 0)
+			c.progressTracker.RecordBlock(c.chainID, []common.Address{common.HexToAddress(contract.Address)}, lastIndexed)
 			continue
 		}
 
 		// If current contract is not within the livefill threshold, start an indexer for it.
-		contractIndexer, err := indexer.NewIndexer(c.chainConfig, []common.Address{contractAddress}, c.eventDB, c.client, c.handler, c.blockHeightMeters[contractAddress], scribeTypes.IndexingConfirmed)
+		err = c.startContractIndexer(indexCtx, indexGroup, contract)
 		if err != nil {
-			return fmt.Errorf("could not create contract indexer: %w", err)
-		}
-
-		// Check if a explicit backfill range has been set.
-		var configEnd *uint64
-		if contract.EndBlock > contract.StartBlock {
-			configEnd = &contract.EndBlock
+			return err
 		}
-
-		indexGroup.Go(func() error {
-			err := c.IndexToBlock(indexCtx, contract.StartBlock, configEnd, contractIndexer)
-			if err != nil {
-				return fmt.Errorf("could not index to livefill: %w", err)
-			}
-			c.readyForLivefill <- contract
-
-			// TODO make sure metrics are killed when indexing is done
-			return nil
-		})
+		c.progressTracker.RecordBlock(c.chainID, []common.Address{common.HexToAddress(contract.Address)}, lastIndexed)
 	}
 
+	// Start indexers for contracts added while indexing.
+	indexGroup.Go(func() error {
+		return c.indexAddedContracts(indexCtx, indexGroup)
+	})
+
 	// Livefill contracts that are within the livefill threshold and before the confirmation threshold.
 	indexGroup.Go(func() error {
 		return c.livefill(indexCtx)
@@ -199,6 +264,7 @@ func (c *ChainIndexer) getLatestBlock(ctx context.Context, indexingUnconfirmed b
 			}
 			if !indexingUnconfirmed {
 				currentBlock -= c.chainConfig.Confirmations
+				c.progressTracker.RecordHead(c.chainID, currentBlock)
 			}
 		}
 
@@ -206,37 +272,181 @@ func (c *ChainIndexer) getLatestBlock(ctx context.Context, indexingUnconfirmed b
 	}
 }
 
+// startContractIndexer starts an indexer that brings a single contract to the livefill threshold, after which it is
+// passed to the livefill indexer.
+func (c *ChainIndexer) startContractIndexer(ctx context.Context, indexGroup *errgroup.Group, contract config.ContractConfig) error {
+	contractAddress := common.HexToAddress(contract.Address)
+	blockHeightMeter, err := c.getBlockHeightMeter(contractAddress)
+	if err != nil {
+		return err
+	}
+
+	contractIndexer, err := indexer.NewIndexer(c.getChainConfig(), []common.Address{contractAddress}, c.eventDB, c.client, c.handler, blockHeightMeter, scribeTypes.IndexingConfirmed)
+	if err != nil {
+		return fmt.Errorf("could not create contract indexer: %w", err)
+	}
+	c.configureIndexer(contractIndexer)
+
+	// Check if a explicit backfill range has been set.
+	var configEnd *uint64
+	if contract.EndBlock > contract.StartBlock {
+		configEnd = &contract.EndBlock
+	}
+	var targetBlock uint64
+	if configEnd != nil {
+		targetBlock = *configEnd
+	}
+	c.progressTracker.SetMode(c.chainID, contractAddress, db.BackfillMode, targetBlock)
+	contractIndexer.SetProgressHandler(c.recordProgress)
+
+	// The indexer is canceled on its own if the contract is removed.
+	contractCtx, cancelContract := context.WithCancel(ctx)
+	c.contractsMux.Lock()
+	c.contractCancels[contractAddress] = cancelContract
+	c.contractsMux.Unlock()
+
+	indexGroup.Go(func() error {
+		defer cancelContract()
+
+		err := c.IndexToBlock(contractCtx, contract.StartBlock, configEnd, contractIndexer)
+		if err != nil {
+			if ctx.Err() == nil {
+				return nil
+			}
+			return fmt.Errorf("could not index to livefill: %w", err)
+		}
+		// Explicit ranges don't store a last indexed block, so their progress is only known once they complete.
+		if configEnd != nil {
+			c.progressTracker.RecordBlock(c.chainID, []common.Address{contractAddress}, *configEnd)
+		}
+
+		select {
+		case <-contractCtx.Done():
+			return nil
+		case c.readyForLivefill <- contract:
+		}
+
+		// TODO make sure metrics are killed when indexing is done
+		return nil
+	})
+
+	return nil
+}
+
+// startBackfill starts an indexer that indexes a contract's block range without changing its last indexed block.
+func (c *ChainIndexer) startBackfill(ctx context.Context, indexGroup *errgroup.Group, contract config.ContractConfig) error {
+	contractAddress := common.HexToAddress(contract.Address)
+	blockHeightMeter, err := c.getBlockHeightMeter(contractAddress)
+	if err != nil {
+		return err
+	}
+
+	backfillIndexer, err := indexer.NewIndexer(c.getChainConfig(), []common.Address{contractAddress}, c.eventDB, c.client, c.handler, blockHeightMeter, scribeTypes.IndexingConfirmed)
+	if err != nil {
+		return fmt.Errorf("could not create backfill indexer: %w", err)
+	}
+	c.configureIndexer(backfillIndexer)
+
+	indexGroup.Go(func() error {
+		err := c.IndexToBlock(ctx, contract.StartBlock, &contract.EndBlock, backfillIndexer)
+		if err != nil {
+			return fmt.Errorf("could not backfill: %w", err)
+		}
+		return nil
+	})
+
+	return nil
+}
+
+// indexAddedContracts starts an indexer for each contract added while indexing, and for each requested backfill.
+func (c *ChainIndexer) indexAddedContracts(ctx context.Context, indexGroup *errgroup.Group) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s chain context canceled: %w", ctx.Value(chainContextKey), ctx.Err())
+		case contract := <-c.addedContracts:
+			err := c.startContractIndexer(ctx, indexGroup, contract)
+			if err != nil {
+				return err
+			}
+		case contract := <-c.backfills:
+			err := c.startBackfill(ctx, indexGroup, contract)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// configureIndexer registers factory creation events from an indexer's logs when factories are configured, and
+// decodes its logs when ABIs are configured.
+func (c *ChainIndexer) configureIndexer(contractIndexer *indexer.Indexer) {
+	contractIndexer.SetReorgGuard(c.reorgGuard)
+	if len(c.factories) > 0 {
+		contractIndexer.SetLogHandler(c.handleFactoryLog)
+	}
+	contractIndexer.SetDecoder(c.decoder)
+}
+
+// recordProgress reports the block an indexer's contracts have been indexed to.
+func (c *ChainIndexer) recordProgress(addresses []common.Address, blockNumber uint64) {
+	c.progressTracker.RecordBlock(c.chainID, addresses, blockNumber)
+}
+
+// getBlockHeightMeter gets the block height meter for a contract, creating it if it doesn't exist.
+func (c *ChainIndexer) getBlockHeightMeter(contractAddress common.Address) (metric.Int64Histogram, error) {
+	c.metersMux.Lock()
+	defer c.metersMux.Unlock()
+
+	if blockHeightMeter, ok := c.blockHeightMeters[contractAddress]; ok {
+		return blockHeightMeter, nil
+	}
+	blockHeightMeter, err := c.handler.Metrics().NewHistogram(fmt.Sprintf("scribe_block_meter_%d_%s", c.chainConfig.ChainID, contractAddress), "block_histogram", "a block height meter", "blocks")
+	if err != nil {
+		return nil, fmt.Errorf("error creating otel histogram %w", err)
+	}
+	c.blockHeightMeters[contractAddress] = blockHeightMeter
+	return blockHeightMeter, nil
+}
+
 // IndexToBlock takes a contract indexer and indexes a contract up until it reaches the livefill threshold. This function should be generally used for calling a indexer with a single contract.
-func (c *ChainIndexer) IndexToBlock(parentContext context.Context, configStart uint64, configEnd *uint64, indexer *indexer.Indexer) error {
+func (c *ChainIndexer) IndexToBlock(parentContext context.Context, configStart uint64, configEnd *uint64, contractIndexer *indexer.Indexer) error {
 	timeout := time.Duration(0)
 	b := createBackoff()
 	for {
 		select {
 		case <-parentContext.Done():
-			logger.ReportIndexerError(fmt.Errorf("context canceled in index to block"), indexer.GetIndexerConfig(), logger.BackfillIndexerError)
+			logger.ReportIndexerError(fmt.Errorf("context canceled in index to block"), contractIndexer.GetIndexerConfig(), logger.BackfillIndexerError)
 			return fmt.Errorf("%s chain context canceled: %w", parentContext.Value(chainContextKey), parentContext.Err())
 		case <-time.After(timeout):
-			indexerConfig := indexer.GetIndexerConfig()
+			indexerConfig := contractIndexer.GetIndexerConfig()
 
 			logger.ReportScribeState(indexerConfig.ChainID, 0, indexerConfig.Addresses, logger.BeginBackfillIndexing)
 
 			var endHeight uint64
 			var err error
-			startHeight, endHeight, err := c.getIndexingRange(parentContext, configStart, configEnd, indexer)
+			startHeight, endHeight, err := c.getIndexingRange(parentContext, configStart, configEnd, contractIndexer)
 			if err != nil {
 				timeout = b.Duration()
-				logger.ReportIndexerError(err, indexer.GetIndexerConfig(), logger.BackfillIndexerError)
+				logger.ReportIndexerError(err, contractIndexer.GetIndexerConfig(), logger.BackfillIndexerError)
+				c.progressTracker.RecordError(indexerConfig.ChainID, indexerConfig.Addresses, err)
 				continue
 			}
 
-			err = indexer.Index(parentContext, startHeight, endHeight)
+			err = contractIndexer.Index(parentContext, startHeight, endHeight)
+			// A reorg was handled while indexing, so index again from the rewound last indexed block.
+			if errors.Is(err, indexer.ErrRewound) {
+				timeout = 0
+				continue
+			}
 			if err != nil {
 				timeout = b.Duration()
 				// if the config has set the contract to refresh at a slower rate than the timeout, use the refresh rate instead.
-				if indexer.RefreshRate() > maxBackoff {
-					timeout = time.Duration(indexer.RefreshRate()) * time.Second
+				if contractIndexer.RefreshRate() > maxBackoff {
+					timeout = time.Duration(contractIndexer.RefreshRate()) * time.Second
 				}
-				logger.ReportIndexerError(fmt.Errorf("error indexing, timeout %v, %w", timeout.Seconds(), err), indexer.GetIndexerConfig(), logger.BackfillIndexerError)
+				logger.ReportIndexerError(fmt.Errorf("error indexing, timeout %v, %w", timeout.Seconds(), err), contractIndexer.GetIndexerConfig(), logger.BackfillIndexerError)
+				c.progressTracker.RecordError(indexerConfig.ChainID, indexerConfig.Addresses, err)
 				continue
 			}
 			if configEnd != nil {
@@ -244,16 +454,16 @@ func (c *ChainIndexer) IndexToBlock(parentContext context.Context, configStart u
 				return nil
 			}
 
-			livefillReady, err := c.isReadyForLivefill(parentContext, indexer)
+			livefillReady, err := c.isReadyForLivefill(parentContext, contractIndexer)
 			if err != nil {
-				logger.ReportIndexerError(fmt.Errorf("could not get last indexed: %w", err), indexer.GetIndexerConfig(), logger.BackfillIndexerError)
+				logger.ReportIndexerError(fmt.Errorf("could not get last indexed: %w", err), contractIndexer.GetIndexerConfig(), logger.BackfillIndexerError)
 				continue
 			}
 			if livefillReady {
 				return nil
 			}
 
-			timeout = time.Duration(indexer.RefreshRate()) * time.Second
+			timeout = time.Duration(contractIndexer.RefreshRate()) * time.Second
 		}
 	}
 }
@@ -341,13 +551,13 @@ func (c *ChainIndexer) getIndexingRange(parentContext context.Context, configSta
 func (c *ChainIndexer) livefillAtHead(parentContext context.Context) error {
 	timeout := time.Duration(0)
 	b := createBackoff()
-	addresses := getAddressesFromConfig(c.chainConfig.Contracts)
+	addresses := getAddressesFromConfig(c.getChainConfig().Contracts)
 	tipLivefillBlockMeter, err := c.handler.Metrics().NewHistogram(fmt.Sprintf("scribe_block_meter_%d_tip_livefill", c.chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
 	if err != nil {
 		return fmt.Errorf("error creating otel histogram %w", err)
 	}
 
-	tipLivefillIndexer, err := indexer.NewIndexer(c.chainConfig, addresses, c.eventDB, c.client, c.handler, tipLivefillBlockMeter, true)
+	tipLivefillIndexer, err := indexer.NewIndexer(c.getChainConfig(), addresses, c.eventDB, c.client, c.handler, tipLivefillBlockMeter, true)
 	if err != nil {
 		return fmt.Errorf("could not create contract indexer: %w", err)
 	}
@@ -365,6 +575,14 @@ func (c *ChainIndexer) livefillAtHead(parentContext context.Context) error {
 				return fmt.Errorf("could not flush logs from head: %w", err)
 			}
 		case <-time.After(timeout):
+			// Include contracts discovered from factories.
+			contracts := c.getChainConfig().Contracts
+			addresses = getAddressesFromConfig(contracts)
+			if err := tipLivefillIndexer.UpdateContracts(contracts); err != nil {
+				logger.ReportIndexerError(err, tipLivefillIndexer.GetIndexerConfig(), logger.LivefillIndexerError)
+				timeout = b.Duration()
+				continue
+			}
 
 			endHeight, err := c.getLatestBlock(parentContext, scribeTypes.LivefillAtHead)
 			if err != nil {
@@ -413,20 +631,46 @@ func (c *ChainIndexer) livefill(parentContext context.Context) error {
 		return fmt.Errorf("error creating otel histogram %w", err)
 	}
 
-	livefillIndexer, err := indexer.NewIndexer(c.chainConfig, getAddressesFromConfig(c.livefillContracts), c.eventDB, c.client, c.handler, livefillBlockMeter, scribeTypes.IndexingConfirmed)
+	livefillIndexer, err := indexer.NewIndexer(c.getChainConfig(), getAddressesFromConfig(c.livefillContracts), c.eventDB, c.client, c.handler, livefillBlockMeter, scribeTypes.IndexingConfirmed)
 	if err != nil {
 		return fmt.Errorf("could not create contract indexer: %w", err)
 	}
+	c.configureIndexer(livefillIndexer)
+	livefillIndexer.SetProgressHandler(c.recordProgress)
+	var lastReorgCheck time.Time
 	for {
 		select {
 		case <-parentContext.Done():
 			logger.ReportScribeError(parentContext.Err(), c.chainID, logger.ContextCancelled)
 			return fmt.Errorf("%s chain context canceled: %w", parentContext.Value(chainContextKey), parentContext.Err())
 		case newLivefillContract := <-c.readyForLivefill:
+			// The contract may have been removed while it was being brought to livefill.
+			if !c.hasContract(common.HexToAddress(newLivefillContract.Address)) {
+				continue
+			}
 			c.livefillContracts = append(c.livefillContracts, newLivefillContract)
+			c.progressTracker.SetMode(c.chainID, common.HexToAddress(newLivefillContract.Address), db.LivefillMode, 0)
 			// Update indexer's config to include new contract.
-			livefillIndexer.UpdateAddress(getAddressesFromConfig(c.livefillContracts))
+			if err := livefillIndexer.UpdateContracts(c.livefillContracts); err != nil {
+				logger.ReportIndexerError(err, livefillIndexer.GetIndexerConfig(), logger.LivefillIndexerError)
+			}
+		case removedContract := <-c.removedContracts:
+			c.removeLivefillContract(removedContract)
+			if err := livefillIndexer.UpdateContracts(c.livefillContracts); err != nil {
+				logger.ReportIndexerError(err, livefillIndexer.GetIndexerConfig(), logger.LivefillIndexerError)
+			}
 		case <-time.After(timeout):
+			// Reorg checks run between livefill iterations so rewinding the last indexed blocks can't race with livefill.
+			// Contract indexers that are still bringing their contract to livefill are kept from storing last
+			// indexed blocks past the rewind by the reorg guard.
+			if c.chainConfig.ReorgCheckDepth > 0 && time.Since(lastReorgCheck) >= time.Duration(c.chainConfig.ReorgCheckInterval)*time.Second {
+				err = c.checkReorgs(parentContext)
+				if err != nil {
+					logger.ReportScribeError(err, c.chainID, logger.ReorgCheckError)
+				}
+				lastReorgCheck = time.Now()
+			}
+
 			if len(c.livefillContracts) == 0 {
 				timeout = b.Duration()
 				continue
@@ -465,6 +709,7 @@ func (c *ChainIndexer) livefill(parentContext context.Context) error {
 			if err != nil {
 				timeout = b.Duration()
 				logger.ReportIndexerError(err, livefillIndexer.GetIndexerConfig(), logger.LivefillIndexerError)
+				c.progressTracker.RecordError(c.chainID, livefillIndexer.GetIndexerConfig().Addresses, err)
 				continue
 			}
 
@@ -1,10 +1,56 @@
 package service
 
 import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
 )
 
 // GetLivefillContracts returns the array of livefill contracts for testing.
 func (c *ChainIndexer) GetLivefillContracts() []config.ContractConfig {
 	return c.livefillContracts
 }
+
+// CheckReorgs exports checkReorgs for testing.
+func (c *ChainIndexer) CheckReorgs(ctx context.Context) error {
+	return c.checkReorgs(ctx)
+}
+
+// HandleFactoryLog exports handleFactoryLog for testing.
+func (c *ChainIndexer) HandleFactoryLog(ctx context.Context, log types.Log) error {
+	return c.handleFactoryLog(ctx, log)
+}
+
+// LoadFactoryChildren exports loadFactoryChildren for testing.
+func (c *ChainIndexer) LoadFactoryChildren(ctx context.Context) error {
+	return c.loadFactoryChildren(ctx)
+}
+
+// AddedContracts returns the chan of contracts added while indexing for testing.
+func (c *ChainIndexer) AddedContracts() <-chan config.ContractConfig {
+	return c.addedContracts
+}
+
+// GetContracts returns the indexed contracts, including those discovered from factories, for testing.
+func (c *ChainIndexer) GetContracts() config.ContractConfigs {
+	return c.getChainConfig().Contracts
+}
+
+// RemovedContracts returns the chan of contracts removed while indexing for testing.
+func (c *ChainIndexer) RemovedContracts() <-chan common.Address {
+	return c.removedContracts
+}
+
+// StartIndexing exports startIndexing for testing.
+func (c *ChainIndexer) StartIndexing(indexCtx context.Context) config.ContractConfigs {
+	return c.startIndexing(indexCtx)
+}
+
+// DecodeLog decodes a log with the chain's decoder for testing.
+func (c *ChainIndexer) DecodeLog(log types.Log) (db.DecodedLog, bool, error) {
+	//nolint: wrapcheck
+	return c.decoder.Decode(log)
+}
@@ -0,0 +1,3 @@
+// Package exporter exports indexed logs, receipts, transactions and block times into partitioned Parquet or CSV
+// files that can be loaded into an analytics database.
+package exporter
@@ -0,0 +1,449 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/base"
+)
+
+// DefaultPartitionSize is the number of blocks exported to each partition if the config doesn't set it.
+const DefaultPartitionSize = 10000
+
+// The tables that are exported.
+const (
+	logsTable         = "logs"
+	receiptsTable     = "receipts"
+	transactionsTable = "transactions"
+	blockTimesTable   = "block_times"
+)
+
+// tables are the exported tables. A partition is complete once a file has been written for each.
+var tables = []string{logsTable, receiptsTable, transactionsTable, blockTimesTable}
+
+// Config is the config of an export.
+type Config struct {
+	// ChainID is the chain to export.
+	ChainID uint32
+	// ContractAddress optionally restricts the export to the logs of a contract, along with the receipts and
+	// transactions of their transactions and the times of their blocks.
+	ContractAddress *common.Address
+	// Contracts are the contracts indexed on the chain, which chain exports wait for. Partitions are only exported
+	// once every contract (or the exported contract, if one is set) has been indexed past them.
+	Contracts []common.Address
+	// StartBlock and EndBlock are the (inclusive) block range to export.
+	StartBlock uint64
+	EndBlock   uint64
+	// PartitionSize is the number of blocks exported to each partition. Partitions are aligned to multiples of it.
+	PartitionSize uint64
+	// Format is the format of the exported files.
+	Format Format
+	// SkipHexFields leaves the hex encoded payload columns (log data, logs bloom and transaction input) empty.
+	SkipHexFields bool
+	// OutputDir is the directory the tables are exported to.
+	OutputDir string
+}
+
+// Report counts what an export wrote.
+type Report struct {
+	// Partitions is the number of partitions exported.
+	Partitions int
+	// Skipped is the number of partitions skipped because a previous export already completed them.
+	Skipped int
+	// Pending is the number of partitions not exported yet because they haven't been indexed.
+	Pending      int
+	Logs         int
+	Receipts     int
+	Transactions int
+	BlockTimes   int
+}
+
+// Exporter exports the data indexed for a chain into partitioned files. Each table of a partition is written to a
+// temporary file that is renamed once complete, so an interrupted export resumes from the first incomplete partition.
+type Exporter struct {
+	eventDB db.EventDB
+	cfg     Config
+}
+
+// NewExporter creates an exporter.
+func NewExporter(eventDB db.EventDB, cfg Config) (*Exporter, error) {
+	if cfg.StartBlock > cfg.EndBlock {
+		return nil, fmt.Errorf("start block %d is after end block %d", cfg.StartBlock, cfg.EndBlock)
+	}
+	if cfg.Format != Parquet && cfg.Format != CSV {
+		return nil, fmt.Errorf("unsupported format %q", cfg.Format)
+	}
+	if cfg.OutputDir == "" {
+		return nil, fmt.Errorf("no output directory set")
+	}
+	if cfg.PartitionSize == 0 {
+		cfg.PartitionSize = DefaultPartitionSize
+	}
+	if cfg.ContractAddress != nil {
+		cfg.Contracts = []common.Address{*cfg.ContractAddress}
+	}
+	if len(cfg.Contracts) == 0 {
+		return nil, fmt.Errorf("no contracts set to wait for")
+	}
+
+	return &Exporter{
+		eventDB: eventDB,
+		cfg:     cfg,
+	}, nil
+}
+
+// Export exports every partition of the block range that isn't already complete. Partitions that haven't been
+// indexed yet are left for a later export, so a complete partition is never missing data.
+func (e *Exporter) Export(ctx context.Context) (*Report, error) {
+	lastIndexed, err := e.lastIndexed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for startBlock := e.cfg.StartBlock; startBlock <= e.cfg.EndBlock; {
+		endBlock := (startBlock/e.cfg.PartitionSize+1)*e.cfg.PartitionSize - 1
+		if endBlock > e.cfg.EndBlock {
+			endBlock = e.cfg.EndBlock
+		}
+
+		if endBlock > lastIndexed {
+			report.Pending++
+		} else if e.isComplete(startBlock, endBlock) {
+			report.Skipped++
+		} else {
+			err := e.exportPartition(ctx, startBlock, endBlock, report)
+			if err != nil {
+				return report, fmt.Errorf("could not export blocks %d to %d: %w", startBlock, endBlock, err)
+			}
+			report.Partitions++
+		}
+
+		if endBlock == e.cfg.EndBlock {
+			break
+		}
+		startBlock = endBlock + 1
+	}
+
+	return report, nil
+}
+
+// lastIndexed gets the last block every contract the export waits for has been indexed to.
+func (e *Exporter) lastIndexed(ctx context.Context) (uint64, error) {
+	lastIndexed, err := e.eventDB.RetrieveLastIndexedMultiple(ctx, e.cfg.Contracts, e.cfg.ChainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve last indexed blocks: %w", err)
+	}
+
+	minLastIndexed := uint64(math.MaxUint64)
+	for _, blockNumber := range lastIndexed {
+		if blockNumber < minLastIndexed {
+			minLastIndexed = blockNumber
+		}
+	}
+	return minLastIndexed, nil
+}
+
+// PartitionPath gets the path of a table partition. Partitions are laid out as
+// <output dir>/<table>/chain_id=<chain id>[/contract_address=<address>]/<start block>-<end block>.<format>.
+func (e *Exporter) PartitionPath(table string, startBlock, endBlock uint64) string {
+	dir := filepath.Join(e.cfg.OutputDir, table, fmt.Sprintf("chain_id=%d", e.cfg.ChainID))
+	if e.cfg.ContractAddress != nil {
+		dir = filepath.Join(dir, fmt.Sprintf("contract_address=%s", e.cfg.ContractAddress.String()))
+	}
+	return filepath.Join(dir, fmt.Sprintf("%012d-%012d.%s", startBlock, endBlock, e.cfg.Format))
+}
+
+// isComplete checks if every table of a partition has been exported.
+func (e *Exporter) isComplete(startBlock, endBlock uint64) bool {
+	for _, table := range tables {
+		if _, err := os.Stat(e.PartitionPath(table, startBlock, endBlock)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// exportPartition exports every table of a partition.
+func (e *Exporter) exportPartition(ctx context.Context, startBlock, endBlock uint64, report *Report) error {
+	logs, err := e.retrieveLogs(ctx, startBlock, endBlock)
+	if err != nil {
+		return err
+	}
+	receipts, txs, err := e.retrieveTxs(ctx, startBlock, endBlock, logs)
+	if err != nil {
+		return err
+	}
+	blockTimes, err := e.retrieveBlockTimes(ctx, startBlock, endBlock, logs)
+	if err != nil {
+		return err
+	}
+
+	logRows := make([]interface{}, len(logs))
+	for i, log := range logs {
+		logRows[i] = e.logRow(log)
+	}
+	receiptRows := make([]interface{}, len(receipts))
+	for i := range receipts {
+		receiptRows[i] = e.receiptRow(&receipts[i])
+	}
+	txRows := make([]interface{}, len(txs))
+	for i := range txs {
+		txRows[i] = e.transactionRow(&txs[i])
+	}
+	blockTimeRows := make([]interface{}, len(blockTimes))
+	for i, blockTime := range blockTimes {
+		blockTimeRows[i] = blockTime
+	}
+
+	partitions := []struct {
+		table    string
+		template interface{}
+		rows     []interface{}
+	}{
+		{logsTable, new(LogRow), logRows},
+		{receiptsTable, new(ReceiptRow), receiptRows},
+		{transactionsTable, new(TransactionRow), txRows},
+		{blockTimesTable, new(BlockTimeRow), blockTimeRows},
+	}
+	for _, partition := range partitions {
+		err = e.writePartition(partition.table, startBlock, endBlock, partition.template, partition.rows)
+		if err != nil {
+			return err
+		}
+	}
+
+	report.Logs += len(logRows)
+	report.Receipts += len(receiptRows)
+	report.Transactions += len(txRows)
+	report.BlockTimes += len(blockTimeRows)
+	return nil
+}
+
+// writePartition writes a table partition to a temporary file, and renames it once it is complete.
+func (e *Exporter) writePartition(table string, startBlock, endBlock uint64, template interface{}, rows []interface{}) error {
+	path := e.PartitionPath(table, startBlock, endBlock)
+	err := os.MkdirAll(filepath.Dir(path), 0750)
+	if err != nil {
+		return fmt.Errorf("could not create %s directory: %w", table, err)
+	}
+
+	tmpPath := path + ".tmp"
+	tableWriter, err := newTableWriter(e.cfg.Format, tmpPath, template)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		err = tableWriter.Write(row)
+		if err != nil {
+			_ = tableWriter.Close()
+			return fmt.Errorf("could not write %s: %w", table, err)
+		}
+	}
+	err = tableWriter.Close()
+	if err != nil {
+		return fmt.Errorf("could not write %s: %w", table, err)
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return fmt.Errorf("could not complete %s: %w", table, err)
+	}
+	return nil
+}
+
+// retrieveLogs retrieves the logs of a partition in ascending order.
+func (e *Exporter) retrieveLogs(ctx context.Context, startBlock, endBlock uint64) ([]*types.Log, error) {
+	logFilter := db.LogFilter{ChainID: e.cfg.ChainID}
+	if e.cfg.ContractAddress != nil {
+		logFilter.ContractAddress = e.cfg.ContractAddress.String()
+	}
+
+	var logs []*types.Log
+	for page := 1; ; page++ {
+		logsPage, err := e.eventDB.RetrieveLogsInRangeAsc(ctx, logFilter, startBlock, endBlock, page)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve logs: %w", err)
+		}
+		logs = append(logs, logsPage...)
+		if len(logsPage) < base.PageSize {
+			return logs, nil
+		}
+	}
+}
+
+// retrieveTxs retrieves the receipts and transactions of a partition in ascending order. If the export is restricted to
+// a contract, only the transactions of its logs are retrieved.
+func (e *Exporter) retrieveTxs(ctx context.Context, startBlock, endBlock uint64, logs []*types.Log) ([]types.Receipt, []db.TxWithBlockNumber, error) {
+	var receipts []types.Receipt
+	var txs []db.TxWithBlockNumber
+
+	if e.cfg.ContractAddress != nil {
+		seen := make(map[common.Hash]bool)
+		for _, log := range logs {
+			if seen[log.TxHash] {
+				continue
+			}
+			seen[log.TxHash] = true
+
+			txReceipts, err := e.eventDB.RetrieveReceiptsWithFilter(ctx, db.ReceiptFilter{ChainID: e.cfg.ChainID, TxHash: log.TxHash.String()}, 1)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not retrieve receipt of %s: %w", log.TxHash, err)
+			}
+			receipts = append(receipts, txReceipts...)
+
+			ethTxs, err := e.eventDB.RetrieveEthTxsWithFilter(ctx, db.EthTxFilter{ChainID: e.cfg.ChainID, TxHash: log.TxHash.String()}, 1)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not retrieve transaction %s: %w", log.TxHash, err)
+			}
+			txs = append(txs, ethTxs...)
+		}
+	} else {
+		for page := 1; ; page++ {
+			receiptsPage, err := e.eventDB.RetrieveReceiptsInRange(ctx, db.ReceiptFilter{ChainID: e.cfg.ChainID}, startBlock, endBlock, page)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not retrieve receipts: %w", err)
+			}
+			receipts = append(receipts, receiptsPage...)
+			if len(receiptsPage) < base.PageSize {
+				break
+			}
+		}
+
+		for page := 1; ; page++ {
+			txsPage, err := e.eventDB.RetrieveEthTxsInRange(ctx, db.EthTxFilter{ChainID: e.cfg.ChainID}, startBlock, endBlock, page)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not retrieve transactions: %w", err)
+			}
+			txs = append(txs, txsPage...)
+			if len(txsPage) < base.PageSize {
+				break
+			}
+		}
+		// Transactions are retrieved latest first.
+		for i, j := 0, len(txs)-1; i < j; i, j = i+1, j-1 {
+			txs[i], txs[j] = txs[j], txs[i]
+		}
+	}
+
+	sort.SliceStable(receipts, func(i, j int) bool {
+		if receipts[i].BlockNumber.Cmp(receipts[j].BlockNumber) != 0 {
+			return receipts[i].BlockNumber.Cmp(receipts[j].BlockNumber) < 0
+		}
+		return receipts[i].TransactionIndex < receipts[j].TransactionIndex
+	})
+	return receipts, txs, nil
+}
+
+// retrieveBlockTimes retrieves the block times of a partition. If the export is restricted to a contract, only the
+// block times of the blocks its logs are in are retrieved.
+func (e *Exporter) retrieveBlockTimes(ctx context.Context, startBlock, endBlock uint64, logs []*types.Log) ([]*BlockTimeRow, error) {
+	blockTimes, err := e.eventDB.RetrieveBlockTimesInRange(ctx, e.cfg.ChainID, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve block times: %w", err)
+	}
+
+	var blockNumbers []uint64
+	if e.cfg.ContractAddress != nil {
+		seen := make(map[uint64]bool)
+		for _, log := range logs {
+			blockNumber := log.BlockNumber
+			if _, ok := blockTimes[blockNumber]; ok && !seen[blockNumber] {
+				seen[blockNumber] = true
+				blockNumbers = append(blockNumbers, blockNumber)
+			}
+		}
+	} else {
+		for blockNumber := range blockTimes {
+			blockNumbers = append(blockNumbers, blockNumber)
+		}
+	}
+	sort.Slice(blockNumbers, func(i, j int) bool {
+		return blockNumbers[i] < blockNumbers[j]
+	})
+
+	rows := make([]*BlockTimeRow, len(blockNumbers))
+	for i, blockNumber := range blockNumbers {
+		rows[i] = &BlockTimeRow{ChainID: int64(e.cfg.ChainID), BlockNumber: int64(blockNumber), Timestamp: int64(blockTimes[blockNumber])}
+	}
+	return rows, nil
+}
+
+func (e *Exporter) logRow(log *types.Log) *LogRow {
+	row := &LogRow{
+		ChainID:         int64(e.cfg.ChainID),
+		BlockNumber:     int64(log.BlockNumber),
+		BlockHash:       log.BlockHash.String(),
+		TxHash:          log.TxHash.String(),
+		TxIndex:         int64(log.TxIndex),
+		LogIndex:        int64(log.Index),
+		ContractAddress: log.Address.String(),
+		Data:            e.hex(log.Data),
+	}
+	topics := []*string{&row.Topic0, &row.Topic1, &row.Topic2, &row.Topic3}
+	for i, topic := range log.Topics {
+		if i < len(topics) {
+			*topics[i] = topic.String()
+		}
+	}
+	return row
+}
+
+func (e *Exporter) receiptRow(receipt *types.Receipt) *ReceiptRow {
+	row := &ReceiptRow{
+		ChainID:           int64(e.cfg.ChainID),
+		BlockNumber:       receipt.BlockNumber.Int64(),
+		BlockHash:         receipt.BlockHash.String(),
+		TxHash:            receipt.TxHash.String(),
+		TxIndex:           int64(receipt.TransactionIndex),
+		Type:              int32(receipt.Type),
+		Status:            int64(receipt.Status),
+		CumulativeGasUsed: int64(receipt.CumulativeGasUsed),
+		GasUsed:           int64(receipt.GasUsed),
+		LogsBloom:         e.hex(receipt.Bloom.Bytes()),
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		row.ContractAddress = receipt.ContractAddress.String()
+	}
+	return row
+}
+
+func (e *Exporter) transactionRow(tx *db.TxWithBlockNumber) *TransactionRow {
+	row := &TransactionRow{
+		ChainID:     int64(e.cfg.ChainID),
+		BlockNumber: int64(tx.BlockNumber),
+		TxHash:      tx.Tx.Hash().String(),
+		Type:        int32(tx.Tx.Type()),
+		Nonce:       int64(tx.Tx.Nonce()),
+		Value:       tx.Tx.Value().String(),
+		Gas:         int64(tx.Tx.Gas()),
+		GasPrice:    tx.Tx.GasPrice().String(),
+		GasFeeCap:   tx.Tx.GasFeeCap().String(),
+		GasTipCap:   tx.Tx.GasTipCap().String(),
+		Input:       e.hex(tx.Tx.Data()),
+	}
+	if sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(int64(e.cfg.ChainID))), &tx.Tx); err == nil {
+		row.FromAddress = sender.String()
+	}
+	if tx.Tx.To() != nil {
+		row.ToAddress = tx.Tx.To().String()
+	}
+	return row
+}
+
+// hex hex encodes a payload column, unless hex fields are skipped.
+func (e *Exporter) hex(data []byte) string {
+	if e.cfg.SkipHexFields {
+		return ""
+	}
+	return hexutil.Encode(data)
+}
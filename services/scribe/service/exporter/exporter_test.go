@@ -0,0 +1,279 @@
+package exporter_test
+
+import (
+	"encoding/csv"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
+	"github.com/synapsecns/sanguine/services/scribe/service/exporter"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// indexedTx is a transaction stored along with its receipt and log.
+type indexedTx struct {
+	tx  *types.Transaction
+	log types.Log
+}
+
+// storeTx stores a signed transaction in a block, along with its receipt and a log emitted by a contract.
+func (e *ExporterSuite) storeTx(chainID uint32, signer *localsigner.Signer, contract common.Address, blockNumber uint64, nonce uint64) indexedTx {
+	transactor, err := signer.GetTransactor(e.GetTestContext(), big.NewInt(int64(chainID)))
+	Nil(e.T(), err)
+	tx, err := transactor.Signer(signer.Address(), types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(int64(chainID)),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &contract,
+		Value:     big.NewInt(int64(gofakeit.Uint32())),
+		Data:      []byte{1, 2, 3},
+	}))
+	Nil(e.T(), err)
+
+	blockHash := common.BigToHash(new(big.Int).SetUint64(blockNumber))
+	log := types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{common.BigToHash(big.NewInt(gofakeit.Int64())), common.BigToHash(big.NewInt(gofakeit.Int64()))},
+		Data:        []byte{4, 5, 6},
+		BlockNumber: blockNumber,
+		TxHash:      tx.Hash(),
+		TxIndex:     uint(nonce),
+		BlockHash:   blockHash,
+		Index:       uint(nonce),
+	}
+	Nil(e.T(), e.testDB.StoreLogs(e.GetTestContext(), chainID, log))
+	Nil(e.T(), e.testDB.StoreReceipt(e.GetTestContext(), chainID, types.Receipt{
+		Type:              types.DynamicFeeTxType,
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Bloom:             types.BytesToBloom([]byte{7}),
+		Logs:              []*types.Log{&log},
+		TxHash:            tx.Hash(),
+		GasUsed:           21000,
+		BlockHash:         blockHash,
+		BlockNumber:       new(big.Int).SetUint64(blockNumber),
+		TransactionIndex:  uint(nonce),
+	}))
+	Nil(e.T(), e.testDB.StoreEthTx(e.GetTestContext(), tx, chainID, blockHash, blockNumber, nonce))
+	return indexedTx{tx: tx, log: log}
+}
+
+// storeChain stores transactions of two contracts in blocks 5, 12 and 25, and block times for blocks 0-29. Both
+// contracts are indexed to block 29.
+func (e *ExporterSuite) storeChain(chainID uint32) (contractA, contractB common.Address, txsA []indexedTx, signer *localsigner.Signer) {
+	testWallet, err := wallet.FromRandom()
+	Nil(e.T(), err)
+	signer = localsigner.NewSigner(testWallet.PrivateKey())
+
+	contractA = common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	contractB = common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	nonce := uint64(0)
+	for _, blockNumber := range []uint64{5, 12, 25} {
+		txsA = append(txsA, e.storeTx(chainID, signer, contractA, blockNumber, nonce))
+		nonce++
+		if blockNumber == 12 {
+			e.storeTx(chainID, signer, contractB, blockNumber, nonce)
+			nonce++
+		}
+	}
+	for blockNumber := uint64(0); blockNumber < 30; blockNumber++ {
+		Nil(e.T(), e.testDB.StoreBlockTime(e.GetTestContext(), chainID, blockNumber, 1000+blockNumber))
+	}
+	Nil(e.T(), e.testDB.StoreLastIndexedMultiple(e.GetTestContext(), []common.Address{contractA, contractB}, chainID, 29))
+	return contractA, contractB, txsA, signer
+}
+
+// TestExportCSV tests exporting a chain to csv partitions and resuming the export.
+func (e *ExporterSuite) TestExportCSV() {
+	chainID := gofakeit.Uint32()
+	contractA, contractB, txsA, _ := e.storeChain(chainID)
+
+	dataExporter, err := exporter.NewExporter(e.testDB, exporter.Config{
+		ChainID:       chainID,
+		Contracts:     []common.Address{contractA, contractB},
+		StartBlock:    3,
+		EndBlock:      29,
+		PartitionSize: 10,
+		Format:        exporter.CSV,
+		OutputDir:     filet.TmpDir(e.T(), ""),
+	})
+	Nil(e.T(), err)
+
+	report, err := dataExporter.Export(e.GetTestContext())
+	Nil(e.T(), err)
+	Equal(e.T(), exporter.Report{Partitions: 3, Logs: 4, Receipts: 4, Transactions: 4, BlockTimes: 27}, *report)
+
+	// Partitions are aligned to the partition size.
+	rows := e.readCSV(dataExporter.PartitionPath("logs", 10, 19))
+	Equal(e.T(), []string{
+		"chain_id", "block_number", "block_hash", "tx_hash", "tx_index", "log_index", "contract_address",
+		"topic0", "topic1", "topic2", "topic3", "data",
+	}, rows[0])
+	Equal(e.T(), 3, len(rows))
+	log := txsA[1].log
+	Equal(e.T(), []string{
+		big.NewInt(int64(chainID)).String(), "12", log.BlockHash.String(), log.TxHash.String(), "1", "1", log.Address.String(),
+		log.Topics[0].String(), log.Topics[1].String(), "", "", "0x040506",
+	}, rows[1])
+
+	rows = e.readCSV(dataExporter.PartitionPath("transactions", 20, 29))
+	Equal(e.T(), 2, len(rows))
+	Equal(e.T(), txsA[2].tx.Hash().String(), rows[1][2])
+	Equal(e.T(), "0x010203", rows[1][12])
+
+	rows = e.readCSV(dataExporter.PartitionPath("block_times", 3, 9))
+	Equal(e.T(), 8, len(rows))
+	Equal(e.T(), []string{big.NewInt(int64(chainID)).String(), "3", "1003"}, rows[1])
+
+	// Completed partitions are skipped when the export is resumed.
+	Nil(e.T(), os.Remove(dataExporter.PartitionPath("receipts", 20, 29)))
+	report, err = dataExporter.Export(e.GetTestContext())
+	Nil(e.T(), err)
+	Equal(e.T(), exporter.Report{Partitions: 1, Skipped: 2, Logs: 1, Receipts: 1, Transactions: 1, BlockTimes: 10}, *report)
+	Equal(e.T(), 2, len(e.readCSV(dataExporter.PartitionPath("receipts", 20, 29))))
+}
+
+// TestExportParquetContract tests exporting a contract to parquet without hex fields.
+func (e *ExporterSuite) TestExportParquetContract() {
+	chainID := gofakeit.Uint32()
+	contractA, _, txsA, signer := e.storeChain(chainID)
+
+	outputDir := filet.TmpDir(e.T(), "")
+	dataExporter, err := exporter.NewExporter(e.testDB, exporter.Config{
+		ChainID:         chainID,
+		ContractAddress: &contractA,
+		StartBlock:      0,
+		EndBlock:        29,
+		Format:          exporter.Parquet,
+		SkipHexFields:   true,
+		OutputDir:       outputDir,
+	})
+	Nil(e.T(), err)
+
+	report, err := dataExporter.Export(e.GetTestContext())
+	Nil(e.T(), err)
+	Equal(e.T(), exporter.Report{Partitions: 1, Logs: 3, Receipts: 3, Transactions: 3, BlockTimes: 3}, *report)
+	Equal(e.T(), filepath.Join(outputDir, "logs", "chain_id="+big.NewInt(int64(chainID)).String(), "contract_address="+contractA.String(),
+		"000000000000-000000000029.parquet"), dataExporter.PartitionPath("logs", 0, 29))
+
+	var logs []exporter.LogRow
+	e.readParquet(dataExporter.PartitionPath("logs", 0, 29), new(exporter.LogRow), &logs)
+	Equal(e.T(), 3, len(logs))
+	for i, log := range logs {
+		Equal(e.T(), exporter.LogRow{
+			ChainID:         int64(chainID),
+			BlockNumber:     int64(txsA[i].log.BlockNumber),
+			BlockHash:       txsA[i].log.BlockHash.String(),
+			TxHash:          txsA[i].log.TxHash.String(),
+			TxIndex:         int64(txsA[i].log.TxIndex),
+			LogIndex:        int64(txsA[i].log.Index),
+			ContractAddress: contractA.String(),
+			Topic0:          txsA[i].log.Topics[0].String(),
+			Topic1:          txsA[i].log.Topics[1].String(),
+		}, log)
+	}
+
+	var txs []exporter.TransactionRow
+	e.readParquet(dataExporter.PartitionPath("transactions", 0, 29), new(exporter.TransactionRow), &txs)
+	Equal(e.T(), 3, len(txs))
+	for i, tx := range txs {
+		Equal(e.T(), txsA[i].tx.Hash().String(), tx.TxHash)
+		Equal(e.T(), signer.Address().String(), tx.FromAddress)
+		Equal(e.T(), contractA.String(), tx.ToAddress)
+		Equal(e.T(), txsA[i].tx.Value().String(), tx.Value)
+		Equal(e.T(), "", tx.Input)
+	}
+
+	var receipts []exporter.ReceiptRow
+	e.readParquet(dataExporter.PartitionPath("receipts", 0, 29), new(exporter.ReceiptRow), &receipts)
+	Equal(e.T(), 3, len(receipts))
+	Equal(e.T(), int64(types.ReceiptStatusSuccessful), receipts[0].Status)
+	Equal(e.T(), "", receipts[0].LogsBloom)
+
+	var blockTimes []exporter.BlockTimeRow
+	e.readParquet(dataExporter.PartitionPath("block_times", 0, 29), new(exporter.BlockTimeRow), &blockTimes)
+	Equal(e.T(), []exporter.BlockTimeRow{
+		{ChainID: int64(chainID), BlockNumber: 5, Timestamp: 1005},
+		{ChainID: int64(chainID), BlockNumber: 12, Timestamp: 1012},
+		{ChainID: int64(chainID), BlockNumber: 25, Timestamp: 1025},
+	}, blockTimes)
+}
+
+// TestExportPastLastIndexed tests that partitions past the last indexed block are exported once they are indexed.
+func (e *ExporterSuite) TestExportPastLastIndexed() {
+	chainID := gofakeit.Uint32()
+	contractA, contractB, _, signer := e.storeChain(chainID)
+
+	dataExporter, err := exporter.NewExporter(e.testDB, exporter.Config{
+		ChainID:       chainID,
+		Contracts:     []common.Address{contractA, contractB},
+		StartBlock:    0,
+		EndBlock:      49,
+		PartitionSize: 10,
+		Format:        exporter.CSV,
+		OutputDir:     filet.TmpDir(e.T(), ""),
+	})
+	Nil(e.T(), err)
+
+	report, err := dataExporter.Export(e.GetTestContext())
+	Nil(e.T(), err)
+	Equal(e.T(), exporter.Report{Partitions: 3, Pending: 2, Logs: 4, Receipts: 4, Transactions: 4, BlockTimes: 30}, *report)
+	NoFileExists(e.T(), dataExporter.PartitionPath("logs", 30, 39))
+
+	// Partitions are exported once every contract has been indexed past them.
+	tx := e.storeTx(chainID, signer, contractA, 35, 4)
+	Nil(e.T(), e.testDB.StoreLastIndexed(e.GetTestContext(), contractA, chainID, 45, false))
+	report, err = dataExporter.Export(e.GetTestContext())
+	Nil(e.T(), err)
+	Equal(e.T(), exporter.Report{Skipped: 3, Pending: 2}, *report)
+
+	Nil(e.T(), e.testDB.StoreLastIndexed(e.GetTestContext(), contractB, chainID, 45, false))
+	report, err = dataExporter.Export(e.GetTestContext())
+	Nil(e.T(), err)
+	Equal(e.T(), exporter.Report{Partitions: 1, Skipped: 3, Pending: 1, Logs: 1, Receipts: 1, Transactions: 1}, *report)
+
+	rows := e.readCSV(dataExporter.PartitionPath("logs", 30, 39))
+	Equal(e.T(), 2, len(rows))
+	Equal(e.T(), tx.log.TxHash.String(), rows[1][3])
+}
+
+func (e *ExporterSuite) readCSV(path string) [][]string {
+	file, err := os.Open(filepath.Clean(path))
+	Nil(e.T(), err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	Nil(e.T(), err)
+	return rows
+}
+
+// readParquet reads every row of a parquet file into rows, a pointer to a slice of the template's type.
+func (e *ExporterSuite) readParquet(path string, template interface{}, rows interface{}) {
+	file, err := local.NewLocalFileReader(path)
+	Nil(e.T(), err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	parquetReader, err := reader.NewParquetReader(file, template, 1)
+	Nil(e.T(), err)
+	defer parquetReader.ReadStop()
+
+	numRows := int(parquetReader.GetNumRows())
+	rowsValue := reflect.ValueOf(rows).Elem()
+	rowsValue.Set(reflect.MakeSlice(rowsValue.Type(), numRows, numRows))
+	Nil(e.T(), parquetReader.Read(rows))
+}
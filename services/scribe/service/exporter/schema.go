@@ -0,0 +1,103 @@
+package exporter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// The rows below define the schema of each exported table. Columns are only ever added, never renamed or removed, so
+// exports stay loadable into existing tables. Parquet and CSV files use the same column names, in the same order.
+// Integers are signed since not every analytics database supports unsigned Parquet columns.
+
+// LogRow is a row of the logs table.
+type LogRow struct {
+	ChainID         int64  `parquet:"name=chain_id, type=INT64"`
+	BlockNumber     int64  `parquet:"name=block_number, type=INT64"`
+	BlockHash       string `parquet:"name=block_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TxHash          string `parquet:"name=tx_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TxIndex         int64  `parquet:"name=tx_index, type=INT64"`
+	LogIndex        int64  `parquet:"name=log_index, type=INT64"`
+	ContractAddress string `parquet:"name=contract_address, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Topic0          string `parquet:"name=topic0, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Topic1          string `parquet:"name=topic1, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Topic2          string `parquet:"name=topic2, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Topic3          string `parquet:"name=topic3, type=BYTE_ARRAY, convertedtype=UTF8"`
+	// Data is hex encoded, or empty if hex fields are skipped.
+	Data string `parquet:"name=data, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// ReceiptRow is a row of the receipts table.
+type ReceiptRow struct {
+	ChainID           int64  `parquet:"name=chain_id, type=INT64"`
+	BlockNumber       int64  `parquet:"name=block_number, type=INT64"`
+	BlockHash         string `parquet:"name=block_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TxHash            string `parquet:"name=tx_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TxIndex           int64  `parquet:"name=tx_index, type=INT64"`
+	Type              int32  `parquet:"name=type, type=INT32"`
+	Status            int64  `parquet:"name=status, type=INT64"`
+	CumulativeGasUsed int64  `parquet:"name=cumulative_gas_used, type=INT64"`
+	GasUsed           int64  `parquet:"name=gas_used, type=INT64"`
+	// ContractAddress is the address of the contract created by the transaction, if any.
+	ContractAddress string `parquet:"name=contract_address, type=BYTE_ARRAY, convertedtype=UTF8"`
+	// LogsBloom is hex encoded, or empty if hex fields are skipped.
+	LogsBloom string `parquet:"name=logs_bloom, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// TransactionRow is a row of the transactions table. Amounts are decimal strings since they may not fit in 64 bits.
+type TransactionRow struct {
+	ChainID     int64  `parquet:"name=chain_id, type=INT64"`
+	BlockNumber int64  `parquet:"name=block_number, type=INT64"`
+	TxHash      string `parquet:"name=tx_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Type        int32  `parquet:"name=type, type=INT32"`
+	Nonce       int64  `parquet:"name=nonce, type=INT64"`
+	// FromAddress is the sender recovered from the signature, empty if it can't be recovered.
+	FromAddress string `parquet:"name=from_address, type=BYTE_ARRAY, convertedtype=UTF8"`
+	// ToAddress is empty for contract creations.
+	ToAddress string `parquet:"name=to_address, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Value     string `parquet:"name=value, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Gas       int64  `parquet:"name=gas, type=INT64"`
+	GasPrice  string `parquet:"name=gas_price, type=BYTE_ARRAY, convertedtype=UTF8"`
+	GasFeeCap string `parquet:"name=gas_fee_cap, type=BYTE_ARRAY, convertedtype=UTF8"`
+	GasTipCap string `parquet:"name=gas_tip_cap, type=BYTE_ARRAY, convertedtype=UTF8"`
+	// Input is hex encoded, or empty if hex fields are skipped.
+	Input string `parquet:"name=input, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// BlockTimeRow is a row of the block times table.
+type BlockTimeRow struct {
+	ChainID     int64 `parquet:"name=chain_id, type=INT64"`
+	BlockNumber int64 `parquet:"name=block_number, type=INT64"`
+	Timestamp   int64 `parquet:"name=timestamp, type=INT64"`
+}
+
+// columns gets the column names of a row type, in order.
+func columns(row interface{}) []string {
+	rowType := reflect.Indirect(reflect.ValueOf(row)).Type()
+	columns := make([]string, rowType.NumField())
+	for i := range columns {
+		columns[i] = columnName(rowType.Field(i))
+	}
+	return columns
+}
+
+// values formats the values of a row, in column order.
+func values(row interface{}) []string {
+	rowValue := reflect.Indirect(reflect.ValueOf(row))
+	rowValues := make([]string, rowValue.NumField())
+	for i := range rowValues {
+		rowValues[i] = fmt.Sprint(rowValue.Field(i).Interface())
+	}
+	return rowValues
+}
+
+// columnName gets the column name of a row field from its parquet tag.
+func columnName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("parquet"), ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(option), "=")
+		if ok && key == "name" {
+			return value
+		}
+	}
+	return field.Name
+}
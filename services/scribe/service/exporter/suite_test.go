@@ -0,0 +1,41 @@
+package exporter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Flaque/filet"
+	. "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
+)
+
+type ExporterSuite struct {
+	*testsuite.TestSuite
+	testDB db.EventDB
+}
+
+// NewExporterSuite creates a new exporter test suite.
+func NewExporterSuite(tb testing.TB) *ExporterSuite {
+	tb.Helper()
+	return &ExporterSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+// SetupTest sets up the test suite.
+func (e *ExporterSuite) SetupTest() {
+	e.TestSuite.SetupTest()
+	e.SetTestTimeout(time.Minute * 3)
+	sqliteStore, err := sqlite.NewSqliteStore(e.GetTestContext(), filet.TmpDir(e.T(), ""), metrics.NewNullHandler(), false)
+	Nil(e.T(), err)
+	e.testDB = sqliteStore
+}
+
+// TestExporterSuite tests the exporter suite.
+func TestExporterSuite(t *testing.T) {
+	suite.Run(t, NewExporterSuite(t))
+}
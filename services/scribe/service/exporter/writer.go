@@ -0,0 +1,118 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Format is the file format tables are exported in.
+type Format string
+
+const (
+	// Parquet exports tables as snappy compressed Parquet files.
+	Parquet Format = "parquet"
+	// CSV exports tables as CSV files with a header row.
+	CSV Format = "csv"
+)
+
+// parquetConcurrency is the number of goroutines used to encode a Parquet file.
+const parquetConcurrency = 4
+
+// tableWriter writes the rows of a table partition to a file.
+type tableWriter interface {
+	// Write writes a row.
+	Write(row interface{}) error
+	// Close flushes the rows written and closes the file.
+	Close() error
+}
+
+// newTableWriter creates a file for a table partition, with rows of the template's type.
+func newTableWriter(format Format, path string, template interface{}) (tableWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not create %s: %w", path, err)
+	}
+
+	switch format {
+	case Parquet:
+		parquetWriter, err := writer.NewParquetWriterFromWriter(file, template, parquetConcurrency)
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("could not create parquet writer: %w", err)
+		}
+		return &parquetTableWriter{file: file, writer: parquetWriter}, nil
+	case CSV:
+		csvWriter := csv.NewWriter(file)
+		err = csvWriter.Write(columns(template))
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("could not write header: %w", err)
+		}
+		return &csvTableWriter{file: file, writer: csvWriter}, nil
+	default:
+		_ = file.Close()
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+type parquetTableWriter struct {
+	file   *os.File
+	writer *writer.ParquetWriter
+}
+
+func (p *parquetTableWriter) Write(row interface{}) error {
+	err := p.writer.Write(row)
+	if err != nil {
+		return fmt.Errorf("could not write row: %w", err)
+	}
+	return nil
+}
+
+func (p *parquetTableWriter) Close() error {
+	err := p.writer.WriteStop()
+	if err != nil {
+		_ = p.file.Close()
+		return fmt.Errorf("could not finish parquet file: %w", err)
+	}
+	return closeFile(p.file)
+}
+
+type csvTableWriter struct {
+	file   *os.File
+	writer *csv.Writer
+}
+
+func (c *csvTableWriter) Write(row interface{}) error {
+	err := c.writer.Write(values(row))
+	if err != nil {
+		return fmt.Errorf("could not write row: %w", err)
+	}
+	return nil
+}
+
+func (c *csvTableWriter) Close() error {
+	c.writer.Flush()
+	err := c.writer.Error()
+	if err != nil {
+		_ = c.file.Close()
+		return fmt.Errorf("could not flush csv file: %w", err)
+	}
+	return closeFile(c.file)
+}
+
+// closeFile syncs a file to disk and closes it.
+func closeFile(file *os.File) error {
+	err := file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("could not sync file: %w", err)
+	}
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("could not close file: %w", err)
+	}
+	return nil
+}
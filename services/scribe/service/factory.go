@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/logger"
+)
+
+// factory is a factory contract whose children are indexed automatically.
+type factory struct {
+	// event is the event emitted when a child is created.
+	event abi.Event
+	// childArgument is the index of the event parameter holding the child address.
+	childArgument int
+}
+
+// newFactory creates a factory from its config.
+func newFactory(factoryConfig config.FactoryConfig) (factory, error) {
+	event, err := factoryConfig.CreationEvent()
+	if err != nil {
+		return factory{}, fmt.Errorf("could not parse creation event: %w", err)
+	}
+
+	return factory{
+		event:         event,
+		childArgument: factoryConfig.ChildArgument,
+	}, nil
+}
+
+// childAddress gets the address of the child created in a creation event log.
+func (f factory) childAddress(log types.Log) (common.Address, error) {
+	topicIndex := 1
+	nonIndexedIndex := 0
+	for i := 0; i < f.childArgument; i++ {
+		if f.event.Inputs[i].Indexed {
+			topicIndex++
+		} else {
+			nonIndexedIndex++
+		}
+	}
+
+	if f.event.Inputs[f.childArgument].Indexed {
+		if topicIndex >= len(log.Topics) {
+			return common.Address{}, fmt.Errorf("creation event has %d topics, child address is topic %d", len(log.Topics), topicIndex)
+		}
+		return common.BytesToAddress(log.Topics[topicIndex].Bytes()), nil
+	}
+
+	values, err := f.event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("could not unpack creation event: %w", err)
+	}
+	child, ok := values[nonIndexedIndex].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("child argument %d is not an address", f.childArgument)
+	}
+	return child, nil
+}
+
+// handleFactoryLog registers the child created by a factory's creation event as a new indexed contract starting at
+// the creation block. Children are persisted so they are indexed again after a restart.
+func (c *ChainIndexer) handleFactoryLog(ctx context.Context, log types.Log) error {
+	factory, ok := c.factories[log.Address]
+	if !ok || len(log.Topics) == 0 || log.Topics[0] != factory.event.ID {
+		return nil
+	}
+
+	child, err := factory.childAddress(log)
+	if err != nil {
+		// A malformed creation event shouldn't stop the factory from being indexed.
+		logger.ReportScribeError(fmt.Errorf("could not get child of factory %s in tx %s: %w", log.Address, log.TxHash, err), c.chainID, logger.FactoryChildError)
+		return nil
+	}
+	if c.hasContract(child) {
+		return nil
+	}
+
+	err = c.eventDB.StoreFactoryChild(ctx, c.chainID, db.FactoryChild{
+		FactoryAddress:  log.Address,
+		ContractAddress: child,
+		BlockNumber:     log.BlockNumber,
+	})
+	if err != nil {
+		return fmt.Errorf("could not store factory child: %w", err)
+	}
+
+	contract := config.ContractConfig{
+		Address:    child.String(),
+		StartBlock: log.BlockNumber,
+	}
+	if !c.addContract(contract) {
+		return nil
+	}
+	logger.ReportScribeState(c.chainID, log.BlockNumber, []common.Address{log.Address, child}, logger.FactoryChildDiscovered)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context canceled while registering factory child: %w", ctx.Err())
+	case c.addedContracts <- contract:
+		return nil
+	}
+}
+
+// loadFactoryChildren adds the children previously discovered from factories to the indexed contracts.
+func (c *ChainIndexer) loadFactoryChildren(ctx context.Context) error {
+	if len(c.factories) == 0 {
+		return nil
+	}
+
+	children, err := c.eventDB.RetrieveFactoryChildren(ctx, c.chainID)
+	if err != nil {
+		return fmt.Errorf("could not get factory children: %w", err)
+	}
+
+	for _, child := range children {
+		c.addContract(config.ContractConfig{
+			Address:    child.ContractAddress.String(),
+			StartBlock: child.BlockNumber,
+		})
+	}
+
+	return nil
+}
+
+// hasContract checks if a contract is already indexed.
+func (c *ChainIndexer) hasContract(address common.Address) bool {
+	c.contractsMux.RLock()
+	defer c.contractsMux.RUnlock()
+
+	return c.hasContractLocked(address)
+}
+
+// hasContractLocked checks if a contract is already indexed. The contracts lock must be held.
+func (c *ChainIndexer) hasContractLocked(address common.Address) bool {
+	for i := range c.chainConfig.Contracts {
+		if common.HexToAddress(c.chainConfig.Contracts[i].Address) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// addContract adds a contract to the indexed contracts, returning false if it was already indexed.
+func (c *ChainIndexer) addContract(contract config.ContractConfig) bool {
+	c.contractsMux.Lock()
+	defer c.contractsMux.Unlock()
+
+	return c.addContractLocked(contract)
+}
+
+// addContractLocked adds a contract to the indexed contracts, returning false if it was already indexed. The
+// contracts lock must be held.
+func (c *ChainIndexer) addContractLocked(contract config.ContractConfig) bool {
+	if c.hasContractLocked(common.HexToAddress(contract.Address)) {
+		return false
+	}
+	c.chainConfig.Contracts = append(c.chainConfig.Contracts, contract)
+	return true
+}
+
+// getChainConfig gets a copy of the chain config, including the contracts discovered from factories.
+func (c *ChainIndexer) getChainConfig() config.ChainConfig {
+	c.contractsMux.RLock()
+	defer c.contractsMux.RUnlock()
+
+	chainConfig := c.chainConfig
+	chainConfig.Contracts = append(config.ContractConfigs{}, c.chainConfig.Contracts...)
+	return chainConfig
+}
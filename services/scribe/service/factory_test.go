@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service"
+)
+
+// TestFactoryChildDiscovery tests that children created by a factory are registered and survive restarts.
+func (s *ScribeSuite) TestFactoryChildDiscovery() {
+	factoryAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	chainConfig := config.ChainConfig{
+		ChainID: gofakeit.Uint32(),
+		Factories: config.FactoryConfigs{{
+			Address:       factoryAddress.String(),
+			StartBlock:    5,
+			Event:         "PairCreated(address indexed token0, address indexed token1, address pair, uint256)",
+			ChildArgument: 2,
+		}},
+	}
+	chainIndexer, err := service.NewChainIndexer(s.testDB, []backend.ScribeBackend{}, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+
+	// The factory itself is indexed.
+	Equal(s.T(), config.ContractConfigs{{Address: factoryAddress.String(), StartBlock: 5}}, chainIndexer.GetContracts())
+
+	child := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	creationLog := types.Log{
+		Address: factoryAddress,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("PairCreated(address,address,address,uint256)")),
+			common.BigToHash(big.NewInt(gofakeit.Int64())),
+			common.BigToHash(big.NewInt(gofakeit.Int64())),
+		},
+		Data:        append(common.LeftPadBytes(child.Bytes(), 32), common.LeftPadBytes(big.NewInt(1).Bytes(), 32)...),
+		BlockNumber: 10,
+		TxHash:      common.BigToHash(big.NewInt(gofakeit.Int64())),
+	}
+
+	// Logs that aren't creation events are ignored.
+	otherLog := creationLog
+	otherLog.Topics = []common.Hash{common.BigToHash(big.NewInt(gofakeit.Int64()))}
+	Nil(s.T(), chainIndexer.HandleFactoryLog(s.GetTestContext(), otherLog))
+	Equal(s.T(), 1, len(chainIndexer.GetContracts()))
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- chainIndexer.HandleFactoryLog(s.GetTestContext(), creationLog)
+	}()
+	discovered := <-chainIndexer.AddedContracts()
+	Nil(s.T(), <-errChan)
+	childConfig := config.ContractConfig{Address: child.String(), StartBlock: 10}
+	Equal(s.T(), childConfig, discovered)
+	Contains(s.T(), chainIndexer.GetContracts(), childConfig)
+
+	// Seeing the creation event again doesn't register the child twice.
+	Nil(s.T(), chainIndexer.HandleFactoryLog(s.GetTestContext(), creationLog))
+	Equal(s.T(), 2, len(chainIndexer.GetContracts()))
+
+	children, err := s.testDB.RetrieveFactoryChildren(s.GetTestContext(), chainConfig.ChainID)
+	Nil(s.T(), err)
+	Equal(s.T(), []db.FactoryChild{{FactoryAddress: factoryAddress, ContractAddress: child, BlockNumber: 10}}, children)
+
+	// After a restart, the child is indexed again.
+	restartedIndexer, err := service.NewChainIndexer(s.testDB, []backend.ScribeBackend{}, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+	Nil(s.T(), restartedIndexer.LoadFactoryChildren(s.GetTestContext()))
+	Contains(s.T(), restartedIndexer.GetContracts(), childConfig)
+}
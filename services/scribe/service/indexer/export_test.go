@@ -0,0 +1,12 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lmittmann/w3/module/debug"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+// FlattenCallTrace exports flattenCallTrace for testing.
+func FlattenCallTrace(callTrace *debug.CallTrace, txHash common.Hash, blockNumber uint64, blockHash common.Hash, txIndex uint64) []db.CallFrame {
+	return flattenCallTrace(callTrace, txHash, blockNumber, blockHash, txIndex)
+}
@@ -12,6 +12,7 @@
 	"time"
 
 	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/debug"
 	"github.com/lmittmann/w3/module/eth"
 	"github.com/lmittmann/w3/w3types"
 	"github.com/synapsecns/sanguine/core/mapmutex"
@@ -26,6 +27,7 @@
 	"github.com/jpillora/backoff"
 	"github.com/synapsecns/sanguine/services/scribe/config"
 	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/decoder"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -37,7 +39,7 @@ type Indexer struct {
 	eventDB db.EventDB
 	// client is the client for filtering.
 	client []backend.ScribeBackend
-	// cache is a cache for txHashes.
+	// cache is a cache for stored txHashes, keyed by txHash and block hash so a tx re-included after a reorg is stored again.
 	cache *lru.Cache
 	// mux is the mutex used to prevent double inserting logs from the same tx
 	mux mapmutex.StringerMapMutex
@@ -51,8 +53,26 @@ type Indexer struct {
 	toHead bool
 	// isBackfill is a boolean signifying if the indexer is backfilling (prevents last indexed from running)
 	isBackfill bool
+	// contractTopics is a map from address -> the topic filter configured for the contract.
+	contractTopics map[common.Address][][]common.Hash
+	// logHandler is called with each fetched log before it is stored.
+	logHandler LogHandler
+	// decoder decodes stored logs with their contract's ABI.
+	decoder *decoder.Decoder
+	// progressHandler is called with the indexed addresses each time their last indexed block is stored.
+	progressHandler ProgressHandler
+	// traceContracts is the set of contracts whose transactions have their call traces indexed.
+	traceContracts map[common.Address]bool
+	// reorgGuard keeps the last indexed block from being stored past a rewind, nil if reorgs aren't handled.
+	reorgGuard *ReorgGuard
 }
 
+// LogHandler is called with each fetched log before it is stored.
+type LogHandler func(ctx context.Context, log types.Log) error
+
+// ProgressHandler is called with the indexed addresses each time their last indexed block is stored.
+type ProgressHandler func(addresses []common.Address, blockNumber uint64)
+
 // retryTolerance is the number of times to retry a failed operation before rerunning the entire Backfill function.
 const retryTolerance = 20
 
@@ -103,8 +123,14 @@ func NewIndexer(chainConfig config.ChainConfig, addresses []common.Address, even
 		}
 	}
 
+	contractTopics, traceContracts, err := contractFilters(chainConfig.Contracts)
+	if err != nil {
+		return nil, err
+	}
+
 	indexerConfig := scribeTypes.IndexerConfig{
 		Addresses:            addresses,
+		Topics:               mergeTopics(contractTopics, addresses),
 		GetLogsRange:         chainConfig.GetLogsRange,
 		GetLogsBatchAmount:   chainConfig.GetLogsBatchAmount,
 		StoreConcurrency:     chainConfig.StoreConcurrency,
@@ -113,22 +139,79 @@ func NewIndexer(chainConfig config.ChainConfig, addresses []common.Address, even
 	}
 
 	return &Indexer{
-		indexerConfig: indexerConfig,
-		eventDB:       eventDB,
-		client:        client,
-		cache:         cache,
-		mux:           mapmutex.NewStringerMapMutex(),
-		handler:       handler,
-		blockMeter:    blockMeter,
-		refreshRate:   refreshRate,
-		toHead:        toHead,
-		isBackfill:    false,
+		indexerConfig:  indexerConfig,
+		eventDB:        eventDB,
+		client:         client,
+		cache:          cache,
+		mux:            mapmutex.NewStringerMapMutex(),
+		handler:        handler,
+		blockMeter:     blockMeter,
+		refreshRate:    refreshRate,
+		toHead:         toHead,
+		isBackfill:     false,
+		contractTopics: contractTopics,
+		traceContracts: traceContracts,
 	}, nil
 }
 
-// UpdateAddress updates the address arrays for the indexer.
-func (x *Indexer) UpdateAddress(addresses []common.Address) {
+// UpdateContracts updates the contracts indexed by the indexer, including their topic filters and traces.
+func (x *Indexer) UpdateContracts(contracts []config.ContractConfig) error {
+	contractTopics, traceContracts, err := contractFilters(contracts)
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]common.Address, len(contracts))
+	for i := range contracts {
+		addresses[i] = common.HexToAddress(contracts[i].Address)
+	}
+
+	x.contractTopics = contractTopics
+	x.traceContracts = traceContracts
 	x.indexerConfig.Addresses = addresses
+	x.indexerConfig.Topics = mergeTopics(contractTopics, addresses)
+	return nil
+}
+
+// contractFilters gets the topic filters and traced contracts of contract configs.
+func contractFilters(contracts []config.ContractConfig) (map[common.Address][][]common.Hash, map[common.Address]bool, error) {
+	contractTopics := make(map[common.Address][][]common.Hash)
+	traceContracts := make(map[common.Address]bool)
+	for i := range contracts {
+		if contracts[i].Traces {
+			traceContracts[common.HexToAddress(contracts[i].Address)] = true
+		}
+		if len(contracts[i].Topics) == 0 {
+			continue
+		}
+		topics, err := scribeTypes.ParseTopics(contracts[i].Topics)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not parse topics for %s: %w", contracts[i].Address, err)
+		}
+		contractTopics[common.HexToAddress(contracts[i].Address)] = topics
+	}
+
+	return contractTopics, traceContracts, nil
+}
+
+// SetLogHandler sets the handler called with each fetched log before it is stored.
+func (x *Indexer) SetLogHandler(handler LogHandler) {
+	x.logHandler = handler
+}
+
+// SetDecoder sets the decoder used to decode stored logs with their contract's ABI.
+func (x *Indexer) SetDecoder(logDecoder *decoder.Decoder) {
+	x.decoder = logDecoder
+}
+
+// SetProgressHandler sets the handler called each time the last indexed block is stored.
+func (x *Indexer) SetProgressHandler(handler ProgressHandler) {
+	x.progressHandler = handler
+}
+
+// SetReorgGuard sets the guard that keeps the last indexed block from being stored past a rewind.
+func (x *Indexer) SetReorgGuard(reorgGuard *ReorgGuard) {
+	x.reorgGuard = reorgGuard
 }
 
 // SetToBackfill sets the indexer to backfill (will not update last indexed).
@@ -167,6 +250,9 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 
 	g, groupCtx := errgroup.WithContext(ctx)
 
+	// The range has to be indexed again if the last indexed blocks are rewound while indexing.
+	rewinds := x.reorgGuard.rewindCount()
+
 	// For logging
 	x.indexerConfig.StartHeight = startHeight
 	x.indexerConfig.EndHeight = endHeight
@@ -192,6 +278,15 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 				if !ok {
 					return nil
 				}
+				// The getLogs filter is shared by every contract in the indexer, so logs are matched against their contract's topics.
+				if !scribeTypes.MatchesTopics(x.contractTopics[log.Address], log.Topics) {
+					continue
+				}
+				if x.logHandler != nil {
+					if err := x.logHandler(groupCtx, log); err != nil {
+						return fmt.Errorf("could not handle log: %w", err)
+					}
+				}
 				concurrentCalls++
 				gS.Go(func() error {
 					// another goroutine is already storing this receipt
@@ -202,7 +297,7 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 					defer locker.Unlock()
 
 					// Check if the txHash has already been stored in the cache.
-					if _, ok := x.cache.Get(log.TxHash); ok {
+					if _, ok := x.cache.Get(newStoredTxKey(log)); ok {
 						return nil
 					}
 
@@ -232,7 +327,7 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 					// Only update last indexed if all logs from the last block have been processed to prevent premature
 					// updates of last indexed. Prevents having to lag a block behind on downstream dependencies (agents).
 					if lastBlockSeen < log.BlockNumber {
-						err = x.saveLastIndexed(storeCtx, lastBlockSeen)
+						err = x.saveLastIndexed(storeCtx, lastBlockSeen, rewinds)
 						if err != nil {
 							logger.ReportIndexerError(err, x.indexerConfig, logger.StoreError)
 							return fmt.Errorf("could not store last indexed: %w", err)
@@ -254,7 +349,7 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 		return fmt.Errorf("could not backfill contract: %w \nChain: %d\nLog 's Contract Address: %s\n ", err, x.indexerConfig.ChainID, x.indexerConfig.Addresses)
 	}
 
-	err = x.saveLastIndexed(ctx, endHeight)
+	err = x.saveLastIndexed(ctx, endHeight, rewinds)
 	if err != nil {
 		logger.ReportIndexerError(err, x.indexerConfig, logger.StoreError)
 		return fmt.Errorf("could not store last indexed: %w", err)
@@ -369,15 +464,44 @@ func (x *Indexer) store(parentCtx context.Context, log types.Log) (err error) {
 		if err != nil {
 			return fmt.Errorf("could not store receipt logs: %w", err)
 		}
+		if x.toHead || x.decoder == nil || !x.decoder.HasABIs() {
+			return nil
+		}
 
+		err = x.eventDB.StoreDecodedLogs(groupCtx, x.indexerConfig.ChainID, x.decodeLogs(logs)...)
+		if err != nil {
+			return fmt.Errorf("could not store decoded logs: %w", err)
+		}
 		return nil
 	})
 
+	if !x.toHead && x.isTraced(tx, hasTX) {
+		g.Go(func() error {
+			frames, err := x.fetchCallFrames(groupCtx, log)
+			if err != nil {
+				return err
+			}
+			err = x.eventDB.StoreCallFrames(groupCtx, x.indexerConfig.ChainID, frames...)
+			if err != nil {
+				return fmt.Errorf("could not store call frames: %w", err)
+			}
+			return nil
+		})
+	}
+
 	g.Go(func() error {
 		err := x.eventDB.StoreBlockTime(groupCtx, x.indexerConfig.ChainID, tx.blockHeader.Number.Uint64(), tx.blockHeader.Time)
 		if err != nil {
 			return fmt.Errorf("could not store receipt logs: %w", err)
 		}
+		if x.toHead {
+			return nil
+		}
+		// Track the hash of the block so reorgs can be detected.
+		err = x.eventDB.StoreBlockHash(groupCtx, x.indexerConfig.ChainID, log.BlockNumber, log.BlockHash)
+		if err != nil {
+			return fmt.Errorf("could not store block hash: %w", err)
+		}
 		return nil
 	})
 
@@ -386,17 +510,178 @@ func (x *Indexer) store(parentCtx context.Context, log types.Log) (err error) {
 		return fmt.Errorf("could not store data: %w\n%s on chain %d from %d to %s", err, x.addressesToString(x.indexerConfig.Addresses), x.indexerConfig.ChainID, log.BlockNumber, log.TxHash.String())
 	}
 
-	x.cache.Add(log.TxHash, true)
+	x.cache.Add(newStoredTxKey(log), true)
 	return nil
 }
 
-// prunedReceiptLogs gets all logs from a receipt and prunes null logs.
+// mergeTopics merges the topic filters of the given contracts into a single getLogs filter.
+// A position is only constrained if every contract constrains it, in which case it accepts the union of their values.
+func mergeTopics(contractTopics map[common.Address][][]common.Hash, addresses []common.Address) [][]common.Hash {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	var merged [][]common.Hash
+	for position := 0; position < scribeTypes.MaxTopics; position++ {
+		seen := make(map[common.Hash]bool)
+		var values []common.Hash
+		for _, address := range addresses {
+			topics := contractTopics[address]
+			if position >= len(topics) || len(topics[position]) == 0 {
+				values = nil
+				break
+			}
+			for _, topic := range topics[position] {
+				if !seen[topic] {
+					seen[topic] = true
+					values = append(values, topic)
+				}
+			}
+		}
+
+		merged = append(merged, values)
+	}
+
+	// Trailing wildcard positions are dropped.
+	for len(merged) > 0 && merged[len(merged)-1] == nil {
+		merged = merged[:len(merged)-1]
+	}
+	return merged
+}
+
+// decodeLogs decodes the logs whose contract ABIs are known. Logs that fail to decode are reported and skipped.
+func (x *Indexer) decodeLogs(logs []types.Log) (decodedLogs []db.DecodedLog) {
+	for _, log := range logs {
+		decodedLog, ok, err := x.decoder.Decode(log)
+		if err != nil {
+			logger.ReportIndexerError(fmt.Errorf("could not decode log %d of tx %s: %w", log.Index, log.TxHash, err), x.indexerConfig, logger.DecodeLogError)
+			continue
+		}
+		if ok {
+			decodedLogs = append(decodedLogs, decodedLog)
+		}
+	}
+	return decodedLogs
+}
+
+// isTraced checks if a transaction touches a contract with trace indexing enabled, either by calling it or through
+// one of its logs.
+func (x *Indexer) isTraced(tx *txData, hasTX bool) bool {
+	if len(x.traceContracts) == 0 {
+		return false
+	}
+	if hasTX && tx.transaction.To() != nil && x.traceContracts[*tx.transaction.To()] {
+		return true
+	}
+	for _, log := range tx.receipt.Logs {
+		if log != nil && x.traceContracts[log.Address] {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchCallFrames fetches the call trace of a log's transaction and flattens it into call frames.
+func (x *Indexer) fetchCallFrames(parentCtx context.Context, log types.Log) (frames []db.CallFrame, err error) {
+	ctx, span := x.handler.Tracer().Start(parentCtx, "fetchCallFrames", trace.WithAttributes(
+		attribute.String("tx", log.TxHash.Hex()),
+		attribute.String("block", fmt.Sprintf("%d", log.BlockNumber)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	b := &backoff.Backoff{
+		Factor: 2,
+		Jitter: true,
+		Min:    3 * time.Millisecond,
+		Max:    2 * time.Second,
+	}
+
+	timeout := time.Duration(0)
+	tryCount := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("context canceled while fetching call trace: %w", ctx.Err())
+		case <-time.After(timeout):
+			tryCount++
+
+			var callTrace debug.CallTrace
+			err = x.client[0].BatchWithContext(ctx, debug.CallTraceTx(log.TxHash, nil).Returns(&callTrace))
+			if err != nil {
+				if tryCount > retryTolerance {
+					return nil, fmt.Errorf("could not trace tx %s: %w", log.TxHash, err)
+				}
+
+				timeout = b.Duration()
+				continue
+			}
+
+			return flattenCallTrace(&callTrace, log.TxHash, log.BlockNumber, log.BlockHash, uint64(log.TxIndex)), nil
+		}
+	}
+}
+
+// flattenCallTrace flattens a call trace into its call frames in depth first order.
+func flattenCallTrace(callTrace *debug.CallTrace, txHash common.Hash, blockNumber uint64, blockHash common.Hash, txIndex uint64) (frames []db.CallFrame) {
+	var walk func(call *debug.CallTrace, traceAddress []uint64)
+	walk = func(call *debug.CallTrace, traceAddress []uint64) {
+		frames = append(frames, db.CallFrame{
+			TxHash:           txHash,
+			BlockNumber:      blockNumber,
+			BlockHash:        blockHash,
+			TransactionIndex: txIndex,
+			Index:            uint64(len(frames)),
+			TraceAddress:     traceAddress,
+			Type:             call.Type,
+			From:             call.From,
+			To:               call.To,
+			Value:            call.Value,
+			Gas:              call.Gas,
+			GasUsed:          call.GasUsed,
+			Input:            call.Input,
+			Output:           call.Output,
+			Error:            call.Error,
+		})
+
+		for i, child := range call.Calls {
+			if child == nil {
+				continue
+			}
+			childAddress := make([]uint64, len(traceAddress), len(traceAddress)+1)
+			copy(childAddress, traceAddress)
+			walk(child, append(childAddress, uint64(i)))
+		}
+	}
+
+	walk(callTrace, []uint64{})
+	return frames
+}
+
+// storedTxKey is the cache key for a stored tx.
+type storedTxKey struct {
+	txHash    common.Hash
+	blockHash common.Hash
+}
+
+func newStoredTxKey(log types.Log) storedTxKey {
+	return storedTxKey{txHash: log.TxHash, blockHash: log.BlockHash}
+}
+
+// prunedReceiptLogs gets all logs from a receipt and prunes null logs and logs that don't match their contract's
+// topic filter.
 func (x *Indexer) prunedReceiptLogs(receipt types.Receipt) (logs []types.Log, err error) {
 	for i := range receipt.Logs {
 		log := receipt.Logs[i]
 		if log == nil {
 			return nil, fmt.Errorf("log is nil\nChain: %d\nTxHash: %s\nLog BlockNumber: %d\nLog 's Contract Address: %s\nContract Address: %s", x.indexerConfig.ChainID, log.TxHash.String(), log.BlockNumber, log.Address.String(), x.addressesToString(x.indexerConfig.Addresses))
 		}
+		if !scribeTypes.MatchesTopics(x.contractTopics[log.Address], log.Topics) {
+			continue
+		}
 		logs = append(logs, *log)
 	}
 	return logs, nil
@@ -492,7 +777,7 @@ func (x *Indexer) addressesToString(addresses []common.Address) string {
 	return output
 }
 
-func (x *Indexer) saveLastIndexed(parentCtx context.Context, blockNumber uint64) error {
+func (x *Indexer) saveLastIndexed(parentCtx context.Context, blockNumber uint64, rewinds uint64) error {
 	if !x.isBackfill {
 		var err error
 		var errMessage string
@@ -500,13 +785,22 @@ func (x *Indexer) saveLastIndexed(parentCtx context.Context, blockNumber uint64)
 			err = x.eventDB.StoreLastIndexed(parentCtx, common.Address{}, x.indexerConfig.ChainID, blockNumber, scribeTypes.LivefillAtHead)
 			errMessage = "could not store last indexed block while livefilling at head"
 		} else {
-			err = x.eventDB.StoreLastIndexedMultiple(parentCtx, x.indexerConfig.Addresses, x.indexerConfig.ChainID, blockNumber)
+			err = x.reorgGuard.store(rewinds, func() error {
+				//nolint: wrapcheck
+				return x.eventDB.StoreLastIndexedMultiple(parentCtx, x.indexerConfig.Addresses, x.indexerConfig.ChainID, blockNumber)
+			})
 			errMessage = "could not store last indexed blocks"
 		}
+		if errors.Is(err, ErrRewound) {
+			return err
+		}
 		if err != nil {
 			logger.ReportIndexerError(err, x.indexerConfig, logger.StoreError)
 			return fmt.Errorf("%s: %w", errMessage, err)
 		}
+		if x.progressHandler != nil {
+			x.progressHandler(x.indexerConfig.Addresses, blockNumber)
+		}
 	}
 	return nil
 }
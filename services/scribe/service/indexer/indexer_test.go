@@ -3,19 +3,24 @@
 import (
 	"context"
 	"fmt"
+	"github.com/Flaque/filet"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/lmittmann/w3/module/debug"
 	. "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/synapsecns/sanguine/ethergo/backends/geth"
 	"github.com/synapsecns/sanguine/services/scribe/backend"
 	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/decoder"
 	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
 	"github.com/synapsecns/sanguine/services/scribe/testutil"
+	"github.com/synapsecns/sanguine/services/scribe/testutil/testcontract"
 	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
 	"os"
+	"path/filepath"
 	"time"
 
 	"sync"
@@ -48,6 +53,7 @@ func (x *IndexerSuite) TestFailedStore() {
 		Return(uint64(0), nil)
 
 	mockDB.On("StoreBlockTime", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockDB.On("StoreBlockHash", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	chainID := gofakeit.Uint32()
 
@@ -204,6 +210,260 @@ func (x *IndexerSuite) TestGetLogsSimulated() {
 	Equal(x.T(), 3, len(collectedLogs))
 }
 
+// TestContractBackfillWithTopics tests that only transactions with events matching a contract's topics are indexed.
+func (x *IndexerSuite) TestContractBackfillWithTopics() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(x.GetSuiteContext(), x.T(), big.NewInt(142))
+	simulatedClient, err := backend.DialBackend(x.GetTestContext(), simulatedChain.RPCAddress(), x.metrics)
+	Nil(x.T(), err)
+
+	simulatedChain.FundAccount(x.GetTestContext(), x.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := x.manager.GetTestContract(x.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(x.GetTestContext(), nil)
+
+	// Only index EventB.
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+		Topics:     [][]string{{"EventB(address,bytes,uint256,uint256)"}},
+	}
+	testABI, err := testcontract.TestContractMetaData.GetAbi()
+	Nil(x.T(), err)
+	eventBTopic := testABI.Events["EventB"].ID
+
+	chainConfig := config.ChainConfig{
+		ChainID:              142,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+	}
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()},
+		x.testDB, []backend.ScribeBackend{simulatedClient}, x.metrics, blockHeightMeter, false)
+	x.Require().NoError(err)
+
+	tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	tx, err = testRef.EmitEventB(transactOpts.TransactOpts, []byte{4}, big.NewInt(5), big.NewInt(6))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	tx, err = testRef.EmitEventAandB(transactOpts.TransactOpts, big.NewInt(7), big.NewInt(8), big.NewInt(9))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(x.GetTestContext(), simulatedChain, tx)
+	Nil(x.T(), err)
+
+	err = contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber)
+	Nil(x.T(), err)
+
+	// The EventA only transaction is skipped.
+	receipts, err := x.testDB.RetrieveReceiptsWithFilter(x.GetTestContext(), db.ReceiptFilter{}, 1)
+	Nil(x.T(), err)
+	Equal(x.T(), 2, len(receipts))
+
+	logs, err := x.testDB.RetrieveLogsWithFilter(x.GetTestContext(), db.LogFilter{Topics: [][]string{{eventBTopic.String()}}}, 1)
+	Nil(x.T(), err)
+	Equal(x.T(), 2, len(logs))
+	for _, log := range logs {
+		Equal(x.T(), eventBTopic, log.Topics[0])
+	}
+}
+
+// TestIndexRewound tests that an indexer doesn't store its last indexed block if the chain's last indexed blocks
+// were rewound while it was indexing.
+func (x *IndexerSuite) TestIndexRewound() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(x.GetSuiteContext(), x.T(), big.NewInt(143))
+	simulatedClient, err := backend.DialBackend(x.GetTestContext(), simulatedChain.RPCAddress(), x.metrics)
+	Nil(x.T(), err)
+
+	simulatedChain.FundAccount(x.GetTestContext(), x.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := x.manager.GetTestContract(x.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(x.GetTestContext(), nil)
+
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+	}
+	chainConfig := config.ChainConfig{
+		ChainID:              143,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+	}
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()},
+		x.testDB, []backend.ScribeBackend{simulatedClient}, x.metrics, blockHeightMeter, false)
+	x.Require().NoError(err)
+
+	// Rewind while the first log is being handled.
+	reorgGuard := indexer.NewReorgGuard()
+	contractIndexer.SetReorgGuard(reorgGuard)
+	contractIndexer.SetLogHandler(func(ctx context.Context, log types.Log) error {
+		return reorgGuard.Rewind(func() error {
+			return nil
+		})
+	})
+
+	tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(x.GetTestContext(), simulatedChain, tx)
+	Nil(x.T(), err)
+
+	err = contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber)
+	ErrorIs(x.T(), err, indexer.ErrRewound)
+
+	lastIndexed, err := x.testDB.RetrieveLastIndexed(x.GetTestContext(), testContract.Address(), uint32(testContract.ChainID().Uint64()), scribeTypes.IndexingConfirmed)
+	Nil(x.T(), err)
+	Equal(x.T(), uint64(0), lastIndexed)
+
+	// Indexing again from the rewound last indexed block stores it.
+	contractIndexer.SetLogHandler(nil)
+	err = contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber)
+	Nil(x.T(), err)
+
+	lastIndexed, err = x.testDB.RetrieveLastIndexed(x.GetTestContext(), testContract.Address(), uint32(testContract.ChainID().Uint64()), scribeTypes.IndexingConfirmed)
+	Nil(x.T(), err)
+	Equal(x.T(), txBlockNumber, lastIndexed)
+}
+
+// TestReceiptLogsWithTopics tests that logs from a transaction's receipt that don't match their contract's topic filter
+// aren't stored.
+func (x *IndexerSuite) TestReceiptLogsWithTopics() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(x.GetSuiteContext(), x.T(), big.NewInt(144))
+	simulatedClient, err := backend.DialBackend(x.GetTestContext(), simulatedChain.RPCAddress(), x.metrics)
+	Nil(x.T(), err)
+
+	simulatedChain.FundAccount(x.GetTestContext(), x.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := x.manager.GetTestContract(x.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(x.GetTestContext(), nil)
+
+	// Only index EventB.
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+		Topics:     [][]string{{"EventB(address,bytes,uint256,uint256)"}},
+	}
+	testABI, err := testcontract.TestContractMetaData.GetAbi()
+	Nil(x.T(), err)
+	eventBTopic := testABI.Events["EventB"].ID
+
+	chainConfig := config.ChainConfig{
+		ChainID:              144,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+	}
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()},
+		x.testDB, []backend.ScribeBackend{simulatedClient}, x.metrics, blockHeightMeter, false)
+	x.Require().NoError(err)
+
+	// The transaction emits both EventA and EventB.
+	tx, err := testRef.EmitEventAandB(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(x.GetTestContext(), simulatedChain, tx)
+	Nil(x.T(), err)
+
+	err = contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber)
+	Nil(x.T(), err)
+
+	logs, err := x.testDB.RetrieveLogsWithFilter(x.GetTestContext(), db.LogFilter{ChainID: chainConfig.ChainID, TxHash: tx.Hash().String()}, 1)
+	Nil(x.T(), err)
+	Equal(x.T(), 1, len(logs))
+	Equal(x.T(), eventBTopic, logs[0].Topics[0])
+}
+
+// TestContractBackfillDecoded tests that logs are decoded with the contract's ABI when they are stored.
+func (x *IndexerSuite) TestContractBackfillDecoded() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(x.GetSuiteContext(), x.T(), big.NewInt(142))
+	simulatedClient, err := backend.DialBackend(x.GetTestContext(), simulatedChain.RPCAddress(), x.metrics)
+	Nil(x.T(), err)
+
+	simulatedChain.FundAccount(x.GetTestContext(), x.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := x.manager.GetTestContract(x.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(x.GetTestContext(), nil)
+
+	abiPath := filepath.Join(filet.TmpDir(x.T(), ""), "TestContract.json")
+	Nil(x.T(), os.WriteFile(abiPath, []byte(testcontract.TestContractMetaData.ABI), 0600))
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+		ABI:        abiPath,
+	}
+	chainConfig := config.ChainConfig{
+		ChainID:              142,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+	}
+	logDecoder, err := decoder.NewDecoder(chainConfig)
+	Nil(x.T(), err)
+
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()},
+		x.testDB, []backend.ScribeBackend{simulatedClient}, x.metrics, blockHeightMeter, false)
+	x.Require().NoError(err)
+	contractIndexer.SetDecoder(logDecoder)
+
+	tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	tx, err = testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(4), big.NewInt(5), big.NewInt(6))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	tx, err = testRef.EmitEventB(transactOpts.TransactOpts, []byte{7}, big.NewInt(8), big.NewInt(9))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(x.GetTestContext(), simulatedChain, tx)
+	Nil(x.T(), err)
+
+	err = contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber)
+	Nil(x.T(), err)
+
+	decodedLogs, err := x.testDB.RetrieveDecodedLogsWithFilter(x.GetTestContext(), db.DecodedLogFilter{ChainID: chainConfig.ChainID, EventName: "EventA"}, 1)
+	Nil(x.T(), err)
+	Equal(x.T(), 2, len(decodedLogs))
+
+	// Filter by an indexed and a non indexed argument.
+	decodedLogs, err = x.testDB.RetrieveDecodedLogsWithFilter(x.GetTestContext(), db.DecodedLogFilter{
+		ChainID:   chainConfig.ChainID,
+		EventName: "EventA",
+		Args:      map[string]string{"valueA": "4", "valueC": "6"},
+	}, 1)
+	Nil(x.T(), err)
+	Equal(x.T(), 1, len(decodedLogs))
+	Equal(x.T(), "5", decodedLogs[0].Args["valueB"])
+	Equal(x.T(), testContract.Address(), decodedLogs[0].Log.Address)
+
+	decodedLogs, err = x.testDB.RetrieveDecodedLogsWithFilter(x.GetTestContext(), db.DecodedLogFilter{ChainID: chainConfig.ChainID, EventName: "EventB"}, 1)
+	Nil(x.T(), err)
+	Equal(x.T(), 1, len(decodedLogs))
+	Equal(x.T(), "0x07", decodedLogs[0].Args["valueA"])
+	Equal(x.T(), tx.Hash(), decodedLogs[0].Log.TxHash)
+}
+
 // TestContractBackfill tests using a contractBackfiller for recording receipts and logs in a database.
 func (x *IndexerSuite) TestContractBackfill() {
 	// Get simulated blockchain, deploy the test contract, and set up test variables.
@@ -615,3 +875,87 @@ func (x *IndexerSuite) TestLargeVolumeIndexer() {
 	logs, err := testutil.GetLogsUntilNoneLeft(x.GetTestContext(), x.testDB, db.LogFilter{})
 	Equal(x.T(), int(testChainHandler.EventsEmitted[contractAddress]), len(logs))
 }
+
+// TestContractBackfillTraces tests that the call traces of transactions touching a traced contract are indexed.
+func (x *IndexerSuite) TestContractBackfillTraces() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(x.GetSuiteContext(), x.T(), big.NewInt(142))
+	simulatedClient, err := backend.DialBackend(x.GetTestContext(), simulatedChain.RPCAddress(), x.metrics)
+	Nil(x.T(), err)
+
+	simulatedChain.FundAccount(x.GetTestContext(), x.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := x.manager.GetTestContract(x.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(x.GetTestContext(), nil)
+
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+		Traces:     true,
+	}
+	chainConfig := config.ChainConfig{
+		ChainID:              142,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+	}
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()},
+		x.testDB, []backend.ScribeBackend{simulatedClient}, x.metrics, blockHeightMeter, false)
+	x.Require().NoError(err)
+
+	tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(x.GetTestContext(), simulatedChain, tx)
+	Nil(x.T(), err)
+
+	err = contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber)
+	Nil(x.T(), err)
+
+	frames, err := x.testDB.RetrieveCallFramesWithFilter(x.GetTestContext(), db.CallFrameFilter{ChainID: chainConfig.ChainID, TxHash: tx.Hash().String()}, 1)
+	Nil(x.T(), err)
+	x.Require().Equal(1, len(frames))
+	Equal(x.T(), "CALL", frames[0].Type)
+	Equal(x.T(), transactOpts.TransactOpts.From, frames[0].From)
+	Equal(x.T(), testContract.Address(), frames[0].To)
+	Equal(x.T(), tx.Data(), frames[0].Input)
+	Equal(x.T(), txBlockNumber, frames[0].BlockNumber)
+	Equal(x.T(), 0, frames[0].Depth())
+}
+
+// TestFlattenCallTrace tests that nested calls are flattened depth first with their trace addresses.
+func (x *IndexerSuite) TestFlattenCallTrace() {
+	router := common.BigToAddress(big.NewInt(1))
+	token := common.BigToAddress(big.NewInt(2))
+	recipient := common.BigToAddress(big.NewInt(3))
+
+	callTrace := &debug.CallTrace{
+		Type: "CALL", From: x.wallet.Address(), To: router, Value: big.NewInt(10),
+		Calls: []*debug.CallTrace{
+			{
+				Type: "CALL", From: router, To: token,
+				Calls: []*debug.CallTrace{{Type: "STATICCALL", From: token, To: recipient}},
+			},
+			{Type: "CALL", From: router, To: recipient, Value: big.NewInt(10), Error: "execution reverted"},
+		},
+	}
+
+	txHash := common.BigToHash(big.NewInt(4))
+	frames := indexer.FlattenCallTrace(callTrace, txHash, 5, common.BigToHash(big.NewInt(6)), 7)
+	x.Require().Equal(4, len(frames))
+
+	traceAddresses := make([][]uint64, len(frames))
+	for i, frame := range frames {
+		Equal(x.T(), uint64(i), frame.Index)
+		Equal(x.T(), txHash, frame.TxHash)
+		Equal(x.T(), uint64(7), frame.TransactionIndex)
+		traceAddresses[i] = frame.TraceAddress
+	}
+	Equal(x.T(), [][]uint64{{}, {0}, {0, 0}, {1}}, traceAddresses)
+	Equal(x.T(), "STATICCALL", frames[2].Type)
+	Equal(x.T(), recipient, frames[3].To)
+	Equal(x.T(), "execution reverted", frames[3].Error)
+}
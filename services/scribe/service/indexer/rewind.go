@@ -0,0 +1,66 @@
+package indexer
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrRewound is returned by Index if the last indexed blocks were rewound while indexing. The range should be
+// indexed again from the rewound last indexed block.
+var ErrRewound = errors.New("last indexed blocks were rewound while indexing")
+
+// ReorgGuard keeps indexers from storing a last indexed block past a rewind. Reorg handling rewinds the last indexed
+// block of every contract on a chain, so an indexer that started before the rewind could otherwise move its contract's
+// last indexed block past the orphaned range, which would then never be re-indexed.
+type ReorgGuard struct {
+	// mux is held for writing while rewinding and for reading while storing last indexed blocks.
+	mux sync.RWMutex
+	// rewinds is the number of rewinds so far.
+	rewinds uint64
+}
+
+// NewReorgGuard creates a reorg guard for a chain.
+func NewReorgGuard() *ReorgGuard {
+	return &ReorgGuard{}
+}
+
+// Rewind runs rewind once no indexer is storing a last indexed block. Indexers that started before a successful
+// rewind can't store their last indexed block.
+func (r *ReorgGuard) Rewind(rewind func() error) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	err := rewind()
+	if err != nil {
+		return err
+	}
+	r.rewinds++
+	return nil
+}
+
+// rewindCount gets the number of rewinds so far. This is 0 for a nil guard.
+func (r *ReorgGuard) rewindCount() uint64 {
+	if r == nil {
+		return 0
+	}
+
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.rewinds
+}
+
+// store runs store unless there has been a rewind since rewinds were counted, in which case ErrRewound is returned.
+// store is always run for a nil guard.
+func (r *ReorgGuard) store(rewinds uint64, store func() error) error {
+	if r == nil {
+		return store()
+	}
+
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	if r.rewinds != rewinds {
+		return ErrRewound
+	}
+	return store()
+}
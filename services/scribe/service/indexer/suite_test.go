@@ -10,6 +10,8 @@
 	"github.com/synapsecns/sanguine/services/scribe/metadata"
 
 	"github.com/Flaque/filet"
+	// register the callTracer so the embedded backends can serve debug_traceTransaction.
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 	. "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/core/testsuite"
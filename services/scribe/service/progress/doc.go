@@ -0,0 +1,2 @@
+// Package progress tracks how far each contract has been indexed, how fast it is being indexed and when it will catch up.
+package progress
@@ -0,0 +1,8 @@
+package progress
+
+import "time"
+
+// SetNow sets the function the tracker gets the current time with.
+func (t *Tracker) SetNow(now func() time.Time) {
+	t.now = now
+}
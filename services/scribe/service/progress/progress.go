@@ -0,0 +1,363 @@
+package progress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Windows are the recent windows indexing rates are reported over.
+var Windows = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute}
+
+// etaWindow is the window whose indexing rate is used to estimate when a contract reaches its target block.
+const etaWindow = 5 * time.Minute
+
+// sampleInterval is the minimum time between samples of a contract's block, so frequent updates don't grow its history.
+const sampleInterval = time.Second
+
+// meterName is the name of the meter progress metrics are reported with.
+const meterName = "github.com/synapsecns/sanguine/services/scribe/service/progress"
+
+// sample is the block a contract was indexed to at a point in time.
+type sample struct {
+	at    time.Time
+	block uint64
+}
+
+// contractProgress is the progress of a single contract.
+type contractProgress struct {
+	mode db.IndexingMode
+	// endBlock is the configured end block of a backfill, 0 if the contract is indexed to the head.
+	endBlock       uint64
+	currentBlock   uint64
+	lastProgressAt time.Time
+	samples        []sample
+	lastError      string
+	lastErrorAt    *time.Time
+}
+
+// chainProgress is the progress of the contracts on a chain.
+type chainProgress struct {
+	head      uint64
+	contracts map[common.Address]*contractProgress
+}
+
+// Tracker tracks the indexing progress of contracts and reports it as metrics. A nil tracker ignores updates.
+type Tracker struct {
+	mux    sync.Mutex
+	chains map[uint32]*chainProgress
+	// now gets the current time.
+	now func() time.Time
+}
+
+// NewTracker creates a new tracker that reports progress through the metrics handler.
+func NewTracker(handler metrics.Handler) (*Tracker, error) {
+	t := &Tracker{
+		chains: make(map[uint32]*chainProgress),
+		now:    time.Now,
+	}
+
+	err := t.registerMetrics(handler.Meter(meterName))
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// SetMode sets how a contract is being indexed. The end block is the block a backfill stops at, 0 if the contract
+// is indexed to the head.
+func (t *Tracker) SetMode(chainID uint32, address common.Address, mode db.IndexingMode, endBlock uint64) {
+	if t == nil {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	contract := t.getContract(chainID, address)
+	contract.mode = mode
+	contract.endBlock = endBlock
+}
+
+// RecordBlock records the block contracts have been indexed to. Contracts without a mode set are ignored.
+func (t *Tracker) RecordBlock(chainID uint32, addresses []common.Address, block uint64) {
+	if t == nil || block == 0 {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	now := t.now()
+	for _, address := range addresses {
+		contract, ok := t.findContract(chainID, address)
+		if !ok || block == contract.currentBlock {
+			continue
+		}
+
+		// Rates are measured from scratch after a rewind, e.g. for a reorg.
+		if block < contract.currentBlock {
+			contract.samples = nil
+		}
+		contract.currentBlock = block
+		contract.lastProgressAt = now
+
+		if len(contract.samples) > 0 && now.Sub(contract.samples[len(contract.samples)-1].at) < sampleInterval {
+			contract.samples[len(contract.samples)-1].block = block
+		} else {
+			contract.samples = append(contract.samples, sample{at: now, block: block})
+		}
+		contract.samples = pruneSamples(contract.samples, now)
+	}
+}
+
+// RecordHead records the latest confirmed block of a chain.
+func (t *Tracker) RecordHead(chainID uint32, head uint64) {
+	if t == nil {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.getChain(chainID).head = head
+}
+
+// RecordError records an error encountered while indexing contracts. Contracts without a mode set are ignored.
+func (t *Tracker) RecordError(chainID uint32, addresses []common.Address, err error) {
+	if t == nil || err == nil {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	now := t.now()
+	for _, address := range addresses {
+		contract, ok := t.findContract(chainID, address)
+		if !ok {
+			continue
+		}
+		contract.lastError = err.Error()
+		contract.lastErrorAt = &now
+	}
+}
+
+// Remove stops tracking a contract.
+func (t *Tracker) Remove(chainID uint32, address common.Address) {
+	if t == nil {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if chain, ok := t.chains[chainID]; ok {
+		delete(chain.contracts, address)
+	}
+}
+
+// RemoveChain stops tracking the contracts of a chain.
+func (t *Tracker) RemoveChain(chainID uint32) {
+	if t == nil {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	delete(t.chains, chainID)
+}
+
+// Progress gets the progress of every tracked contract, ordered by chain and contract address.
+func (t *Tracker) Progress() []db.IndexerProgress {
+	if t == nil {
+		return nil
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	now := t.now()
+	var progress []db.IndexerProgress
+	for chainID, chain := range t.chains {
+		for address, contract := range chain.contracts {
+			progress = append(progress, contract.progress(chainID, address, chain.head, now))
+		}
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		if progress[i].ChainID != progress[j].ChainID {
+			return progress[i].ChainID < progress[j].ChainID
+		}
+		return progress[i].ContractAddress.Hex() < progress[j].ContractAddress.Hex()
+	})
+	return progress
+}
+
+// getChain gets the progress of a chain, creating it if it doesn't exist. The lock must be held.
+func (t *Tracker) getChain(chainID uint32) *chainProgress {
+	chain, ok := t.chains[chainID]
+	if !ok {
+		chain = &chainProgress{contracts: make(map[common.Address]*contractProgress)}
+		t.chains[chainID] = chain
+	}
+	return chain
+}
+
+// findContract gets the progress of a contract if it is tracked. The lock must be held.
+func (t *Tracker) findContract(chainID uint32, address common.Address) (*contractProgress, bool) {
+	chain, ok := t.chains[chainID]
+	if !ok {
+		return nil, false
+	}
+	contract, ok := chain.contracts[address]
+	return contract, ok
+}
+
+// getContract gets the progress of a contract, creating it if it doesn't exist. The lock must be held.
+func (t *Tracker) getContract(chainID uint32, address common.Address) *contractProgress {
+	chain := t.getChain(chainID)
+	contract, ok := chain.contracts[address]
+	if !ok {
+		contract = &contractProgress{}
+		chain.contracts[address] = contract
+	}
+	return contract
+}
+
+// progress gets the progress of the contract at a point in time.
+func (c *contractProgress) progress(chainID uint32, address common.Address, head uint64, now time.Time) db.IndexerProgress {
+	targetBlock := head
+	if c.endBlock != 0 {
+		targetBlock = c.endBlock
+	}
+
+	progress := db.IndexerProgress{
+		ChainID:         chainID,
+		ContractAddress: address,
+		Mode:            c.mode,
+		CurrentBlock:    c.currentBlock,
+		TargetBlock:     targetBlock,
+		HeadBlock:       head,
+		LastError:       c.lastError,
+		LastErrorAt:     c.lastErrorAt,
+		LastProgressAt:  c.lastProgressAt,
+		UpdatedAt:       now,
+	}
+
+	var etaRate float64
+	for _, window := range Windows {
+		rate := c.rate(window, now)
+		progress.Rates = append(progress.Rates, db.IndexingRate{Window: window, BlocksPerSecond: rate})
+		if window == etaWindow {
+			etaRate = rate
+		}
+	}
+
+	switch {
+	case c.currentBlock >= targetBlock:
+		eta := time.Duration(0)
+		progress.ETA = &eta
+	case etaRate > 0:
+		eta := time.Duration(float64(targetBlock-c.currentBlock) / etaRate * float64(time.Second))
+		progress.ETA = &eta
+	}
+
+	return progress
+}
+
+// rate gets the number of blocks indexed per second over a window. It is measured from the last sample taken before
+// the window started, or the first sample if there is none, so stalls lower the rate over time.
+func (c *contractProgress) rate(window time.Duration, now time.Time) float64 {
+	if len(c.samples) == 0 {
+		return 0
+	}
+
+	baseline := c.samples[0]
+	for _, s := range c.samples {
+		if s.at.After(now.Add(-window)) {
+			break
+		}
+		baseline = s
+	}
+
+	elapsed := now.Sub(baseline.at).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(c.currentBlock-baseline.block) / elapsed
+}
+
+// pruneSamples drops the samples that are no longer needed to measure rates over the longest window.
+func pruneSamples(samples []sample, now time.Time) []sample {
+	cutoff := now.Add(-Windows[len(Windows)-1])
+	// Keep the last sample before the cutoff as the baseline of the longest window.
+	first := 0
+	for i := range samples {
+		if samples[i].at.After(cutoff) {
+			break
+		}
+		first = i
+	}
+	return samples[first:]
+}
+
+// registerMetrics registers gauges that report the progress of every tracked contract.
+func (t *Tracker) registerMetrics(meter metric.Meter) error {
+	currentBlockGauge, err := meter.Int64ObservableGauge("scribe_indexer_current_block", metric.WithDescription("the last block indexed"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	targetBlockGauge, err := meter.Int64ObservableGauge("scribe_indexer_target_block", metric.WithDescription("the block being indexed to"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	lagGauge, err := meter.Int64ObservableGauge("scribe_indexer_lag", metric.WithDescription("the number of blocks behind the confirmed head"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	rateGauge, err := meter.Float64ObservableGauge("scribe_indexer_blocks_per_second", metric.WithDescription("the number of blocks indexed per second over a recent window"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	etaGauge, err := meter.Int64ObservableGauge("scribe_indexer_eta_seconds", metric.WithDescription("the estimated number of seconds to reach the target block"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	stallGauge, err := meter.Int64ObservableGauge("scribe_indexer_seconds_since_progress", metric.WithDescription("the number of seconds since the last indexed block advanced"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, progress := range t.Progress() {
+			attributes := attribute.NewSet(
+				attribute.Int(metrics.ChainID, int(progress.ChainID)),
+				attribute.String(metrics.ContractAddress, progress.ContractAddress.String()),
+				attribute.String("mode", string(progress.Mode)),
+			)
+
+			o.ObserveInt64(currentBlockGauge, int64(progress.CurrentBlock), metric.WithAttributeSet(attributes))
+			o.ObserveInt64(targetBlockGauge, int64(progress.TargetBlock), metric.WithAttributeSet(attributes))
+			o.ObserveInt64(lagGauge, int64(progress.Lag()), metric.WithAttributeSet(attributes))
+			if progress.ETA != nil {
+				o.ObserveInt64(etaGauge, int64(progress.ETA.Seconds()), metric.WithAttributeSet(attributes))
+			}
+			if !progress.LastProgressAt.IsZero() {
+				o.ObserveInt64(stallGauge, int64(progress.UpdatedAt.Sub(progress.LastProgressAt).Seconds()), metric.WithAttributeSet(attributes))
+			}
+			for _, rate := range progress.Rates {
+				rateAttributes := attribute.NewSet(append(attributes.ToSlice(), attribute.String("window", rate.Window.String()))...)
+				o.ObserveFloat64(rateGauge, rate.BlocksPerSecond, metric.WithAttributeSet(rateAttributes))
+			}
+		}
+		return nil
+	}, currentBlockGauge, targetBlockGauge, lagGauge, rateGauge, etaGauge, stallGauge)
+	if err != nil {
+		return fmt.Errorf("could not register progress callback: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package progress_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service/progress"
+)
+
+const chainID = 1
+
+// newTracker creates a tracker whose clock is advanced by the returned function.
+func newTracker(t *testing.T) (*progress.Tracker, func(time.Duration)) {
+	t.Helper()
+	tracker, err := progress.NewTracker(metrics.NewNullHandler())
+	Nil(t, err)
+
+	now := time.Unix(1_000_000, 0)
+	tracker.SetNow(func() time.Time {
+		return now
+	})
+	return tracker, func(d time.Duration) {
+		now = now.Add(d)
+	}
+}
+
+func TestBackfillProgress(t *testing.T) {
+	tracker, advance := newTracker(t)
+	address := common.BigToAddress(big.NewInt(1))
+	addresses := []common.Address{address}
+
+	tracker.SetMode(chainID, address, db.BackfillMode, 0)
+	tracker.RecordHead(chainID, 10_000)
+	tracker.RecordBlock(chainID, addresses, 1000)
+
+	// Index 10 blocks a second for 10 minutes.
+	for i := 1; i <= 600; i++ {
+		advance(time.Second)
+		tracker.RecordBlock(chainID, addresses, uint64(1000+10*i))
+	}
+
+	statuses := tracker.Progress()
+	Equal(t, 1, len(statuses))
+	status := statuses[0]
+	Equal(t, db.BackfillMode, status.Mode)
+	Equal(t, uint64(7000), status.CurrentBlock)
+	Equal(t, uint64(10_000), status.TargetBlock)
+	Equal(t, uint64(3000), status.Lag())
+
+	Equal(t, len(progress.Windows), len(status.Rates))
+	for _, rate := range status.Rates[:2] {
+		InDelta(t, 10, rate.BlocksPerSecond, 0.01)
+	}
+	// Less than 15 minutes of history is measured from the first sample.
+	InDelta(t, 10, status.Rates[2].BlocksPerSecond, 0.01)
+
+	NotNil(t, status.ETA)
+	InDelta(t, (300 * time.Second).Seconds(), status.ETA.Seconds(), 1)
+
+	// Stalls lower the rate over time.
+	advance(time.Minute)
+	status = tracker.Progress()[0]
+	InDelta(t, 0, status.Rates[0].BlocksPerSecond, 0.01)
+	InDelta(t, 8, status.Rates[1].BlocksPerSecond, 0.01)
+	Equal(t, time.Minute, status.UpdatedAt.Sub(status.LastProgressAt))
+}
+
+func TestBackfillToEndBlock(t *testing.T) {
+	tracker, advance := newTracker(t)
+	address := common.BigToAddress(big.NewInt(1))
+
+	tracker.SetMode(chainID, address, db.BackfillMode, 500)
+	tracker.RecordHead(chainID, 10_000)
+
+	status := tracker.Progress()[0]
+	Equal(t, uint64(500), status.TargetBlock)
+	Nil(t, status.ETA)
+
+	advance(time.Second)
+	tracker.RecordBlock(chainID, []common.Address{address}, 500)
+	status = tracker.Progress()[0]
+	NotNil(t, status.ETA)
+	Equal(t, time.Duration(0), *status.ETA)
+}
+
+func TestRewind(t *testing.T) {
+	tracker, advance := newTracker(t)
+	address := common.BigToAddress(big.NewInt(1))
+	addresses := []common.Address{address}
+
+	tracker.SetMode(chainID, address, db.LivefillMode, 0)
+	tracker.RecordBlock(chainID, addresses, 1000)
+	advance(10 * time.Second)
+	tracker.RecordBlock(chainID, addresses, 1100)
+
+	// Rewinding, e.g. after a reorg, restarts rate measurement.
+	advance(time.Second)
+	tracker.RecordBlock(chainID, addresses, 900)
+	status := tracker.Progress()[0]
+	Equal(t, uint64(900), status.CurrentBlock)
+	for _, rate := range status.Rates {
+		Zero(t, rate.BlocksPerSecond)
+	}
+}
+
+func TestErrorsAndRemoval(t *testing.T) {
+	tracker, _ := newTracker(t)
+	addressA := common.BigToAddress(big.NewInt(1))
+	addressB := common.BigToAddress(big.NewInt(2))
+	untracked := common.BigToAddress(big.NewInt(3))
+
+	tracker.SetMode(chainID, addressA, db.LivefillMode, 0)
+	tracker.SetMode(chainID, addressB, db.LivefillMode, 0)
+	tracker.SetMode(chainID+1, addressA, db.BackfillMode, 0)
+
+	tracker.RecordError(chainID, []common.Address{addressA, untracked}, errors.New("could not get logs"))
+	// Untracked contracts are ignored.
+	tracker.RecordBlock(chainID, []common.Address{untracked}, 100)
+
+	statuses := tracker.Progress()
+	Equal(t, 3, len(statuses))
+	Equal(t, "could not get logs", statuses[0].LastError)
+	NotNil(t, statuses[0].LastErrorAt)
+	Empty(t, statuses[1].LastError)
+	Nil(t, statuses[1].LastErrorAt)
+
+	tracker.Remove(chainID, addressA)
+	tracker.RemoveChain(chainID + 1)
+	statuses = tracker.Progress()
+	Equal(t, 1, len(statuses))
+	Equal(t, addressB, statuses[0].ContractAddress)
+}
+
+func TestNilTracker(t *testing.T) {
+	var tracker *progress.Tracker
+	NotPanics(t, func() {
+		tracker.SetMode(chainID, common.Address{}, db.LivefillMode, 0)
+		tracker.RecordBlock(chainID, []common.Address{{}}, 1)
+		tracker.RecordHead(chainID, 1)
+		tracker.RecordError(chainID, []common.Address{{}}, errors.New("error"))
+		tracker.Remove(chainID, common.Address{})
+		tracker.RemoveChain(chainID)
+	})
+	Nil(t, tracker.Progress())
+}
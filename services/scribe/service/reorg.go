@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/logger"
+)
+
+// defaultReorgCheckBatchSize is the number of block headers fetched per batch request when checking for reorgs.
+const defaultReorgCheckBatchSize = 100
+
+// checkReorgs verifies the stored hashes of the last ReorgCheckDepth indexed blocks against the chain.
+// If any of them were orphaned, all data indexed from them is removed and the last indexed block of every
+// affected contract is rewound so the range is re-indexed from the canonical chain.
+func (c *ChainIndexer) checkReorgs(ctx context.Context) error {
+	lastIndexedMap, err := c.eventDB.RetrieveLastIndexedMultiple(ctx, getAddressesFromConfig(c.getChainConfig().Contracts), c.chainID)
+	if err != nil {
+		return fmt.Errorf("could not get last indexed map: %w", err)
+	}
+
+	var lastIndexed uint64
+	for _, blockNumber := range lastIndexedMap {
+		if blockNumber > lastIndexed {
+			lastIndexed = blockNumber
+		}
+	}
+	if lastIndexed == 0 {
+		return nil
+	}
+
+	startBlock := uint64(0)
+	if lastIndexed > c.chainConfig.ReorgCheckDepth {
+		startBlock = lastIndexed - c.chainConfig.ReorgCheckDepth
+	}
+
+	storedHashes, err := c.eventDB.RetrieveBlockHashesInRange(ctx, c.chainID, startBlock, lastIndexed)
+	if err != nil {
+		return fmt.Errorf("could not get stored block hashes: %w", err)
+	}
+	if len(storedHashes) == 0 {
+		return nil
+	}
+
+	orphanedHashes, firstOrphaned, err := c.getOrphanedBlocks(ctx, storedHashes, startBlock, lastIndexed)
+	if err != nil {
+		return err
+	}
+	if len(orphanedHashes) == 0 {
+		return nil
+	}
+
+	logger.ReportScribeState(c.chainID, firstOrphaned, nil, logger.ReorgDetected)
+	err = c.reorgGuard.Rewind(func() error {
+		//nolint: wrapcheck
+		return c.eventDB.RemoveOrphanedBlocks(ctx, c.chainID, firstOrphaned, orphanedHashes)
+	})
+	if err != nil {
+		return fmt.Errorf("could not remove orphaned blocks: %w", err)
+	}
+
+	return nil
+}
+
+// getOrphanedBlocks compares stored block hashes in a range against the canonical chain, returning the hashes of
+// the orphaned blocks and the lowest orphaned block number.
+func (c *ChainIndexer) getOrphanedBlocks(ctx context.Context, storedHashes map[uint64]common.Hash, startBlock, endBlock uint64) ([]common.Hash, uint64, error) {
+	batchSize := uint64(defaultReorgCheckBatchSize)
+	if c.chainConfig.GetBlockBatchAmount > 0 {
+		batchSize = uint64(c.chainConfig.GetBlockBatchAmount)
+	}
+
+	var orphanedHashes []common.Hash
+	var firstOrphaned uint64
+	for chunkStart := startBlock; chunkStart <= endBlock; chunkStart += batchSize {
+		chunkEnd := chunkStart + batchSize - 1
+		if chunkEnd > endBlock {
+			chunkEnd = endBlock
+		}
+
+		if !hasBlockInRange(storedHashes, chunkStart, chunkEnd) {
+			continue
+		}
+
+		canonicalHashes, err := backend.BlockHashesInRange(ctx, c.client[0], chunkStart, chunkEnd)
+		if err != nil {
+			return nil, 0, fmt.Errorf("could not get canonical block hashes: %w", err)
+		}
+
+		for blockNumber := chunkStart; blockNumber <= chunkEnd; blockNumber++ {
+			storedHash, ok := storedHashes[blockNumber]
+			if !ok {
+				continue
+			}
+			canonicalHash, ok := canonicalHashes.Get(blockNumber)
+			if ok && common.HexToHash(canonicalHash) == storedHash {
+				continue
+			}
+
+			if len(orphanedHashes) == 0 {
+				firstOrphaned = blockNumber
+			}
+			orphanedHashes = append(orphanedHashes, storedHash)
+		}
+	}
+
+	return orphanedHashes, firstOrphaned, nil
+}
+
+func hasBlockInRange(blockHashes map[uint64]common.Hash, startBlock, endBlock uint64) bool {
+	for blockNumber := range blockHashes {
+		if blockNumber >= startBlock && blockNumber <= endBlock {
+			return true
+		}
+	}
+	return false
+}
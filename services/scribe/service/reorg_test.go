@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/backends/geth"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service"
+)
+
+// TestCheckReorgs tests that data indexed from orphaned blocks is removed and re-indexed.
+func (s *ScribeSuite) TestCheckReorgs() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(s.GetSuiteContext(), s.T(), big.NewInt(145))
+	simulatedClient, err := backend.DialBackend(s.GetTestContext(), simulatedChain.RPCAddress(), s.nullMetrics)
+	Nil(s.T(), err)
+
+	// Mine a few blocks.
+	for i := 0; i < 3; i++ {
+		simulatedChain.FundAccount(s.GetTestContext(), common.BigToAddress(big.NewInt(gofakeit.Int64())), *big.NewInt(params.Ether))
+	}
+	head, err := simulatedClient.BlockNumber(s.GetTestContext())
+	Nil(s.T(), err)
+	canonicalHashes, err := backend.BlockHashesInRange(s.GetTestContext(), simulatedClient, head-1, head)
+	Nil(s.T(), err)
+	canonicalHash, ok := canonicalHashes.Get(head - 1)
+	True(s.T(), ok)
+
+	contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	chainConfig := config.ChainConfig{
+		ChainID:         145,
+		ReorgCheckDepth: 10,
+		Contracts:       []config.ContractConfig{{Address: contractAddress.String()}},
+	}
+	chainIndexer, err := service.NewChainIndexer(s.testDB, []backend.ScribeBackend{simulatedClient}, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+
+	// Index a log from the canonical block and one from a block that has since been orphaned.
+	canonicalLog := s.buildReorgLog(contractAddress, head-1, common.HexToHash(canonicalHash))
+	orphanedLog := s.buildReorgLog(contractAddress, head, common.BigToHash(big.NewInt(gofakeit.Int64())))
+	Nil(s.T(), s.testDB.StoreBlockHash(s.GetTestContext(), chainConfig.ChainID, canonicalLog.BlockNumber, canonicalLog.BlockHash))
+	Nil(s.T(), s.testDB.StoreBlockHash(s.GetTestContext(), chainConfig.ChainID, orphanedLog.BlockNumber, orphanedLog.BlockHash))
+	Nil(s.T(), s.testDB.StoreLogs(s.GetTestContext(), chainConfig.ChainID, canonicalLog, orphanedLog))
+	Nil(s.T(), s.testDB.StoreLastIndexed(s.GetTestContext(), contractAddress, chainConfig.ChainID, head, false))
+
+	Nil(s.T(), chainIndexer.CheckReorgs(s.GetTestContext()))
+
+	logFilter := db.LogFilter{ChainID: chainConfig.ChainID, ContractAddress: contractAddress.String()}
+	logs, err := s.testDB.RetrieveLogsWithFilter(s.GetTestContext(), logFilter, 1)
+	Nil(s.T(), err)
+	Equal(s.T(), 1, len(logs))
+	Equal(s.T(), canonicalLog.BlockHash, logs[0].BlockHash)
+
+	removedLogs, err := s.testDB.RetrieveRemovedLogs(s.GetTestContext(), logFilter, 0, 1)
+	Nil(s.T(), err)
+	Equal(s.T(), 1, len(removedLogs))
+	Equal(s.T(), orphanedLog.BlockHash, removedLogs[0].Log.BlockHash)
+
+	// The orphaned block is re-indexed from the canonical chain.
+	lastIndexed, err := s.testDB.RetrieveLastIndexed(s.GetTestContext(), contractAddress, chainConfig.ChainID, false)
+	Nil(s.T(), err)
+	Equal(s.T(), head-1, lastIndexed)
+}
+
+func (s *ScribeSuite) buildReorgLog(contractAddress common.Address, blockNumber uint64, blockHash common.Hash) types.Log {
+	return types.Log{
+		Address:     contractAddress,
+		Topics:      []common.Hash{common.BigToHash(big.NewInt(gofakeit.Int64()))},
+		Data:        []byte(gofakeit.Sentence(10)),
+		BlockNumber: blockNumber,
+		TxHash:      common.BigToHash(big.NewInt(gofakeit.Int64())),
+		BlockHash:   blockHash,
+	}
+}
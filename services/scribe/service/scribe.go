@@ -3,13 +3,17 @@
 import (
 	"context"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jpillora/backoff"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/scribe/backend"
 	"github.com/synapsecns/sanguine/services/scribe/config"
 	"github.com/synapsecns/sanguine/services/scribe/db"
 	"github.com/synapsecns/sanguine/services/scribe/logger"
+	"github.com/synapsecns/sanguine/services/scribe/service/progress"
+	"github.com/synapsecns/sanguine/services/scribe/service/sink"
 	otelMetrics "go.opentelemetry.io/otel/metric"
+	"sync"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -29,10 +33,35 @@ type Scribe struct {
 	handler metrics.Handler
 	// reorgMeters holds a otel counter meter for reorgs for each chain
 	reorgMeters map[uint32]otelMetrics.Int64Counter
+	// clientDialer dials the clients of chains added while scribe is running.
+	clientDialer ClientDialer
+	// configPath is the path the config is saved to when it changes, if set.
+	configPath string
+	// group runs the chain indexers once scribe has started.
+	group *errgroup.Group
+	// groupCtx is the context of group.
+	groupCtx context.Context
+	// chainCancels is a map from chain ID -> cancel func that stops indexing the chain.
+	chainCancels map[uint32]context.CancelFunc
+	// mux protects the chain indexers, clients, config, running chains and stored progress, which change as chains
+	// and contracts are added or removed.
+	mux sync.RWMutex
+	// progressTracker tracks the indexing progress of every contract.
+	progressTracker *progress.Tracker
+	// sinks are the outputs logs are delivered to, keyed by sink name.
+	sinks map[string]sink.Sink
 }
 
+// progressStoreInterval is how often indexing progress is stored so it can be served.
+const progressStoreInterval = 10 * time.Second
+
 // NewScribe creates a new scribe.
 func NewScribe(eventDB db.EventDB, clients map[uint32][]backend.ScribeBackend, config config.Config, handler metrics.Handler) (*Scribe, error) {
+	progressTracker, err := progress.NewTracker(handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not create progress tracker: %w", err)
+	}
+
 	chainIndexers := make(map[uint32]*ChainIndexer)
 	for i := range config.Chains {
 		chainConfig := config.Chains[i]
@@ -40,68 +69,130 @@ func NewScribe(eventDB db.EventDB, clients map[uint32][]backend.ScribeBackend, c
 		if err != nil {
 			return nil, fmt.Errorf("could not create chain indexer: %w", err)
 		}
+		chainIndexer.SetProgressTracker(progressTracker)
 		chainIndexers[chainConfig.ChainID] = chainIndexer
 	}
+	if clients == nil {
+		clients = make(map[uint32][]backend.ScribeBackend)
+	}
+
+	sinks := make(map[string]sink.Sink)
+	for _, sinkConfig := range config.Sinks {
+		sinks[sinkConfig.Name], err = sink.NewSink(sinkConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not create sink %s: %w", sinkConfig.Name, err)
+		}
+	}
 
 	return &Scribe{
-		eventDB:       eventDB,
-		clients:       clients,
-		chainIndexers: chainIndexers,
-		config:        config,
-		handler:       handler,
-		reorgMeters:   make(map[uint32]otelMetrics.Int64Counter),
+		eventDB:         eventDB,
+		clients:         clients,
+		chainIndexers:   chainIndexers,
+		config:          config,
+		handler:         handler,
+		reorgMeters:     make(map[uint32]otelMetrics.Int64Counter),
+		chainCancels:    make(map[uint32]context.CancelFunc),
+		progressTracker: progressTracker,
+		sinks:           sinks,
 	}, nil
 }
 
 // Start starts the scribe. A chain indexer is spun up for each chain, and a indexer is spun up for
 // each contract on that chain. There is an indexer for livefilling all contracts and indexer for livefilling at the tip as well.
-//
-//nolint:cyclop
-func (s Scribe) Start(ctx context.Context) error {
-	g, groupCtx := errgroup.WithContext(ctx)
-	b := backoff.Backoff{
-		Factor: 2,
-		Jitter: true,
-		Min:    1 * time.Second,
-		Max:    10 * time.Second,
+// Chains added while scribe is running are started alongside them.
+func (s *Scribe) Start(ctx context.Context) error {
+	s.mux.Lock()
+	s.group, s.groupCtx = errgroup.WithContext(ctx)
+	for chainID, chainIndexer := range s.chainIndexers {
+		s.startChain(chainID, chainIndexer)
 	}
-	retryRate := time.Second * 0
-	for i := range s.config.Chains {
-		chainConfig := s.config.Chains[i]
-		chainID := chainConfig.ChainID
-
-		// Run chain indexer for each chain
-		g.Go(func() error {
-			// Each chain gets its own context so it can retry on its own if there is a fatal error.
-			// If the global scribe context fails, all chains will fail.
-			chainCtx, cancelChain := context.WithCancel(ctx)
-			defer cancelChain()
-			for {
-				select {
-				case <-groupCtx.Done(): // Global context cancel, destroy all chain indexers.
-					cancelChain() // redundant, but clean.
+	groupCtx := s.groupCtx
+	s.group.Go(func() error {
+		return s.storeProgress(groupCtx)
+	})
+	g := s.group
+	s.mux.Unlock()
+
+	err := g.Wait()
+	for name, outputSink := range s.sinks {
+		if closeErr := outputSink.Close(); closeErr != nil {
+			logger.ReportScribeError(fmt.Errorf("could not close sink %s: %w", name, closeErr), 0, logger.SinkError)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("scribe failed: %w", err)
+	}
+
+	return nil
+}
+
+// startChain runs a chain indexer until scribe stops or the chain is removed. The scribe lock must be held.
+func (s *Scribe) startChain(chainID uint32, chainIndexer *ChainIndexer) {
+	// Each chain gets its own context so it can be removed on its own.
+	// If the global scribe context fails, all chains will fail.
+	chainCtx, cancelChain := context.WithCancel(s.groupCtx)
+	s.chainCancels[chainID] = cancelChain
+	groupCtx := s.groupCtx
+
+	s.group.Go(func() error {
+		defer cancelChain()
+
+		b := backoff.Backoff{
+			Factor: 2,
+			Jitter: true,
+			Min:    1 * time.Second,
+			Max:    10 * time.Second,
+		}
+		retryRate := time.Second * 0
+		for {
+			select {
+			case <-groupCtx.Done(): // Global context cancel, destroy all chain indexers.
+				return fmt.Errorf("global scribe context cancel %w", groupCtx.Err())
+			case <-chainCtx.Done(): // Chain level context cancel, the chain was removed.
+				if groupCtx.Err() != nil {
 					return fmt.Errorf("global scribe context cancel %w", groupCtx.Err())
-				case <-chainCtx.Done(): // Chain level context cancel, retry and recreate context.
-					logger.ReportScribeError(fmt.Errorf("chain level scribe context cancel, %w", chainCtx.Err()), chainID, logger.ContextCancelled)
-					chainCtx, cancelChain = context.WithCancel(ctx)
+				}
+				logger.ReportScribeError(fmt.Errorf("chain level scribe context cancel, %w", chainCtx.Err()), chainID, logger.ContextCancelled)
+				return nil
+			case <-time.After(retryRate):
+				err := chainIndexer.Index(chainCtx)
+				if err != nil {
+					logger.ReportScribeError(fmt.Errorf("error running chain indexer %w", err), chainID, logger.FatalScribeError)
 					retryRate = b.Duration()
 					continue
-				case <-time.After(retryRate):
-					err := s.chainIndexers[chainID].Index(groupCtx)
-					if err != nil {
-						logger.ReportScribeError(fmt.Errorf("error running chain indexer %w", err), chainID, logger.FatalScribeError)
-						retryRate = b.Duration()
-						continue
-					}
-					cancelChain()
-					return nil // This shouldn't really ever be hit
 				}
+				return nil // This shouldn't really ever be hit
 			}
+		}
+	})
+
+	for _, sinkConfig := range s.config.Sinks {
+		if !sinkConfig.MatchesChain(chainID) {
+			continue
+		}
+		dispatcher := sink.NewDispatcher(sinkConfig, s.sinks[sinkConfig.Name], chainID, s.eventDB, func() []common.Address {
+			return getAddressesFromConfig(chainIndexer.getChainConfig().Contracts)
+		})
+		s.group.Go(func() error {
+			return dispatcher.Run(chainCtx)
 		})
 	}
-	if err := g.Wait(); err != nil {
-		return fmt.Errorf("scribe failed: %w", err)
-	}
+}
 
-	return nil
+// storeProgress periodically stores the indexing progress of every contract until scribe stops.
+func (s *Scribe) storeProgress(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(progressStoreInterval):
+			// Progress is stored under the read lock so progress removed by the admin api isn't stored again.
+			s.mux.RLock()
+			err := s.eventDB.StoreIndexerProgress(ctx, s.progressTracker.Progress()...)
+			s.mux.RUnlock()
+			if err != nil {
+				logger.ReportScribeError(err, 0, logger.ProgressStoreError)
+			}
+		}
+	}
 }
@@ -0,0 +1,375 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core/retry"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/base"
+	"github.com/synapsecns/sanguine/services/scribe/logger"
+)
+
+const (
+	// defaultBatchSize is the max number of logs delivered at once if the sink doesn't set one.
+	defaultBatchSize = 100
+	// defaultMaxAttempts is the number of times a failed batch is retried if the sink doesn't set it.
+	defaultMaxAttempts = 5
+	// defaultPollInterval is how often new logs and removals are checked for.
+	defaultPollInterval = time.Second
+	// blockChunkSize is the number of blocks whose logs are retrieved at a time.
+	blockChunkSize = 10000
+	// endOfBlock is the tx and log index of a cursor positioned after every log of its block. It is the largest
+	// value the database can store.
+	endOfBlock = math.MaxInt64
+)
+
+// Dispatcher delivers the logs of a chain to a sink as they are indexed, along with the logs removed by reorgs
+// that were already delivered. Delivery is at least once: the cursor is stored after each batch, so a batch may be
+// delivered again if scribe stops before the cursor is stored. Batches that still fail after being retried are
+// stored as dead letters and skipped.
+type Dispatcher struct {
+	cfg     config.SinkConfig
+	sink    Sink
+	chainID uint32
+	eventDB db.EventDB
+	// contracts returns the contracts indexed on the chain. Logs are delivered up to the block all of them are indexed to.
+	contracts    func() []common.Address
+	logFilter    db.LogFilter
+	batchSize    int
+	maxAttempts  int
+	pollInterval time.Duration
+}
+
+// NewDispatcher creates a dispatcher that delivers the logs of a chain to a sink.
+func NewDispatcher(cfg config.SinkConfig, sink Sink, chainID uint32, eventDB db.EventDB, contracts func() []common.Address) *Dispatcher {
+	batchSize := defaultBatchSize
+	if cfg.BatchSize > 0 {
+		batchSize = cfg.BatchSize
+	}
+	maxAttempts := defaultMaxAttempts
+	if cfg.MaxAttempts > 0 {
+		maxAttempts = cfg.MaxAttempts
+	}
+
+	return &Dispatcher{
+		cfg:       cfg,
+		sink:      sink,
+		chainID:   chainID,
+		eventDB:   eventDB,
+		contracts: contracts,
+		logFilter: db.LogFilter{
+			ChainID:           chainID,
+			ContractAddresses: cfg.ContractAddresses,
+			Topics:            cfg.Topics,
+		},
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Run delivers logs until the context is canceled. Errors are reported and retried on the next poll.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	var cursor *db.SinkCursor
+	wait := time.Duration(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+			wait = d.pollInterval
+
+			if cursor == nil {
+				var err error
+				cursor, err = d.loadCursor(ctx)
+				if err != nil {
+					logger.ReportScribeError(fmt.Errorf("could not load cursor of sink %s: %w", d.cfg.Name, err), d.chainID, logger.SinkError)
+					continue
+				}
+			}
+
+			err := d.poll(ctx, cursor)
+			if err != nil && ctx.Err() == nil {
+				logger.ReportScribeError(fmt.Errorf("could not deliver logs to sink %s: %w", d.cfg.Name, err), d.chainID, logger.SinkError)
+			}
+		}
+	}
+}
+
+// loadCursor retrieves the sink's cursor, creating it the first time the sink is run on the chain.
+func (d *Dispatcher) loadCursor(ctx context.Context) (*db.SinkCursor, error) {
+	cursor, err := d.eventDB.RetrieveSinkCursor(ctx, d.cfg.Name, d.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve cursor: %w", err)
+	}
+	if cursor != nil {
+		return cursor, nil
+	}
+
+	// Removals from before the sink was created are not delivered.
+	removedID, err := d.eventDB.RetrieveLastRemovedLogID(ctx, d.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve last removed log: %w", err)
+	}
+
+	var blockNumber uint64
+	if d.cfg.StartBlock > 0 {
+		blockNumber = d.cfg.StartBlock - 1
+	} else {
+		blockNumber, err = d.lastIndexed(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cursor = &db.SinkCursor{BlockNumber: blockNumber, TxIndex: endOfBlock, Index: endOfBlock, RemovedID: removedID}
+	err = d.eventDB.StoreSinkCursor(ctx, d.cfg.Name, d.chainID, *cursor)
+	if err != nil {
+		return nil, fmt.Errorf("could not store cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+// poll delivers the removals and new logs since the cursor, advancing it as they are delivered.
+func (d *Dispatcher) poll(ctx context.Context, cursor *db.SinkCursor) error {
+	err := d.deliverRemovals(ctx, cursor)
+	if err != nil {
+		return err
+	}
+
+	lastIndexed, err := d.lastIndexed(ctx)
+	if err != nil {
+		return err
+	}
+
+	for fromBlock := d.nextBlock(cursor); fromBlock <= lastIndexed; fromBlock = d.nextBlock(cursor) {
+		toBlock := fromBlock + blockChunkSize - 1
+		if toBlock > lastIndexed {
+			toBlock = lastIndexed
+		}
+
+		err = d.deliverRange(ctx, cursor, fromBlock, toBlock)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliverRemovals delivers the logs removed by reorgs that were already delivered. The cursor is rewound to before
+// the earliest removed block so that the logs indexed in its place are delivered.
+func (d *Dispatcher) deliverRemovals(ctx context.Context, cursor *db.SinkCursor) error {
+	for {
+		removedLogs, err := d.eventDB.RetrieveRemovedLogs(ctx, d.logFilter, cursor.RemovedID, 1)
+		if err != nil {
+			return fmt.Errorf("could not retrieve removed logs: %w", err)
+		}
+		if len(removedLogs) == 0 {
+			return nil
+		}
+
+		var events []Event
+		var removedFrom *uint64
+		for _, removedLog := range removedLogs {
+			if !delivered(cursor, removedLog.Log) {
+				continue
+			}
+
+			log := *removedLog.Log
+			log.Removed = true
+			events = append(events, Event{ChainID: d.chainID, Log: log})
+			if removedFrom == nil || log.BlockNumber < *removedFrom {
+				blockNumber := log.BlockNumber
+				removedFrom = &blockNumber
+			}
+		}
+
+		for start := 0; start < len(events); start += d.batchSize {
+			end := start + d.batchSize
+			if end > len(events) {
+				end = len(events)
+			}
+			err = d.deliver(ctx, events[start:end])
+			if err != nil {
+				return err
+			}
+		}
+
+		cursor.RemovedID = removedLogs[len(removedLogs)-1].ID
+		if removedFrom != nil && *removedFrom > 0 {
+			setEndOfBlock(cursor, *removedFrom-1)
+		}
+		err = d.storeCursor(ctx, cursor)
+		if err != nil {
+			return err
+		}
+
+		if len(removedLogs) < base.PageSize {
+			return nil
+		}
+	}
+}
+
+// deliverRange delivers the logs after the cursor in a block range, advancing the cursor to the end of the range.
+func (d *Dispatcher) deliverRange(ctx context.Context, cursor *db.SinkCursor, fromBlock, toBlock uint64) error {
+	var events []Event
+	flush := func() error {
+		if len(events) == 0 {
+			return nil
+		}
+		err := d.deliver(ctx, events)
+		if err != nil {
+			return err
+		}
+
+		last := events[len(events)-1].Log
+		cursor.BlockNumber = last.BlockNumber
+		cursor.TxIndex = uint64(last.TxIndex)
+		cursor.Index = uint64(last.Index)
+		events = nil
+		return d.storeCursor(ctx, cursor)
+	}
+
+	for page := 1; ; page++ {
+		logs, err := d.eventDB.RetrieveLogsInRangeAsc(ctx, d.logFilter, fromBlock, toBlock, page)
+		if err != nil {
+			return fmt.Errorf("could not retrieve logs: %w", err)
+		}
+
+		for _, log := range logs {
+			if delivered(cursor, log) {
+				continue
+			}
+			events = append(events, Event{ChainID: d.chainID, Log: *log})
+			if len(events) == d.batchSize {
+				err = flush()
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(logs) < base.PageSize {
+			break
+		}
+	}
+
+	err := flush()
+	if err != nil {
+		return err
+	}
+
+	setEndOfBlock(cursor, toBlock)
+	return d.storeCursor(ctx, cursor)
+}
+
+// deliver delivers a batch of events, retrying it with backoff. If every attempt fails the events are stored as
+// dead letters so delivery can move on.
+func (d *Dispatcher) deliver(ctx context.Context, events []Event) error {
+	var deliveryErr error
+	err := retry.WithBackoff(ctx, func(ctx context.Context) error {
+		deliveryErr = d.sink.Deliver(ctx, events)
+		//nolint: wrapcheck
+		return deliveryErr
+	}, retry.WithMaxAttempts(d.maxAttempts), retry.WithMaxTotalTime(0))
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("could not deliver events: %w", ctx.Err())
+	}
+
+	logger.ReportScribeError(fmt.Errorf("dead lettering %d events of sink %s: %w", len(events), d.cfg.Name, deliveryErr), d.chainID, logger.SinkError)
+	failedAt := time.Now()
+	deadLetters := make([]db.DeadLetter, len(events))
+	for i, event := range events {
+		deadLetters[i] = db.DeadLetter{
+			SinkName: d.cfg.Name,
+			ChainID:  d.chainID,
+			Log:      event.Log,
+			Error:    deliveryErr.Error(),
+			FailedAt: failedAt,
+		}
+	}
+	err = d.eventDB.StoreDeadLetters(ctx, deadLetters...)
+	if err != nil {
+		return fmt.Errorf("could not store dead letters: %w", err)
+	}
+	return nil
+}
+
+// lastIndexed gets the block every contract delivered to the sink has been indexed to.
+func (d *Dispatcher) lastIndexed(ctx context.Context) (uint64, error) {
+	addresses := d.contracts()
+	if len(d.cfg.ContractAddresses) > 0 {
+		sinkAddresses := make(map[common.Address]bool, len(d.cfg.ContractAddresses))
+		for _, address := range d.cfg.ContractAddresses {
+			sinkAddresses[common.HexToAddress(address)] = true
+		}
+
+		var filtered []common.Address
+		for _, address := range addresses {
+			if sinkAddresses[address] {
+				filtered = append(filtered, address)
+			}
+		}
+		addresses = filtered
+	}
+	if len(addresses) == 0 {
+		return 0, nil
+	}
+
+	lastIndexedMap, err := d.eventDB.RetrieveLastIndexedMultiple(ctx, addresses, d.chainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve last indexed blocks: %w", err)
+	}
+
+	lastIndexed := lastIndexedMap[addresses[0]]
+	for _, address := range addresses[1:] {
+		if lastIndexedMap[address] < lastIndexed {
+			lastIndexed = lastIndexedMap[address]
+		}
+	}
+	return lastIndexed, nil
+}
+
+// nextBlock gets the first block with logs that may not have been delivered.
+func (d *Dispatcher) nextBlock(cursor *db.SinkCursor) uint64 {
+	if cursor.TxIndex == endOfBlock && cursor.Index == endOfBlock {
+		return cursor.BlockNumber + 1
+	}
+	return cursor.BlockNumber
+}
+
+func (d *Dispatcher) storeCursor(ctx context.Context, cursor *db.SinkCursor) error {
+	err := d.eventDB.StoreSinkCursor(ctx, d.cfg.Name, d.chainID, *cursor)
+	if err != nil {
+		return fmt.Errorf("could not store cursor: %w", err)
+	}
+	return nil
+}
+
+// setEndOfBlock positions the cursor after every log of a block.
+func setEndOfBlock(cursor *db.SinkCursor, blockNumber uint64) {
+	cursor.BlockNumber = blockNumber
+	cursor.TxIndex = endOfBlock
+	cursor.Index = endOfBlock
+}
+
+// delivered checks if a log is at or before the cursor.
+func delivered(cursor *db.SinkCursor, log *types.Log) bool {
+	if log.BlockNumber != cursor.BlockNumber {
+		return log.BlockNumber < cursor.BlockNumber
+	}
+	if uint64(log.TxIndex) != cursor.TxIndex {
+		return uint64(log.TxIndex) < cursor.TxIndex
+	}
+	return uint64(log.Index) <= cursor.Index
+}
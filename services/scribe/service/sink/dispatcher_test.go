@@ -0,0 +1,170 @@
+package sink_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service/sink"
+)
+
+// recordingSink records the events delivered to it, failing every delivery if fail is set.
+type recordingSink struct {
+	mux    sync.Mutex
+	events []sink.Event
+	fail   bool
+}
+
+func (r *recordingSink) Deliver(_ context.Context, events []sink.Event) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.fail {
+		return errors.New("sink is down")
+	}
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *recordingSink) Close() error {
+	return nil
+}
+
+func (r *recordingSink) Events() []sink.Event {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return append([]sink.Event{}, r.events...)
+}
+
+// runDispatcher runs a dispatcher until the returned function is called.
+func (s *SinkSuite) runDispatcher(cfg config.SinkConfig, recorder *recordingSink, chainID uint32, contract common.Address) func() {
+	dispatcher := sink.NewDispatcher(cfg, recorder, chainID, s.testDB, func() []common.Address {
+		return []common.Address{contract}
+	})
+	dispatcher.SetPollInterval(10 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(s.GetTestContext())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Nil(s.T(), dispatcher.Run(ctx))
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// storeBlockLogs stores logs of a contract in a block and marks the block as indexed.
+func (s *SinkSuite) storeBlockLogs(chainID uint32, contract common.Address, blockNumber uint64, count int) []types.Log {
+	blockHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+	logs := make([]types.Log, count)
+	for i := range logs {
+		logs[i] = types.Log{
+			Address:     contract,
+			Topics:      []common.Hash{common.BigToHash(big.NewInt(gofakeit.Int64()))},
+			Data:        []byte{},
+			BlockNumber: blockNumber,
+			TxHash:      common.BigToHash(big.NewInt(gofakeit.Int64())),
+			TxIndex:     uint(i),
+			BlockHash:   blockHash,
+			Index:       uint(i),
+		}
+	}
+	Nil(s.T(), s.testDB.StoreLogs(s.GetTestContext(), chainID, logs...))
+	Nil(s.T(), s.testDB.StoreLastIndexed(s.GetTestContext(), contract, chainID, blockNumber, false))
+	return logs
+}
+
+// TestDispatcher tests that logs are delivered in order as they are indexed, that removals of delivered logs are
+// delivered, and that delivery resumes from the stored cursor.
+func (s *SinkSuite) TestDispatcher() {
+	chainID := gofakeit.Uint32()
+	contract := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	cfg := config.SinkConfig{Name: "test", Type: config.WebhookSink, StartBlock: 1, BatchSize: 2}
+
+	var expected []sink.Event
+	var lastBlockLogs []types.Log
+	for block := uint64(1); block <= 3; block++ {
+		lastBlockLogs = s.storeBlockLogs(chainID, contract, block, 3)
+		for _, log := range lastBlockLogs {
+			expected = append(expected, sink.Event{ChainID: chainID, Log: log})
+		}
+	}
+
+	recorder := &recordingSink{}
+	stop := s.runDispatcher(cfg, recorder, chainID, contract)
+	s.Eventually(func() bool {
+		return len(recorder.Events()) >= len(expected)
+	})
+	Equal(s.T(), expected, recorder.Events())
+
+	// The last block is reorged out, so its logs are delivered as removed and the logs replacing them are delivered.
+	Nil(s.T(), s.testDB.RemoveOrphanedBlocks(s.GetTestContext(), chainID, 3, []common.Hash{lastBlockLogs[0].BlockHash}))
+	for _, log := range lastBlockLogs {
+		log.Removed = true
+		expected = append(expected, sink.Event{ChainID: chainID, Log: log})
+	}
+	s.Eventually(func() bool {
+		return len(recorder.Events()) >= len(expected)
+	})
+	for _, log := range s.storeBlockLogs(chainID, contract, 3, 2) {
+		expected = append(expected, sink.Event{ChainID: chainID, Log: log})
+	}
+	s.Eventually(func() bool {
+		return len(recorder.Events()) >= len(expected)
+	})
+	stop()
+	Equal(s.T(), expected, recorder.Events())
+
+	cursor, err := s.testDB.RetrieveSinkCursor(s.GetTestContext(), cfg.Name, chainID)
+	Nil(s.T(), err)
+	Equal(s.T(), uint64(3), cursor.BlockNumber)
+
+	// A restarted dispatcher only delivers logs after the cursor.
+	recorder = &recordingSink{}
+	stop = s.runDispatcher(cfg, recorder, chainID, contract)
+	defer stop()
+	newLogs := s.storeBlockLogs(chainID, contract, 4, 1)
+	s.Eventually(func() bool {
+		return len(recorder.Events()) >= 1
+	})
+	Equal(s.T(), []sink.Event{{ChainID: chainID, Log: newLogs[0]}}, recorder.Events())
+}
+
+// TestDispatcherDeadLetters tests that batches which can't be delivered are dead lettered and skipped.
+func (s *SinkSuite) TestDispatcherDeadLetters() {
+	chainID := gofakeit.Uint32()
+	contract := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	cfg := config.SinkConfig{Name: "failing", Type: config.WebhookSink, StartBlock: 1, MaxAttempts: 1}
+	logs := s.storeBlockLogs(chainID, contract, 1, 2)
+
+	stop := s.runDispatcher(cfg, &recordingSink{fail: true}, chainID, contract)
+	defer stop()
+
+	var deadLetters []db.DeadLetter
+	s.Eventually(func() bool {
+		var err error
+		deadLetters, err = s.testDB.RetrieveDeadLetters(s.GetTestContext(), cfg.Name, 1)
+		Nil(s.T(), err)
+		return len(deadLetters) == len(logs)
+	})
+	for i, deadLetter := range deadLetters {
+		Equal(s.T(), chainID, deadLetter.ChainID)
+		Equal(s.T(), logs[i], deadLetter.Log)
+		Equal(s.T(), "sink is down", deadLetter.Error)
+	}
+
+	s.Eventually(func() bool {
+		cursor, err := s.testDB.RetrieveSinkCursor(s.GetTestContext(), cfg.Name, chainID)
+		Nil(s.T(), err)
+		return cursor != nil && cursor.BlockNumber == 1
+	})
+}
@@ -0,0 +1,2 @@
+// Package sink delivers newly indexed logs and reorg removals to external outputs such as webhooks and JSONL files.
+package sink
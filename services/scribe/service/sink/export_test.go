@@ -0,0 +1,8 @@
+package sink
+
+import "time"
+
+// SetPollInterval sets how often the dispatcher checks for new logs and removals.
+func (d *Dispatcher) SetPollInterval(pollInterval time.Duration) {
+	d.pollInterval = pollInterval
+}
@@ -0,0 +1,142 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/scribe/config"
+)
+
+// defaultMaxFileSize is the size after which a file sink starts a new file if the sink doesn't set one.
+const defaultMaxFileSize = 100 * 1024 * 1024
+
+// rotatedFileTimeFormat is the format of the time rotated files are suffixed with.
+const rotatedFileTimeFormat = "20060102T150405.000000000"
+
+// FileSink appends events to a JSONL file, one event per line. Once the file would exceed the max file size, it is
+// renamed with the time it was rotated at and a new file is started.
+type FileSink struct {
+	name        string
+	dir         string
+	maxFileSize int64
+	// mux protects the file, which is replaced when it is rotated.
+	mux  sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileSink creates a file sink, creating its directory if it doesn't exist.
+func NewFileSink(cfg config.SinkConfig) (*FileSink, error) {
+	maxFileSize := int64(defaultMaxFileSize)
+	if cfg.MaxFileSize > 0 {
+		maxFileSize = cfg.MaxFileSize
+	}
+
+	err := os.MkdirAll(cfg.Path, 0750)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sink directory: %w", err)
+	}
+
+	f := &FileSink{
+		name:        cfg.Name,
+		dir:         cfg.Path,
+		maxFileSize: maxFileSize,
+	}
+	err = f.open()
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Path is the path of the file events are currently appended to.
+func (f *FileSink) Path() string {
+	return filepath.Join(f.dir, f.name+".jsonl")
+}
+
+// Deliver appends a batch of events to the file and syncs it to disk.
+func (f *FileSink) Deliver(_ context.Context, events []Event) error {
+	var lines bytes.Buffer
+	encoder := json.NewEncoder(&lines)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return fmt.Errorf("could not encode event: %w", err)
+		}
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if f.size > 0 && f.size+int64(lines.Len()) > f.maxFileSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+
+	written, err := f.file.Write(lines.Bytes())
+	f.size += int64(written)
+	if err != nil {
+		return fmt.Errorf("could not write events: %w", err)
+	}
+	err = f.file.Sync()
+	if err != nil {
+		return fmt.Errorf("could not sync file: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the file.
+func (f *FileSink) Close() error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	err := f.file.Close()
+	if err != nil {
+		return fmt.Errorf("could not close file: %w", err)
+	}
+	return nil
+}
+
+// open opens the current file for appending. The file lock must be held.
+func (f *FileSink) open() error {
+	file, err := os.OpenFile(f.Path(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("could not stat file: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file and starts a new one. The file lock must be held.
+func (f *FileSink) rotate() error {
+	err := f.file.Close()
+	if err != nil {
+		return fmt.Errorf("could not close file: %w", err)
+	}
+
+	rotatedPath := filepath.Join(f.dir, fmt.Sprintf("%s-%s.jsonl", f.name, time.Now().UTC().Format(rotatedFileTimeFormat)))
+	err = os.Rename(f.Path(), rotatedPath)
+	if err != nil {
+		// Keep appending to the current file so the sink can still be written to.
+		if openErr := f.open(); openErr != nil {
+			return openErr
+		}
+		return fmt.Errorf("could not rotate file: %w", err)
+	}
+
+	return f.open()
+}
@@ -0,0 +1,86 @@
+package sink_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/service/sink"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+)
+
+// TestFileSink tests that events are appended as JSON lines and that files are rotated once they are full.
+func (s *SinkSuite) TestFileSink() {
+	dir := filepath.Join(filet.TmpDir(s.T(), ""), "sink")
+	cfg := config.SinkConfig{
+		Name:        "events",
+		Type:        config.FileSink,
+		Path:        dir,
+		MaxFileSize: 2048,
+	}
+	fileSink, err := sink.NewSink(cfg)
+	Nil(s.T(), err)
+
+	var events []sink.Event
+	for i := 0; i < 6; i++ {
+		log := testutil.MakeRandomLog(common.BigToHash(big.NewInt(int64(i))))
+		events = append(events, sink.Event{ChainID: 1, Log: log})
+		Nil(s.T(), fileSink.Deliver(s.GetTestContext(), events[i:i+1]))
+	}
+	Nil(s.T(), fileSink.Close())
+
+	files, err := os.ReadDir(dir)
+	Nil(s.T(), err)
+	Greater(s.T(), len(files), 1)
+
+	// Every event is written once across the rotated files, which sort in the order they were written.
+	var rotated, written []sink.Event
+	for _, file := range files {
+		fileEvents := s.readEvents(filepath.Join(dir, file.Name()))
+		info, err := file.Info()
+		Nil(s.T(), err)
+		LessOrEqual(s.T(), info.Size(), cfg.MaxFileSize)
+		if file.Name() == "events.jsonl" {
+			written = fileEvents
+			continue
+		}
+		rotated = append(rotated, fileEvents...)
+	}
+	Equal(s.T(), events, append(rotated, written...))
+
+	// Reopening the sink continues from the current file, so its size still bounds it.
+	fileSink, err = sink.NewSink(cfg)
+	Nil(s.T(), err)
+	log := testutil.MakeRandomLog(common.BigToHash(big.NewInt(6)))
+	Nil(s.T(), fileSink.Deliver(s.GetTestContext(), []sink.Event{{ChainID: 1, Log: log}}))
+	Nil(s.T(), fileSink.Close())
+	current := s.readEvents(filepath.Join(dir, "events.jsonl"))
+	Equal(s.T(), log, current[len(current)-1].Log)
+	info, err := os.Stat(filepath.Join(dir, "events.jsonl"))
+	Nil(s.T(), err)
+	LessOrEqual(s.T(), info.Size(), cfg.MaxFileSize)
+}
+
+func (s *SinkSuite) readEvents(path string) []sink.Event {
+	file, err := os.Open(filepath.Clean(path))
+	Nil(s.T(), err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var events []sink.Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event sink.Event
+		Nil(s.T(), json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	Nil(s.T(), scanner.Err())
+	return events
+}
@@ -0,0 +1,40 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+)
+
+// Event is a log delivered to a sink. Logs removed by a reorg are delivered again with Removed set.
+type Event struct {
+	ChainID uint32    `json:"chain_id"`
+	Log     types.Log `json:"log"`
+}
+
+// Sink is an output logs are delivered to.
+type Sink interface {
+	// Deliver delivers a batch of events. The batch is retried if an error is returned, so a sink may receive
+	// an event more than once.
+	Deliver(ctx context.Context, events []Event) error
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// NewSink creates the sink for a sink config.
+func NewSink(cfg config.SinkConfig) (Sink, error) {
+	switch cfg.Type {
+	case config.WebhookSink:
+		return NewWebhookSink(cfg), nil
+	case config.FileSink:
+		fileSink, err := NewFileSink(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return fileSink, nil
+	default:
+		return nil, fmt.Errorf("%w %q for sink %s", config.ErrInvalidSinkType, cfg.Type, cfg.Name)
+	}
+}
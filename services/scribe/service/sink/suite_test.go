@@ -0,0 +1,41 @@
+package sink_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Flaque/filet"
+	. "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
+)
+
+type SinkSuite struct {
+	*testsuite.TestSuite
+	testDB db.EventDB
+}
+
+// NewSinkSuite creates a new sink test suite.
+func NewSinkSuite(tb testing.TB) *SinkSuite {
+	tb.Helper()
+	return &SinkSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+// SetupTest sets up the test suite.
+func (s *SinkSuite) SetupTest() {
+	s.TestSuite.SetupTest()
+	s.SetTestTimeout(time.Minute * 3)
+	sqliteStore, err := sqlite.NewSqliteStore(s.GetTestContext(), filet.TmpDir(s.T(), ""), metrics.NewNullHandler(), false)
+	Nil(s.T(), err)
+	s.testDB = sqliteStore
+}
+
+// TestSinkSuite tests the sink suite.
+func TestSinkSuite(t *testing.T) {
+	suite.Run(t, NewSinkSuite(t))
+}
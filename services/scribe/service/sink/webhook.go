@@ -0,0 +1,82 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/scribe/config"
+)
+
+// defaultWebhookTimeout is the timeout of a webhook request if the sink doesn't set one.
+const defaultWebhookTimeout = 10 * time.Second
+
+// webhookPayload is the body posted to a webhook.
+type webhookPayload struct {
+	Sink   string  `json:"sink"`
+	Events []Event `json:"events"`
+}
+
+// WebhookSink posts batches of events to an HTTP endpoint as JSON.
+type WebhookSink struct {
+	name    string
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookSink creates a webhook sink.
+func NewWebhookSink(cfg config.SinkConfig) *WebhookSink {
+	timeout := defaultWebhookTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+
+	return &WebhookSink{
+		name:    cfg.Name,
+		url:     cfg.URL,
+		headers: cfg.Headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Deliver posts a batch of events. Any response other than a 2xx is an error.
+func (w *WebhookSink) Deliver(ctx context.Context, events []Event) error {
+	body, err := json.Marshal(webhookPayload{Sink: w.name, Events: events})
+	if err != nil {
+		return fmt.Errorf("could not encode events: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range w.headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not post events: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("webhook returned status %d: %s", res.StatusCode, resBody)
+	}
+
+	return nil
+}
+
+// Close is a no-op for webhook sinks.
+func (w *WebhookSink) Close() error {
+	return nil
+}
@@ -0,0 +1,57 @@
+package sink_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/service/sink"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+)
+
+// TestWebhookSink tests that events are posted to the webhook with the configured headers.
+func (s *SinkSuite) TestWebhookSink() {
+	type payload struct {
+		Sink   string       `json:"sink"`
+		Events []sink.Event `json:"events"`
+	}
+
+	var received []payload
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Equal(s.T(), http.MethodPost, r.Method)
+		Equal(s.T(), "application/json", r.Header.Get("Content-Type"))
+		Equal(s.T(), "Bearer secret", r.Header.Get("Authorization"))
+
+		var body payload
+		Nil(s.T(), json.NewDecoder(r.Body).Decode(&body))
+		received = append(received, body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	webhookSink, err := sink.NewSink(config.SinkConfig{
+		Name:    "hook",
+		Type:    config.WebhookSink,
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	})
+	Nil(s.T(), err)
+	defer func() {
+		Nil(s.T(), webhookSink.Close())
+	}()
+
+	log := testutil.MakeRandomLog(common.BigToHash(big.NewInt(1)))
+	events := []sink.Event{{ChainID: 1, Log: log}}
+	Nil(s.T(), webhookSink.Deliver(s.GetTestContext(), events))
+	Equal(s.T(), 1, len(received))
+	Equal(s.T(), "hook", received[0].Sink)
+	Equal(s.T(), events, received[0].Events)
+
+	status = http.StatusServiceUnavailable
+	NotNil(s.T(), webhookSink.Deliver(s.GetTestContext(), events))
+}
@@ -0,0 +1,3 @@
+// Package verifier compares the logs, receipts and transactions indexed for a contract against the chain, and repairs
+// any that are missing, extra or mismatched.
+package verifier
@@ -0,0 +1,74 @@
+package verifier_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
+	"github.com/synapsecns/sanguine/services/scribe/metadata"
+
+	"github.com/Flaque/filet"
+	. "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+)
+
+type VerifierSuite struct {
+	*testsuite.TestSuite
+	testDB  db.EventDB
+	manager *testutil.DeployManager
+	wallet  wallet.Wallet
+	signer  *localsigner.Signer
+	metrics metrics.Handler
+}
+
+// NewVerifierSuite creates a new verifier test suite.
+func NewVerifierSuite(tb testing.TB) *VerifierSuite {
+	tb.Helper()
+	return &VerifierSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+// SetupTest sets up the test suite.
+func (v *VerifierSuite) SetupTest() {
+	v.TestSuite.SetupTest()
+	v.SetTestTimeout(time.Minute * 10)
+	sqliteStore, err := sqlite.NewSqliteStore(v.GetTestContext(), filet.TmpDir(v.T(), ""), v.metrics, false)
+	Nil(v.T(), err)
+	v.testDB = sqliteStore
+	v.manager = testutil.NewDeployManager(v.T())
+	v.wallet, err = wallet.FromRandom()
+	Nil(v.T(), err)
+	v.signer = localsigner.NewSigner(v.wallet.PrivateKey())
+}
+
+func (v *VerifierSuite) SetupSuite() {
+	v.TestSuite.SetupSuite()
+
+	// don't use metrics on ci for integration tests
+	isCI := core.GetEnvBool("CI", false)
+	useMetrics := !isCI
+	metricsHandler := metrics.Null
+
+	if useMetrics {
+		localmetrics.SetupTestJaeger(v.GetSuiteContext(), v.T())
+		metricsHandler = metrics.Jaeger
+	}
+
+	var err error
+	v.metrics, err = metrics.NewByType(v.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
+	Nil(v.T(), err)
+}
+
+// TestVerifierSuite tests the verifier suite.
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, NewVerifierSuite(t))
+}
@@ -0,0 +1,495 @@
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/util"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/decoder"
+	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+// txBatchSize is the number of transactions whose receipts are fetched per batch request.
+const txBatchSize = 50
+
+// unsupportedTxErrors are returned by the rpc for transactions the indexer can't store, so they aren't verified.
+var unsupportedTxErrors = map[string]bool{
+	"transaction type not supported":     true,
+	"invalid transaction v, r, s values": true,
+}
+
+// Tx identifies a transaction by its hash and the block it is included in, on chain if it is there and in the
+// database otherwise.
+type Tx struct {
+	Hash        common.Hash
+	BlockNumber uint64
+}
+
+// Report is the result of verifying a contract's indexed data in a block range.
+type Report struct {
+	ChainID         uint32
+	ContractAddress common.Address
+	StartBlock      uint64
+	EndBlock        uint64
+	// MissingLogs are logs on chain that are not stored.
+	MissingLogs []types.Log
+	// ExtraLogs are stored logs that are not on chain.
+	ExtraLogs []types.Log
+	// MismatchedLogs are the chain's version of stored logs that differ from it.
+	MismatchedLogs []types.Log
+	// MissingReceipts are receipts on chain that are not stored.
+	MissingReceipts []Tx
+	// ExtraReceipts are stored receipts of transactions that are not on chain.
+	ExtraReceipts []Tx
+	// MismatchedReceipts are stored receipts that differ from the chain.
+	MismatchedReceipts []Tx
+	// MissingTxs are transactions on chain that are not stored.
+	MissingTxs []Tx
+	// ExtraTxs are stored transactions that are not on chain.
+	ExtraTxs []Tx
+	// MismatchedTxs are stored transactions that differ from the chain.
+	MismatchedTxs []Tx
+}
+
+// Discrepancies is the number of missing, extra and mismatched logs, receipts and transactions.
+func (r Report) Discrepancies() int {
+	return len(r.MissingLogs) + len(r.ExtraLogs) + len(r.MismatchedLogs) +
+		len(r.MissingReceipts) + len(r.ExtraReceipts) + len(r.MismatchedReceipts) +
+		len(r.MissingTxs) + len(r.ExtraTxs) + len(r.MismatchedTxs)
+}
+
+// Verifier compares the data indexed for a chain's contracts against the chain.
+type Verifier struct {
+	// eventDB is the database the indexed data is stored in.
+	eventDB db.EventDB
+	// client contains the clients the chain's data is fetched with.
+	client []backend.ScribeBackend
+	// chainConfig is the config of the chain, used to index repaired blocks the same way they were indexed.
+	chainConfig config.ChainConfig
+	// handler is the metrics handler.
+	handler metrics.Handler
+}
+
+// NewVerifier creates a verifier for a chain. Data is fetched with the first client, so it should be configured with
+// enough confirmations for the verified range to be final.
+func NewVerifier(eventDB db.EventDB, client []backend.ScribeBackend, chainConfig config.ChainConfig, handler metrics.Handler) (*Verifier, error) {
+	if len(client) == 0 {
+		return nil, fmt.Errorf("no clients for chain %d", chainConfig.ChainID)
+	}
+	if chainConfig.GetLogsRange == 0 {
+		chainConfig.GetLogsRange = 600
+	}
+	if chainConfig.GetLogsBatchAmount == 0 {
+		chainConfig.GetLogsBatchAmount = 2
+	}
+	if chainConfig.StoreConcurrency == 0 {
+		chainConfig.StoreConcurrency = 20
+	}
+	if chainConfig.ConcurrencyThreshold == 0 {
+		chainConfig.ConcurrencyThreshold = 50000
+	}
+
+	return &Verifier{
+		eventDB:     eventDB,
+		client:      client,
+		chainConfig: chainConfig,
+		handler:     handler,
+	}, nil
+}
+
+// Verify compares the logs of a contract in a block range, and the receipts and transactions of their
+// transactions, against the chain.
+func (v *Verifier) Verify(ctx context.Context, address common.Address, startBlock, endBlock uint64) (*Report, error) {
+	if endBlock < startBlock {
+		return nil, fmt.Errorf("end block %d is before start block %d", endBlock, startBlock)
+	}
+	topicFilter := v.contractTopics(address)
+	topics, err := scribeTypes.ParseTopics(topicFilter)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse topics: %w", err)
+	}
+
+	chainLogs, err := v.fetchLogs(ctx, address, topics, startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	storedLogs, err := v.retrieveLogs(ctx, address, topicFilter, startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		ChainID:         v.chainConfig.ChainID,
+		ContractAddress: address,
+		StartBlock:      startBlock,
+		EndBlock:        endBlock,
+	}
+
+	// Transactions are verified in the order their logs are first seen.
+	var txHashes []common.Hash
+	storedTxBlocks := make(map[common.Hash]uint64)
+	addTx := func(log types.Log) {
+		if _, ok := storedTxBlocks[log.TxHash]; !ok {
+			txHashes = append(txHashes, log.TxHash)
+			storedTxBlocks[log.TxHash] = log.BlockNumber
+		}
+	}
+
+	storedByKey := make(map[logKey]types.Log, len(storedLogs))
+	for _, log := range storedLogs {
+		storedByKey[newLogKey(log)] = log
+		addTx(log)
+	}
+	for _, log := range chainLogs {
+		key := newLogKey(log)
+		storedLog, ok := storedByKey[key]
+		switch {
+		case !ok:
+			report.MissingLogs = append(report.MissingLogs, log)
+		case !logsEqual(log, storedLog):
+			report.MismatchedLogs = append(report.MismatchedLogs, log)
+		}
+		delete(storedByKey, key)
+		addTx(log)
+	}
+	for _, log := range storedLogs {
+		if _, ok := storedByKey[newLogKey(log)]; ok {
+			report.ExtraLogs = append(report.ExtraLogs, log)
+		}
+	}
+
+	for i := 0; i < len(txHashes); i += txBatchSize {
+		end := i + txBatchSize
+		if end > len(txHashes) {
+			end = len(txHashes)
+		}
+		err = v.verifyTxs(ctx, report, txHashes[i:end], storedTxBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// Repair removes the extra and mismatched data in a report and indexes the blocks of the missing and mismatched
+// data again, without changing any contract's last indexed block.
+func (v *Verifier) Repair(ctx context.Context, report *Report) error {
+	chainID := report.ChainID
+
+	err := v.eventDB.RemoveLogs(ctx, chainID, append(append([]types.Log{}, report.ExtraLogs...), report.MismatchedLogs...)...)
+	if err != nil {
+		return fmt.Errorf("could not remove logs: %w", err)
+	}
+	err = v.eventDB.DeleteReceiptsForTxHashes(ctx, chainID, txHashes(report.ExtraReceipts, report.MismatchedReceipts)...)
+	if err != nil {
+		return fmt.Errorf("could not delete receipts: %w", err)
+	}
+	err = v.eventDB.DeleteEthTxsForTxHashes(ctx, chainID, txHashes(report.ExtraTxs, report.MismatchedTxs)...)
+	if err != nil {
+		return fmt.Errorf("could not delete txs: %w", err)
+	}
+
+	blocks := make(map[uint64]bool)
+	for _, log := range append(append([]types.Log{}, report.MissingLogs...), report.MismatchedLogs...) {
+		blocks[log.BlockNumber] = true
+	}
+	for _, txs := range [][]Tx{report.MissingReceipts, report.MismatchedReceipts, report.MissingTxs, report.MismatchedTxs} {
+		for _, tx := range txs {
+			blocks[tx.BlockNumber] = true
+		}
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	repairIndexer, err := v.newIndexer(report.ContractAddress)
+	if err != nil {
+		return err
+	}
+	for _, blockRange := range blockRanges(blocks) {
+		err = repairIndexer.Index(ctx, blockRange[0], blockRange[1])
+		if err != nil {
+			return fmt.Errorf("could not index blocks %d to %d: %w", blockRange[0], blockRange[1], err)
+		}
+	}
+
+	return nil
+}
+
+// notFoundCaller returns ethereum.NotFound for calls with a null result, since w3 doesn't export its own error.
+type notFoundCaller struct {
+	w3types.Caller
+}
+
+// HandleResponse returns ethereum.NotFound if the call has no result, and handles the response otherwise.
+func (n notFoundCaller) HandleResponse(elem rpc.BatchElem) error {
+	if result, ok := elem.Result.(*json.RawMessage); ok && elem.Error == nil && (len(*result) == 0 || bytes.Equal(*result, []byte("null"))) {
+		return ethereum.NotFound
+	}
+	//nolint: wrapcheck
+	return n.Caller.HandleResponse(elem)
+}
+
+// verifyTxs compares the receipts and transactions of a batch of transactions against the chain.
+//
+//nolint:cyclop
+func (v *Verifier) verifyTxs(ctx context.Context, report *Report, hashes []common.Hash, storedTxBlocks map[common.Hash]uint64) error {
+	receipts := make([]types.Receipt, len(hashes))
+	txs := make([]types.Transaction, len(hashes))
+	calls := make([]w3types.Caller, 2*len(hashes))
+	for i, hash := range hashes {
+		calls[2*i] = notFoundCaller{eth.TxReceipt(hash).Returns(&receipts[i])}
+		calls[2*i+1] = eth.Tx(hash).Returns(&txs[i])
+	}
+
+	var callErrs w3.CallErrors
+	if err := v.client[0].BatchWithContext(ctx, calls...); err != nil {
+		if !errors.As(err, &callErrs) {
+			return fmt.Errorf("could not get receipts: %w", err)
+		}
+	}
+	callErr := func(i int) error {
+		if callErrs == nil {
+			return nil
+		}
+		return callErrs[i]
+	}
+
+	for i, hash := range hashes {
+		storedReceipt, err := v.retrieveReceipt(ctx, hash)
+		if err != nil {
+			return err
+		}
+		storedTx, err := v.retrieveTx(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		receiptErr := callErr(2 * i)
+		if receiptErr != nil {
+			if !errors.Is(receiptErr, ethereum.NotFound) {
+				return fmt.Errorf("could not get receipt of %s: %w", hash, receiptErr)
+			}
+			stored := Tx{Hash: hash, BlockNumber: storedTxBlocks[hash]}
+			if storedReceipt != nil {
+				report.ExtraReceipts = append(report.ExtraReceipts, stored)
+			}
+			if storedTx != nil {
+				report.ExtraTxs = append(report.ExtraTxs, stored)
+			}
+			continue
+		}
+
+		chainTx := Tx{Hash: hash, BlockNumber: receipts[i].BlockNumber.Uint64()}
+		switch {
+		case storedReceipt == nil:
+			report.MissingReceipts = append(report.MissingReceipts, chainTx)
+		case !receiptsEqual(receipts[i], *storedReceipt):
+			report.MismatchedReceipts = append(report.MismatchedReceipts, chainTx)
+		}
+
+		txErr := callErr(2*i + 1)
+		if txErr != nil {
+			if unsupportedTxErrors[txErr.Error()] {
+				continue
+			}
+			return fmt.Errorf("could not get tx %s: %w", hash, txErr)
+		}
+		switch {
+		case storedTx == nil:
+			report.MissingTxs = append(report.MissingTxs, chainTx)
+		case storedTx.BlockNumber != chainTx.BlockNumber || storedTx.Tx.Hash() != txs[i].Hash():
+			report.MismatchedTxs = append(report.MismatchedTxs, chainTx)
+		}
+	}
+
+	return nil
+}
+
+// retrieveTx gets a stored transaction, nil if it is not stored.
+func (v *Verifier) retrieveTx(ctx context.Context, hash common.Hash) (*db.TxWithBlockNumber, error) {
+	txs, err := v.eventDB.RetrieveEthTxsWithFilter(ctx, db.EthTxFilter{ChainID: v.chainConfig.ChainID, TxHash: hash.String()}, 1)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve tx %s: %w", hash, err)
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	return &txs[0], nil
+}
+
+// retrieveReceipt gets a stored receipt, nil if it is not stored.
+func (v *Verifier) retrieveReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipts, err := v.eventDB.RetrieveReceiptsWithFilter(ctx, db.ReceiptFilter{ChainID: v.chainConfig.ChainID, TxHash: hash.String()}, 1)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve receipt %s: %w", hash, err)
+	}
+	if len(receipts) == 0 {
+		return nil, nil
+	}
+	return &receipts[0], nil
+}
+
+// fetchLogs gets the logs of a contract in a block range from the chain.
+func (v *Verifier) fetchLogs(ctx context.Context, address common.Address, topics [][]common.Hash, startBlock, endBlock uint64) ([]types.Log, error) {
+	iterator := util.NewChunkIterator(new(big.Int).SetUint64(startBlock), new(big.Int).SetUint64(endBlock), int(v.chainConfig.GetLogsRange)-1, true)
+
+	var logs []types.Log
+	for {
+		var chunks []*util.Chunk
+		for i := uint64(0); i < v.chainConfig.GetLogsBatchAmount; i++ {
+			chunk := iterator.NextChunk()
+			if chunk == nil {
+				break
+			}
+			chunks = append(chunks, chunk)
+		}
+		if len(chunks) == 0 {
+			return logs, nil
+		}
+
+		logChunks, err := backend.GetLogsInRange(ctx, v.client[0], []common.Address{address}, uint64(v.chainConfig.ChainID), chunks, topics)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch logs: %w", err)
+		}
+		for iter := logChunks.Iterator(); !iter.Done(); {
+			_, logChunk := iter.Next()
+			logs = append(logs, *logChunk...)
+		}
+	}
+}
+
+// retrieveLogs gets the stored logs of a contract in a block range.
+func (v *Verifier) retrieveLogs(ctx context.Context, address common.Address, topics [][]string, startBlock, endBlock uint64) ([]types.Log, error) {
+	logFilter := db.LogFilter{
+		ChainID:         v.chainConfig.ChainID,
+		ContractAddress: address.String(),
+		Topics:          topics,
+	}
+
+	var logs []types.Log
+	for page := 1; ; page++ {
+		logGroup, err := v.eventDB.RetrieveLogsInRangeAsc(ctx, logFilter, startBlock, endBlock, page)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve logs: %w", err)
+		}
+		if len(logGroup) == 0 {
+			return logs, nil
+		}
+		for _, log := range logGroup {
+			logs = append(logs, *log)
+		}
+	}
+}
+
+// contractTopics gets the topic filter configured for a contract, if any.
+func (v *Verifier) contractTopics(address common.Address) [][]string {
+	for _, contract := range v.chainConfig.Contracts {
+		if common.HexToAddress(contract.Address) == address {
+			return contract.Topics
+		}
+	}
+	return nil
+}
+
+// newIndexer creates an indexer that indexes a contract's blocks without changing its last indexed block.
+func (v *Verifier) newIndexer(address common.Address) (*indexer.Indexer, error) {
+	blockHeightMeter, err := v.handler.Metrics().NewHistogram(fmt.Sprintf("scribe_block_meter_%d_verify", v.chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	if err != nil {
+		return nil, fmt.Errorf("error creating otel histogram %w", err)
+	}
+
+	repairIndexer, err := indexer.NewIndexer(v.chainConfig, []common.Address{address}, v.eventDB, v.client, v.handler, blockHeightMeter, scribeTypes.IndexingConfirmed)
+	if err != nil {
+		return nil, fmt.Errorf("could not create indexer: %w", err)
+	}
+	repairIndexer.SetToBackfill()
+
+	logDecoder, err := decoder.NewDecoder(v.chainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create decoder: %w", err)
+	}
+	if logDecoder.HasABIs() {
+		repairIndexer.SetDecoder(logDecoder)
+	}
+
+	return repairIndexer, nil
+}
+
+// logKey identifies a log.
+type logKey struct {
+	txHash common.Hash
+	index  uint
+}
+
+func newLogKey(log types.Log) logKey {
+	return logKey{txHash: log.TxHash, index: log.Index}
+}
+
+// logsEqual checks if two logs have the same contents and position.
+func logsEqual(a, b types.Log) bool {
+	if len(a.Topics) != len(b.Topics) {
+		return false
+	}
+	for i := range a.Topics {
+		if a.Topics[i] != b.Topics[i] {
+			return false
+		}
+	}
+	return a.Address == b.Address && bytes.Equal(a.Data, b.Data) && a.BlockNumber == b.BlockNumber &&
+		a.TxHash == b.TxHash && a.TxIndex == b.TxIndex && a.BlockHash == b.BlockHash && a.Index == b.Index
+}
+
+// receiptsEqual checks if two receipts have the same stored fields. Their logs are verified separately.
+func receiptsEqual(a, b types.Receipt) bool {
+	return a.Type == b.Type && bytes.Equal(a.PostState, b.PostState) && a.Status == b.Status &&
+		a.CumulativeGasUsed == b.CumulativeGasUsed && a.Bloom == b.Bloom && a.TxHash == b.TxHash &&
+		a.ContractAddress == b.ContractAddress && a.GasUsed == b.GasUsed && a.BlockHash == b.BlockHash &&
+		a.BlockNumber.Cmp(b.BlockNumber) == 0 && a.TransactionIndex == b.TransactionIndex
+}
+
+// txHashes gets the hashes of transactions.
+func txHashes(txLists ...[]Tx) (hashes []common.Hash) {
+	for _, txs := range txLists {
+		for _, tx := range txs {
+			hashes = append(hashes, tx.Hash)
+		}
+	}
+	return hashes
+}
+
+// blockRanges groups blocks into sorted ranges of consecutive blocks.
+func blockRanges(blocks map[uint64]bool) (ranges [][2]uint64) {
+	sorted := make([]uint64, 0, len(blocks))
+	for block := range blocks {
+		sorted = append(sorted, block)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for _, block := range sorted {
+		if len(ranges) > 0 && ranges[len(ranges)-1][1]+1 == block {
+			ranges[len(ranges)-1][1] = block
+			continue
+		}
+		ranges = append(ranges, [2]uint64{block, block})
+	}
+	return ranges
+}
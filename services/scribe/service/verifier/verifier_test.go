@@ -0,0 +1,110 @@
+package verifier_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/backends/geth"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
+	"github.com/synapsecns/sanguine/services/scribe/service/verifier"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+// TestVerifyAndRepair tests that missing, extra and mismatched data is reported and repaired.
+func (v *VerifierSuite) TestVerifyAndRepair() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(v.GetSuiteContext(), v.T(), big.NewInt(142))
+	simulatedClient, err := backend.DialBackend(v.GetTestContext(), simulatedChain.RPCAddress(), v.metrics)
+	Nil(v.T(), err)
+
+	simulatedChain.FundAccount(v.GetTestContext(), v.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := v.manager.GetTestContract(v.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(v.GetTestContext(), nil)
+	contractAddress := testContract.Address()
+
+	chainConfig := config.ChainConfig{
+		ChainID:              142,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         10,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{{Address: contractAddress.String()}},
+	}
+	clients := []backend.ScribeBackend{simulatedClient, simulatedClient}
+
+	var startBlock uint64
+	var txs []*types.Transaction
+	for i := int64(0); i < 3; i++ {
+		tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(i), big.NewInt(2), big.NewInt(3))
+		Nil(v.T(), err)
+		simulatedChain.WaitForConfirmation(v.GetTestContext(), tx)
+		txs = append(txs, tx)
+		if i == 0 {
+			startBlock, err = testutil.GetTxBlockNumber(v.GetTestContext(), simulatedChain, tx)
+			Nil(v.T(), err)
+		}
+	}
+	endBlock, err := testutil.GetTxBlockNumber(v.GetTestContext(), simulatedChain, txs[2])
+	Nil(v.T(), err)
+
+	blockHeightMeter, err := v.metrics.Metrics().NewHistogram("scribe_block_meter_verify_test", "block_histogram", "a block height meter", "blocks")
+	Nil(v.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{contractAddress}, v.testDB, clients, v.metrics, blockHeightMeter, scribeTypes.IndexingConfirmed)
+	Nil(v.T(), err)
+	err = contractIndexer.Index(v.GetTestContext(), startBlock, endBlock)
+	Nil(v.T(), err)
+
+	logVerifier, err := verifier.NewVerifier(v.testDB, clients, chainConfig, v.metrics)
+	Nil(v.T(), err)
+
+	report, err := logVerifier.Verify(v.GetTestContext(), contractAddress, startBlock, endBlock)
+	Nil(v.T(), err)
+	Equal(v.T(), 0, report.Discrepancies())
+
+	logs, err := v.testDB.RetrieveLogsInRangeAsc(v.GetTestContext(), db.LogFilter{ChainID: chainConfig.ChainID}, startBlock, endBlock, 1)
+	Nil(v.T(), err)
+	Equal(v.T(), 3, len(logs))
+
+	// Lose the first log, corrupt the second and drop the receipt of the third.
+	err = v.testDB.RemoveLogs(v.GetTestContext(), chainConfig.ChainID, *logs[0], *logs[1])
+	Nil(v.T(), err)
+	corruptedLog := *logs[1]
+	corruptedLog.Data = []byte{1}
+	err = v.testDB.StoreLogs(v.GetTestContext(), chainConfig.ChainID, corruptedLog)
+	Nil(v.T(), err)
+	err = v.testDB.DeleteReceiptsForTxHashes(v.GetTestContext(), chainConfig.ChainID, txs[2].Hash())
+	Nil(v.T(), err)
+
+	// Store a log from a transaction that isn't on chain.
+	extraLog := *logs[2]
+	extraLog.TxHash = common.BigToHash(big.NewInt(1))
+	err = v.testDB.StoreLogs(v.GetTestContext(), chainConfig.ChainID, extraLog)
+	Nil(v.T(), err)
+
+	report, err = logVerifier.Verify(v.GetTestContext(), contractAddress, startBlock, endBlock)
+	Nil(v.T(), err)
+	Equal(v.T(), 4, report.Discrepancies())
+	Equal(v.T(), []types.Log{*logs[0]}, report.MissingLogs)
+	Equal(v.T(), []types.Log{*logs[1]}, report.MismatchedLogs)
+	Equal(v.T(), 1, len(report.ExtraLogs))
+	Equal(v.T(), extraLog.TxHash, report.ExtraLogs[0].TxHash)
+	Equal(v.T(), []verifier.Tx{{Hash: txs[2].Hash(), BlockNumber: endBlock}}, report.MissingReceipts)
+
+	err = logVerifier.Repair(v.GetTestContext(), report)
+	Nil(v.T(), err)
+
+	report, err = logVerifier.Verify(v.GetTestContext(), contractAddress, startBlock, endBlock)
+	Nil(v.T(), err)
+	Equal(v.T(), 0, report.Discrepancies())
+
+	// Repairs don't change the last indexed block.
+	lastIndexed, err := v.testDB.RetrieveLastIndexed(v.GetTestContext(), contractAddress, chainConfig.ChainID, scribeTypes.IndexingConfirmed)
+	Nil(v.T(), err)
+	Equal(v.T(), endBlock, lastIndexed)
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ParseEvent parses a human readable event signature, e.g. "PairCreated(address indexed token0, address indexed token1, address pair, uint256)".
+// Parameters are marked as indexed with the `indexed` keyword and parameter names are optional. Tuple parameters are not supported.
+func ParseEvent(signature string) (abi.Event, error) {
+	signature = strings.TrimSpace(signature)
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return abi.Event{}, fmt.Errorf("event signature %s must be of the form Name(type [indexed] [name], ...)", signature)
+	}
+	name := strings.TrimSpace(signature[:open])
+	params := strings.TrimSpace(signature[open+1 : len(signature)-1])
+
+	var inputs abi.Arguments
+	if params != "" {
+		for i, param := range strings.Split(params, ",") {
+			fields := strings.Fields(param)
+			if len(fields) == 0 || len(fields) > 3 {
+				return abi.Event{}, fmt.Errorf("invalid parameter %q in event signature %s", param, signature)
+			}
+
+			argType, err := abi.NewType(fields[0], "", nil)
+			if err != nil {
+				return abi.Event{}, fmt.Errorf("invalid type %s in event signature %s: %w", fields[0], signature, err)
+			}
+
+			arg := abi.Argument{Name: fmt.Sprintf("arg%d", i), Type: argType}
+			for _, field := range fields[1:] {
+				if field == "indexed" {
+					arg.Indexed = true
+				} else {
+					arg.Name = field
+				}
+			}
+			inputs = append(inputs, arg)
+		}
+	}
+
+	indexed := 0
+	for _, input := range inputs {
+		if input.Indexed {
+			indexed++
+		}
+	}
+	if indexed > MaxTopics-1 {
+		return abi.Event{}, fmt.Errorf("event signature %s has %d indexed parameters, max is %d", signature, indexed, MaxTopics-1)
+	}
+
+	return abi.NewEvent(name, name, false, inputs), nil
+}
+
+// FormatArg formats a decoded event argument as a string so it can be stored and filtered on. Addresses, hashes and
+// bytes are lowercase hex, integers are decimal and other non scalar values are JSON encoded.
+//
+//nolint:cyclop
+func FormatArg(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case common.Address:
+		return strings.ToLower(v.Hex()), nil
+	case common.Hash:
+		return v.Hex(), nil
+	case *big.Int:
+		return v.String(), nil
+	case []byte:
+		return hexutil.Encode(v), nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case uint8, uint16, uint32, uint64, int8, int16, int32, int64:
+		return fmt.Sprintf("%d", v), nil
+	}
+
+	// Fixed size byte arrays, e.g. bytes32.
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() == reflect.Array && reflected.Type().Elem().Kind() == reflect.Uint8 {
+		bytes := make([]byte, reflected.Len())
+		reflect.Copy(reflect.ValueOf(bytes), reflected)
+		return hexutil.Encode(bytes), nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("could not encode argument: %w", err)
+	}
+	return string(encoded), nil
+}
+
+// NormalizeArgValue normalizes an argument value used to filter decoded logs so it matches the format of FormatArg.
+func NormalizeArgValue(value string) string {
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		return strings.ToLower(value)
+	}
+	return value
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxTopics is the max number of topics a log can have.
+const MaxTopics = 4
+
+// ParseTopic parses a topic filter value. Event signatures (e.g. "Transfer(address,address,uint256)") are hashed,
+// addresses are left padded to a topic and 32 byte hex values are used as is.
+func ParseTopic(value string) (common.Hash, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "(") {
+		return crypto.Keccak256Hash([]byte(strings.ReplaceAll(value, " ", ""))), nil
+	}
+
+	decoded, err := hexutil.Decode(value)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not decode topic %s: %w", value, err)
+	}
+
+	switch len(decoded) {
+	case common.HashLength:
+		return common.BytesToHash(decoded), nil
+	case common.AddressLength:
+		return common.BytesToHash(common.BytesToAddress(decoded).Bytes()), nil
+	default:
+		return common.Hash{}, fmt.Errorf("topic %s must be an event signature, an address or a 32 byte hash", value)
+	}
+}
+
+// ParseTopics parses a topic filter. Each position is matched against any of its values, and an empty
+// position matches any topic.
+func ParseTopics(values [][]string) ([][]common.Hash, error) {
+	if len(values) > MaxTopics {
+		return nil, fmt.Errorf("topic filter has %d positions, max is %d", len(values), MaxTopics)
+	}
+
+	topics := make([][]common.Hash, len(values))
+	for i, position := range values {
+		for _, value := range position {
+			topic, err := ParseTopic(value)
+			if err != nil {
+				return nil, err
+			}
+			topics[i] = append(topics[i], topic)
+		}
+	}
+
+	return topics, nil
+}
+
+// MatchesTopics checks if a log's topics match a topic filter.
+func MatchesTopics(filter [][]common.Hash, topics []common.Hash) bool {
+	for i, position := range filter {
+		if len(position) == 0 {
+			continue
+		}
+		if i >= len(topics) {
+			return false
+		}
+
+		matched := false
+		for _, topic := range position {
+			if topic == topics[i] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
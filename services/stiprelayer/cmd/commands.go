@@ -11,9 +11,12 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql/base"
 	"github.com/synapsecns/sanguine/services/stiprelayer/relayer"
 	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
 	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 var configFlag = &cli.StringFlag{
@@ -58,3 +61,24 @@
 		return nil
 	},
 }
+
+// migrateCommand runs versioned migrations on the relayer database.
+var migrateCommand = dbcommon.NewMigrateCommand(dbcommon.MigrateCommandConfig{
+	Service:    base.MigrationService,
+	Migrations: base.GetMigrations(),
+	Flags:      []cli.Flag{configFlag},
+	Open: func(c *cli.Context) (*gorm.DB, error) {
+		cfg, err := stipconfig.LoadConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("could not read config file: %w", err)
+		}
+
+		dbType, err := dbcommon.DBTypeFromString(cfg.Database.Type)
+		if err != nil {
+			return nil, fmt.Errorf("could not get db type: %w", err)
+		}
+
+		//nolint: wrapcheck
+		return dbcommon.Open(dbType, cfg.Database.DSN, "stip.db", schema.NamingStrategy{})
+	},
+})
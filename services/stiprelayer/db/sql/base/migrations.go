@@ -0,0 +1,18 @@
+package base
+
+import (
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+)
+
+// MigrationService is the name the stip relayer db's migrations are stored under.
+const MigrationService = "stip-relayer"
+
+// GetMigrations gets the versioned schema migrations for the stip relayer db. New migrations are appended here
+// and released migrations must never be changed, so their models are listed out rather than taken from GetAllModels.
+func GetMigrations() []dbcommon.Migration {
+	return []dbcommon.Migration{
+		dbcommon.AutoMigration(1, "initial_schema", &txdb.ETHTX{}, &db.STIPTransactions{}),
+	}
+}